@@ -0,0 +1,73 @@
+package componentoverride
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ClearComponentOverride godoc
+// @Summary Clear a component override
+// @Description Ends an override in effect so the component shows its computed status again (owner/admin only). The override stays in the audit log.
+// @Tags component-overrides
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param overrideID path string true "Override ID"
+// @Success 200 {object} response.SuccessResponse "Component override cleared"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Override not found or no longer in effect"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/overrides/{overrideID} [delete]
+func (h *Handler) ClearComponentOverride(c echo.Context) error {
+	teamID, statusPageID, overrideID, err := parseComponentOverrideParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	override, err := h.Repo.ClearComponentOverride(ctx, tx, page.ID, overrideID, *userID, now)
+	if err != nil {
+		zap.L().Error("Failed to clear component override", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to clear component override")
+	}
+
+	if override == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Component override not found")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Component override cleared", newComponentOverrideResponse(*override, now)))
+}
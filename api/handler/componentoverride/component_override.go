@@ -0,0 +1,114 @@
+package componentoverride
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+type componentOverrideRequest struct {
+	// Exactly one of GroupID (a group of the page) and MonitorID (a monitor shown on the page) is required.
+	GroupID   *int64                 `json:"group_id,string,omitempty"`
+	MonitorID *int64                 `json:"monitor_id,string,omitempty"`
+	Status    models.ComponentStatus `json:"status" validate:"required,oneof=operational degraded_performance partial_outage major_outage under_maintenance"`
+	Message   string                 `json:"message" validate:"max=1000"`
+	// ExpiresAt ends the override on its own; without it the override stays until cleared.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type componentOverrideResponse struct {
+	models.ComponentOverride
+	Active bool `json:"active"`
+}
+
+func newComponentOverrideResponse(override models.ComponentOverride, now time.Time) componentOverrideResponse {
+	return componentOverrideResponse{ComponentOverride: override, Active: override.ActiveAt(now)}
+}
+
+// parseComponentOverrideParams reads the team, status page and, when present, override IDs of the path.
+func parseComponentOverrideParams(c echo.Context) (teamID, statusPageID, overrideID int64, err error) {
+	teamID, err = strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err = strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	if c.Param("overrideID") != "" {
+		overrideID, err = strconv.ParseInt(c.Param("overrideID"), 10, 64)
+		if err != nil {
+			return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid override ID")
+		}
+	}
+
+	return teamID, statusPageID, overrideID, nil
+}
+
+// statusPageForMember loads a team's status page for a member; writes additionally need an owner or admin.
+// Errors are already HTTP errors.
+func (h *Handler) statusPageForMember(ctx context.Context, tx pgx.Tx, teamID, statusPageID, userID int64, write bool) (*models.StatusPage, error) {
+	member, err := h.Repo.GetTeamMemberByUserID(ctx, tx, teamID, userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if write && member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "You do not have permission to override component statuses for this team")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(ctx, tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	return page, nil
+}
+
+// checkComponentOnPage makes sure the group or monitor of a request is shown on the page.
+// Errors are already HTTP errors.
+func (h *Handler) checkComponentOnPage(ctx context.Context, tx pgx.Tx, statusPageID int64, req componentOverrideRequest) error {
+	if req.GroupID != nil {
+		groups, err := h.Repo.ListStatusPageGroupsByStatusPageID(ctx, tx, statusPageID)
+		if err != nil {
+			zap.L().Error("Failed to list status page groups", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page groups")
+		}
+		for _, group := range groups {
+			if group.ID == *req.GroupID {
+				return nil
+			}
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, "Group is not on this status page")
+	}
+
+	monitors, err := h.Repo.ListStatusPageMonitorsByStatusPageID(ctx, tx, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to list status page monitors", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page monitors")
+	}
+	for _, monitor := range monitors {
+		if monitor.MonitorID == *req.MonitorID {
+			return nil
+		}
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, "Monitor is not on this status page")
+}
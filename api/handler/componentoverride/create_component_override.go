@@ -0,0 +1,122 @@
+package componentoverride
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// CreateComponentOverride godoc
+// @Summary Override a component status
+// @Description Sets the status shown for a group or monitor of a status page regardless of monitor results (owner/admin only). It replaces the component's current override and lasts until expires_at or until cleared. The public page shows it next to the computed status.
+// @Tags component-overrides
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param request body componentOverrideRequest true "Override payload"
+// @Success 200 {object} response.SuccessResponse "Component status overridden"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/overrides [post]
+func (h *Handler) CreateComponentOverride(c echo.Context) error {
+	teamID, statusPageID, _, err := parseComponentOverrideParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req componentOverrideRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if (req.GroupID == nil) == (req.MonitorID == nil) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Exactly one of group_id and monitor_id is required")
+	}
+
+	now := time.Now().UTC()
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return echo.NewHTTPError(http.StatusBadRequest, "expires_at must be in the future")
+		}
+		expiresAt := req.ExpiresAt.UTC()
+		req.ExpiresAt = &expiresAt
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	if err := h.checkComponentOnPage(ctx, tx, page.ID, req); err != nil {
+		return err
+	}
+
+	overrideID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate component override ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to override component status")
+	}
+
+	override := models.ComponentOverride{
+		ID:           overrideID,
+		StatusPageID: page.ID,
+		GroupID:      req.GroupID,
+		MonitorID:    req.MonitorID,
+		Status:       req.Status,
+		Message:      req.Message,
+		ExpiresAt:    req.ExpiresAt,
+		CreatedBy:    userID,
+		CreatedAt:    now,
+	}
+
+	// A component has at most one override at a time; the replaced one stays in the audit log.
+	if err := h.Repo.ClearActiveComponentOverrides(ctx, tx, page.ID, req.GroupID, req.MonitorID, *userID, now); err != nil {
+		zap.L().Error("Failed to clear component overrides", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to override component status")
+	}
+
+	if err := h.Repo.CreateComponentOverride(ctx, tx, override); err != nil {
+		zap.L().Error("Failed to create component override", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to override component status")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Component status overridden", newComponentOverrideResponse(override, now)))
+}
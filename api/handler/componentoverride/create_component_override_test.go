@@ -0,0 +1,130 @@
+package componentoverride
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestCreateComponentOverride_Success(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.StatusPage{ID: 7, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("ListStatusPageGroupsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageGroup{{ID: 20, StatusPageID: 7, Name: "Core"}}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 7, MonitorID: 100}}, nil)
+	var clearedMonitorID *int64
+	mockRepo.On("ClearActiveComponentOverrides", mock.Anything, mock.Anything, int64(7), mock.Anything, mock.Anything, int64(123), mock.Anything).
+		Return(nil).Run(func(args mock.Arguments) {
+		clearedMonitorID = args.Get(4).(*int64)
+	})
+	var captured models.ComponentOverride
+	mockRepo.On("CreateComponentOverride", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		captured = args.Get(2).(models.ComponentOverride)
+	})
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/overrides",
+		strings.NewReader(`{"monitor_id":"100","status":"degraded_performance","message":"Slow checkouts"}`))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	require.NoError(t, h.CreateComponentOverride(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, int64(100), *clearedMonitorID)
+	require.Equal(t, int64(7), captured.StatusPageID)
+	require.Nil(t, captured.GroupID)
+	require.Equal(t, int64(100), *captured.MonitorID)
+	require.Equal(t, models.ComponentStatusDegradedPerformance, captured.Status)
+	require.Equal(t, "Slow checkouts", captured.Message)
+	require.Equal(t, int64(123), *captured.CreatedBy)
+
+	var resp struct {
+		Message string `json:"message"`
+		Data    struct {
+			Active bool `json:"active"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "Component status overridden", resp.Message)
+	require.True(t, resp.Data.Active)
+}
+
+func TestCreateComponentOverride_InvalidTarget(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.StatusPage{ID: 7, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("ListStatusPageGroupsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageGroup{{ID: 20, StatusPageID: 7, Name: "Core"}}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 7, MonitorID: 100}}, nil)
+	h := &Handler{Repo: mockRepo}
+
+	for _, body := range []string{
+		`{"status":"major_outage"}`,
+		`{"group_id":"20","monitor_id":"100","status":"major_outage"}`,
+		`{"monitor_id":"200","status":"major_outage"}`,
+		`{"group_id":"21","status":"major_outage"}`,
+		`{"monitor_id":"100","status":"broken"}`,
+		`{"monitor_id":"100","status":"major_outage","expires_at":"2020-01-01T00:00:00Z"}`,
+	} {
+		c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/overrides", strings.NewReader(body))
+		testutil.SetJSONHeader(c)
+		testutil.Authenticate(c, 123)
+		c.SetParamNames("teamID", "id")
+		c.SetParamValues("1", "7")
+
+		err := h.CreateComponentOverride(c)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr, body)
+		require.Equal(t, http.StatusBadRequest, httpErr.Code, body)
+	}
+	mockRepo.AssertNotCalled(t, "CreateComponentOverride", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateComponentOverride_MemberForbidden(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleMember}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/overrides", strings.NewReader(`{"group_id":"20","status":"major_outage"}`))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	err := h.CreateComponentOverride(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusForbidden, httpErr.Code)
+}
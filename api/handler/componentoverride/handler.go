@@ -0,0 +1,8 @@
+package componentoverride
+
+import "github.com/yorukot/kymarium/repository"
+
+// Handler handles manual status overrides of status page components.
+type Handler struct {
+	Repo repository.Repository
+}
@@ -0,0 +1,79 @@
+package componentoverride
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListComponentOverrides godoc
+// @Summary List component overrides
+// @Description Lists the component status overrides of a status page, newest first. Cleared, replaced and expired overrides are kept as an audit log unless active=true.
+// @Tags component-overrides
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param active query bool false "Only overrides in effect"
+// @Success 200 {object} response.SuccessResponse "Component overrides retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/overrides [get]
+func (h *Handler) ListComponentOverrides(c echo.Context) error {
+	teamID, statusPageID, _, err := parseComponentOverrideParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, false)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var overrides []models.ComponentOverride
+	if c.QueryParam("active") == "true" {
+		overrides, err = h.Repo.ListActiveComponentOverridesByStatusPageID(ctx, tx, page.ID, now)
+	} else {
+		overrides, err = h.Repo.ListComponentOverridesByStatusPageID(ctx, tx, page.ID)
+	}
+	if err != nil {
+		zap.L().Error("Failed to list component overrides", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list component overrides")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	resp := make([]componentOverrideResponse, 0, len(overrides))
+	for _, override := range overrides {
+		resp = append(resp, newComponentOverrideResponse(override, now))
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Component overrides retrieved", resp))
+}
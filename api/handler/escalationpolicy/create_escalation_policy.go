@@ -0,0 +1,128 @@
+package escalationpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// CreateEscalationPolicy godoc
+// @Summary Create an escalation policy
+// @Description Creates an escalation policy with ordered levels for the given team (owner/admin only)
+// @Tags escalation_policies
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param request body escalationPolicyUpsertRequest true "Escalation policy create request"
+// @Success 200 {object} response.SuccessResponse "Escalation policy created successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or team ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/escalation-policies [post]
+func (h *Handler) CreateEscalationPolicy(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	var req escalationPolicyUpsertRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	req = normalizeEscalationPolicyUpsert(req)
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Team not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to create escalation policies for this team")
+	}
+
+	if err := validateEscalationTargets(c.Request().Context(), h.Repo, tx, teamID, req.Levels); err != nil {
+		if errors.Is(err, errUnknownTarget) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to validate escalation targets", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate escalation targets")
+	}
+
+	policyID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate escalation policy ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate escalation policy ID")
+	}
+
+	now := time.Now()
+	policy := models.EscalationPolicy{
+		ID:          policyID,
+		TeamID:      teamID,
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := h.Repo.CreateEscalationPolicy(c.Request().Context(), tx, policy); err != nil {
+		zap.L().Error("Failed to create escalation policy", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create escalation policy")
+	}
+
+	levels, err := buildEscalationLevels(req.Levels, policy.ID)
+	if err != nil {
+		zap.L().Error("Failed to generate escalation level IDs", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate escalation level IDs")
+	}
+
+	if err := h.Repo.CreateEscalationPolicyLevels(c.Request().Context(), tx, levels); err != nil {
+		zap.L().Error("Failed to create escalation policy levels", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create escalation policy levels")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	policy.Levels = levels
+
+	return c.JSON(http.StatusOK, response.Success("Escalation policy created successfully", policy))
+}
@@ -0,0 +1,85 @@
+package escalationpolicy
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteEscalationPolicy godoc
+// @Summary Delete an escalation policy
+// @Description Deletes an escalation policy for a team (owner/admin only); linked monitors are detached
+// @Tags escalation_policies
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Escalation policy ID"
+// @Success 200 {object} response.SuccessResponse "Escalation policy deleted successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID or escalation policy ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Escalation policy not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/escalation-policies/{id} [delete]
+func (h *Handler) DeleteEscalationPolicy(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid escalation policy ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to delete this escalation policy")
+	}
+
+	if err := h.Repo.DeleteEscalationPolicy(c.Request().Context(), tx, teamID, policyID); err != nil {
+		if err == pgx.ErrNoRows {
+			return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+		}
+
+		zap.L().Error("Failed to delete escalation policy", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete escalation policy")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Escalation policy deleted successfully"))
+}
@@ -0,0 +1,110 @@
+package escalationpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/id"
+)
+
+var errUnknownTarget = errors.New("escalation target does not belong to this team")
+
+type escalationLevelInput struct {
+	DelayMinutes int                       `json:"delay_minutes" validate:"min=1,max=1440"`
+	Targets      []models.EscalationTarget `json:"targets" validate:"required,min=1,max=20,dive"`
+}
+
+type escalationPolicyUpsertRequest struct {
+	Name        string                 `json:"name" validate:"required,min=1,max=255"`
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Levels      []escalationLevelInput `json:"levels" validate:"required,min=1,max=10,dive"`
+}
+
+// normalizeEscalationPolicyUpsert trims the name and drops a blank description.
+func normalizeEscalationPolicyUpsert(req escalationPolicyUpsertRequest) escalationPolicyUpsertRequest {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Description != nil {
+		trimmed := strings.TrimSpace(*req.Description)
+		if trimmed == "" {
+			req.Description = nil
+		} else {
+			req.Description = &trimmed
+		}
+	}
+	return req
+}
+
+// validateEscalationTargets ensures every target references a notification, member or schedule of the team.
+func validateEscalationTargets(ctx context.Context, repo repository.Repository, tx pgx.Tx, teamID int64, levels []escalationLevelInput) error {
+	for i, level := range levels {
+		for _, target := range level.Targets {
+			switch target.Type {
+			case models.EscalationTargetTypeNotification:
+				notification, err := repo.GetNotificationByID(ctx, tx, teamID, target.ID)
+				if err != nil {
+					return err
+				}
+				if notification == nil {
+					return fmt.Errorf("level %d: %w", i+1, errUnknownTarget)
+				}
+			case models.EscalationTargetTypeUser:
+				member, err := repo.GetTeamMemberByUserID(ctx, tx, teamID, target.ID)
+				if err != nil {
+					return err
+				}
+				if member == nil {
+					return fmt.Errorf("level %d: %w", i+1, errUnknownTarget)
+				}
+			case models.EscalationTargetTypeSchedule:
+				schedule, err := repo.GetOnCallScheduleByID(ctx, tx, teamID, target.ID)
+				if err != nil {
+					return err
+				}
+				if schedule == nil {
+					return fmt.Errorf("level %d: %w", i+1, errUnknownTarget)
+				}
+			default:
+				return fmt.Errorf("level %d: %w", i+1, errUnknownTarget)
+			}
+		}
+	}
+	return nil
+}
+
+// buildEscalationLevels assigns IDs and positions in request order.
+func buildEscalationLevels(levels []escalationLevelInput, policyID int64) ([]models.EscalationPolicyLevel, error) {
+	result := make([]models.EscalationPolicyLevel, 0, len(levels))
+	for i, level := range levels {
+		levelID, err := id.GetID()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, models.EscalationPolicyLevel{
+			ID:           levelID,
+			PolicyID:     policyID,
+			Position:     i + 1,
+			DelayMinutes: level.DelayMinutes,
+			Targets:      level.Targets,
+		})
+	}
+	return result, nil
+}
+
+// attachEscalationLevels groups levels onto their policies.
+func attachEscalationLevels(policies []models.EscalationPolicy, levels []models.EscalationPolicyLevel) {
+	byPolicy := make(map[int64][]models.EscalationPolicyLevel, len(policies))
+	for _, level := range levels {
+		byPolicy[level.PolicyID] = append(byPolicy[level.PolicyID], level)
+	}
+	for i := range policies {
+		policies[i].Levels = byPolicy[policies[i].ID]
+		if policies[i].Levels == nil {
+			policies[i].Levels = []models.EscalationPolicyLevel{}
+		}
+	}
+}
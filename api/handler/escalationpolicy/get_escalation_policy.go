@@ -0,0 +1,89 @@
+package escalationpolicy
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// GetEscalationPolicy godoc
+// @Summary Get an escalation policy
+// @Description Retrieves an escalation policy and its levels for a team the user belongs to
+// @Tags escalation_policies
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Escalation policy ID"
+// @Success 200 {object} response.SuccessResponse "Escalation policy retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID or escalation policy ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Escalation policy not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/escalation-policies/{id} [get]
+func (h *Handler) GetEscalationPolicy(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid escalation policy ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+	}
+
+	policy, err := h.Repo.GetEscalationPolicyByID(c.Request().Context(), tx, teamID, policyID)
+	if err != nil {
+		zap.L().Error("Failed to get escalation policy", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get escalation policy")
+	}
+
+	if policy == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+	}
+
+	levels, err := h.Repo.ListEscalationPolicyLevelsByPolicyIDs(c.Request().Context(), tx, []int64{policy.ID})
+	if err != nil {
+		zap.L().Error("Failed to list escalation policy levels", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list escalation policy levels")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	policies := []models.EscalationPolicy{*policy}
+	attachEscalationLevels(policies, levels)
+
+	return c.JSON(http.StatusOK, response.Success("Escalation policy retrieved successfully", policies[0]))
+}
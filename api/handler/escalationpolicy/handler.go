@@ -0,0 +1,8 @@
+package escalationpolicy
+
+import "github.com/yorukot/kymarium/repository"
+
+// Handler handles escalation policy requests.
+type Handler struct {
+	Repo repository.Repository
+}
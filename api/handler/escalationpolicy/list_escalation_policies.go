@@ -0,0 +1,82 @@
+package escalationpolicy
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListEscalationPolicies godoc
+// @Summary List escalation policies
+// @Description Lists escalation policies and their levels for a team the user belongs to
+// @Tags escalation_policies
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Success 200 {object} response.SuccessResponse "Escalation policies retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/escalation-policies [get]
+func (h *Handler) ListEscalationPolicies(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Team not found")
+	}
+
+	policies, err := h.Repo.ListEscalationPoliciesByTeamID(c.Request().Context(), tx, teamID)
+	if err != nil {
+		zap.L().Error("Failed to list escalation policies", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list escalation policies")
+	}
+
+	policyIDs := make([]int64, 0, len(policies))
+	for _, policy := range policies {
+		policyIDs = append(policyIDs, policy.ID)
+	}
+
+	levels, err := h.Repo.ListEscalationPolicyLevelsByPolicyIDs(c.Request().Context(), tx, policyIDs)
+	if err != nil {
+		zap.L().Error("Failed to list escalation policy levels", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list escalation policy levels")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	attachEscalationLevels(policies, levels)
+
+	return c.JSON(http.StatusOK, response.Success("Escalation policies retrieved successfully", policies))
+}
@@ -0,0 +1,133 @@
+package escalationpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateEscalationPolicy godoc
+// @Summary Update an escalation policy
+// @Description Replaces an escalation policy and its levels (owner/admin only). Incidents already escalating pick up the new levels on their next step.
+// @Tags escalation_policies
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Escalation policy ID"
+// @Param request body escalationPolicyUpsertRequest true "Escalation policy update request"
+// @Success 200 {object} response.SuccessResponse "Escalation policy updated successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or IDs"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Escalation policy not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/escalation-policies/{id} [put]
+func (h *Handler) UpdateEscalationPolicy(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid escalation policy ID")
+	}
+
+	var req escalationPolicyUpsertRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	req = normalizeEscalationPolicyUpsert(req)
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to update this escalation policy")
+	}
+
+	if err := validateEscalationTargets(c.Request().Context(), h.Repo, tx, teamID, req.Levels); err != nil {
+		if errors.Is(err, errUnknownTarget) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to validate escalation targets", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate escalation targets")
+	}
+
+	updated, err := h.Repo.UpdateEscalationPolicy(c.Request().Context(), tx, models.EscalationPolicy{
+		ID:          policyID,
+		TeamID:      teamID,
+		Name:        req.Name,
+		Description: req.Description,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		zap.L().Error("Failed to update escalation policy", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update escalation policy")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Escalation policy not found")
+	}
+
+	if err := h.Repo.DeleteEscalationPolicyLevelsByPolicyID(c.Request().Context(), tx, updated.ID); err != nil {
+		zap.L().Error("Failed to delete escalation policy levels", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete escalation policy levels")
+	}
+
+	levels, err := buildEscalationLevels(req.Levels, updated.ID)
+	if err != nil {
+		zap.L().Error("Failed to generate escalation level IDs", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate escalation level IDs")
+	}
+
+	if err := h.Repo.CreateEscalationPolicyLevels(c.Request().Context(), tx, levels); err != nil {
+		zap.L().Error("Failed to create escalation policy levels", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create escalation policy levels")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	updated.Levels = levels
+
+	return c.JSON(http.StatusOK, response.Success("Escalation policy updated successfully", updated))
+}
@@ -0,0 +1,171 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type acknowledgeIncidentRequest struct {
+	Note string `json:"note" validate:"max=1000"`
+}
+
+// errIncidentNotAcknowledgeable is returned when an incident is already acknowledged or resolved.
+var errIncidentNotAcknowledgeable = errors.New("incident cannot be acknowledged")
+
+// AcknowledgeIncident godoc
+// @Summary Acknowledge an incident
+// @Description Claims an open incident, stopping reminders and escalations, and records a timeline event
+// @Tags incidents
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param incidentID path string true "Incident ID"
+// @Param request body acknowledgeIncidentRequest false "Optional acknowledgement note"
+// @Success 200 {object} response.SuccessResponse "Incident acknowledged successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Incident not found"
+// @Failure 409 {object} response.ErrorResponse "Incident already acknowledged or resolved"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/incidents/{incidentID}/acknowledge [post]
+func (h *Handler) AcknowledgeIncident(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	incidentID, err := strconv.ParseInt(c.Param("incidentID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid incident ID")
+	}
+
+	var req acknowledgeIncidentRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(ctx, tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Incident not found")
+	}
+
+	existing, err := h.Repo.GetIncidentByIDForTeam(ctx, tx, teamID, incidentID)
+	if err != nil {
+		zap.L().Error("Failed to get incident", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get incident")
+	}
+
+	if existing == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Incident not found")
+	}
+
+	var note *string
+	if trimmed := strings.TrimSpace(req.Note); trimmed != "" {
+		note = &trimmed
+	}
+
+	acknowledged, event, err := h.acknowledge(ctx, tx, existing.ID, userID, note)
+	if err != nil {
+		if errors.Is(err, errIncidentNotAcknowledgeable) {
+			return echo.NewHTTPError(http.StatusConflict, "Incident is already acknowledged or resolved")
+		}
+		zap.L().Error("Failed to acknowledge incident", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to acknowledge incident")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	resp := struct {
+		Incident models.Incident      `json:"incident"`
+		Event    models.EventTimeline `json:"event"`
+	}{
+		Incident: *acknowledged,
+		Event:    *event,
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Incident acknowledged successfully", resp))
+}
+
+// acknowledge marks the incident as acknowledged and writes the matching timeline event.
+func (h *Handler) acknowledge(ctx context.Context, tx pgx.Tx, incidentID int64, userID *int64, note *string) (*models.Incident, *models.EventTimeline, error) {
+	now := time.Now().UTC()
+
+	incident, err := h.Repo.AcknowledgeIncident(ctx, tx, incidentID, userID, note, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if incident == nil {
+		return nil, nil, errIncidentNotAcknowledgeable
+	}
+
+	message := "Incident acknowledged"
+	if note != nil {
+		message = *note
+	}
+
+	eventID, err := id.GetID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event := models.EventTimeline{
+		ID:         eventID,
+		IncidentID: incident.ID,
+		CreatedBy:  userID,
+		Message:    message,
+		EventType:  models.IncidentEventTypeAcknowledged,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := h.Repo.CreateEventTimeline(ctx, tx, event); err != nil {
+		return nil, nil, err
+	}
+
+	return incident, &event, nil
+}
@@ -0,0 +1,85 @@
+package incident
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestAcknowledgeIncident_Success(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	now := time.Now().UTC()
+	userID := int64(123)
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), userID).
+		Return(&models.TeamMember{TeamID: 1, UserID: userID, Role: models.MemberRoleMember}, nil)
+	mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(9)).
+		Return(&models.Incident{ID: 9, Status: models.IncidentStatusDetected}, nil)
+	mockRepo.On("AcknowledgeIncident", mock.Anything, mock.Anything, int64(9), &userID, mock.Anything, mock.Anything).
+		Return(&models.Incident{ID: 9, Status: models.IncidentStatusDetected, AcknowledgedAt: &now, AcknowledgedBy: &userID}, nil)
+
+	var capturedEvent models.EventTimeline
+	mockRepo.On("CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		capturedEvent = args.Get(2).(models.EventTimeline)
+	})
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/teams/1/incidents/9/acknowledge", strings.NewReader(`{"note":"on it"}`))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, userID)
+	c.SetParamNames("teamID", "incidentID")
+	c.SetParamValues("1", "9")
+
+	err := h.AcknowledgeIncident(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, models.IncidentEventTypeAcknowledged, capturedEvent.EventType)
+	require.Equal(t, "on it", capturedEvent.Message)
+	require.Equal(t, &userID, capturedEvent.CreatedBy)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "Incident acknowledged successfully", resp["message"])
+}
+
+func TestAcknowledgeIncident_AlreadyAcknowledged(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	userID := int64(123)
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), userID).
+		Return(&models.TeamMember{TeamID: 1, UserID: userID, Role: models.MemberRoleMember}, nil)
+	mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(9)).
+		Return(&models.Incident{ID: 9, Status: models.IncidentStatusDetected}, nil)
+	mockRepo.On("AcknowledgeIncident", mock.Anything, mock.Anything, int64(9), &userID, mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/incidents/9/acknowledge", nil)
+	testutil.Authenticate(c, userID)
+	c.SetParamNames("teamID", "incidentID")
+	c.SetParamValues("1", "9")
+
+	err := h.AcknowledgeIncident(c)
+	require.Error(t, err)
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	require.Equal(t, http.StatusConflict, httpErr.Code)
+	mockRepo.AssertNotCalled(t, "CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,39 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/id"
+)
+
+// resolveFromChat resolves an incident from a chat button and records who resolved it on the timeline.
+func (h *Handler) resolveFromChat(ctx context.Context, tx pgx.Tx, incidentID int64, message string) error {
+	now := time.Now().UTC()
+
+	incident, err := h.Repo.UpdateIncidentStatus(ctx, tx, incidentID, models.IncidentStatusResolved, &now, now)
+	if err != nil {
+		return err
+	}
+
+	if incident == nil {
+		return errors.New("incident not found")
+	}
+
+	eventID, err := id.GetID()
+	if err != nil {
+		return err
+	}
+
+	return h.Repo.CreateEventTimeline(ctx, tx, models.EventTimeline{
+		ID:         eventID,
+		IncidentID: incident.ID,
+		Message:    message,
+		EventType:  models.IncidentEventTypeManuallyResolved,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+}
@@ -188,7 +188,14 @@ func (h *Handler) CreateIncident(c echo.Context) error {
 		msg = string(status)
 	}
 
+	eventID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate incident event ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create incident event")
+	}
+
 	event := models.EventTimeline{
+		ID:         eventID,
 		IncidentID: incidentID,
 		CreatedBy:  userID,
 		Message:    msg,
@@ -206,6 +213,8 @@ func (h *Handler) CreateIncident(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
 	}
 
+	h.publishStatusPageUpdate(teamID, incident, event)
+
 	resp := struct {
 		Incident models.Incident      `json:"incident"`
 		Event    models.EventTimeline `json:"event"`
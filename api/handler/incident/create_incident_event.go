@@ -8,8 +8,10 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
 	"github.com/yorukot/kymarium/models"
 	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
 	"github.com/yorukot/kymarium/utils/response"
 	"go.uber.org/zap"
 )
@@ -17,11 +19,16 @@ import (
 type createIncidentEventRequest struct {
 	Message   string           `json:"message" validate:"required,min=1"`
 	EventType models.EventType `json:"event_type" validate:"omitempty,oneof=detected notification_sent manually_resolved auto_resolved unpublished published investigating identified update monitoring"`
+	// MessageTranslations optionally overrides Message per locale on status pages.
+	MessageTranslations models.Translations `json:"message_translations,omitempty"`
 }
 
+// maxEventTranslationLength bounds a single translated event message.
+const maxEventTranslationLength = 10000
+
 // CreateIncidentEvent godoc
 // @Summary Create an incident event
-// @Description Adds a new event to an incident timeline
+// @Description Adds a new event to an incident timeline; message_translations optionally localizes the message on status pages
 // @Tags incidents
 // @Accept json
 // @Produce json
@@ -54,6 +61,10 @@ func (h *Handler) CreateIncidentEvent(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
 	}
 
+	if err := statuspagecore.ValidateTranslations("message_translations", req.MessageTranslations, "", maxEventTranslationLength); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	userID, err := authutil.GetUserIDFromContext(c)
 	if err != nil {
 		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
@@ -97,14 +108,22 @@ func (h *Handler) CreateIncidentEvent(c echo.Context) error {
 		eventType = models.IncidentEventTypeUpdate
 	}
 
+	eventID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate incident event ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create incident event")
+	}
+
 	now := time.Now().UTC()
 	event := models.EventTimeline{
-		IncidentID: incident.ID,
-		CreatedBy:  userID,
-		Message:    req.Message,
-		EventType:  eventType,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+		ID:                  eventID,
+		IncidentID:          incident.ID,
+		CreatedBy:           userID,
+		Message:             req.Message,
+		EventType:           eventType,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		MessageTranslations: req.MessageTranslations,
 	}
 
 	if err := h.Repo.CreateEventTimeline(ctx, tx, event); err != nil {
@@ -116,5 +135,8 @@ func (h *Handler) CreateIncidentEvent(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
 	}
 
+	h.publishStatusPageUpdate(teamID, *incident, event)
+	h.publishManualStatusUpdate(teamID, *incident, event)
+
 	return c.JSON(http.StatusOK, response.Success("Incident event created successfully", event))
 }
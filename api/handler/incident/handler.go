@@ -1,9 +1,13 @@
 package incident
 
-import "github.com/yorukot/kymarium/repository"
+import (
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/repository"
+)
 
 // Handler groups dependencies for incident endpoints.
 // Handler handles incident-related requests.
 type Handler struct {
-	Repo repository.Repository
+	Repo     repository.Repository
+	Notifier *asynq.Client
 }
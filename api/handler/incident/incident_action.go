@@ -0,0 +1,180 @@
+package incident
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/encrypt"
+	"go.uber.org/zap"
+)
+
+// incidentActionPage renders the confirmation and result pages for one-click incident actions.
+// Links are confirmed with a POST so chat unfurlers and mail scanners prefetching the URL cannot trigger the action.
+var incidentActionPage = template.Must(template.New("incident_action").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>{{.Title}}</title></head>
+<body style="font-family: sans-serif; max-width: 32rem; margin: 4rem auto; text-align: center;">
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+{{if .Confirm}}<form method="post"><input type="hidden" name="token" value="{{.Token}}"><button type="submit">{{.Confirm}}</button></form>{{end}}
+</body>
+</html>`))
+
+type incidentActionView struct {
+	Title   string
+	Message string
+	Confirm string
+	Token   string
+}
+
+// ShowIncidentAction godoc
+// @Summary Confirm a one-click incident action
+// @Description Renders a confirmation page for a signed incident action link embedded in notifications
+// @Tags incidents
+// @Produce html
+// @Param token query string true "Signed incident action token"
+// @Success 200 {string} string "Confirmation page"
+// @Failure 400 {string} string "Invalid or expired link"
+// @Router /incident-actions [get]
+func (h *Handler) ShowIncidentAction(c echo.Context) error {
+	token := c.QueryParam("token")
+	claims, ok := parseIncidentActionToken(token)
+	if !ok {
+		return renderExpiredIncidentAction(c)
+	}
+
+	switch models.IncidentAction(claims.Action) {
+	case models.IncidentActionAcknowledge:
+		return renderIncidentAction(c, http.StatusOK, incidentActionView{
+			Title:   "Acknowledge incident",
+			Message: "Confirm that you are handling this incident. Reminders and escalations will stop.",
+			Confirm: "Acknowledge",
+			Token:   token,
+		})
+	default:
+		return renderIncidentAction(c, http.StatusBadRequest, incidentActionView{
+			Title:   "Unsupported action",
+			Message: "This link cannot be used.",
+		})
+	}
+}
+
+// PerformIncidentAction godoc
+// @Summary Perform a one-click incident action
+// @Description Performs the action authorized by a signed incident action token
+// @Tags incidents
+// @Accept x-www-form-urlencoded
+// @Produce html
+// @Param token formData string true "Signed incident action token"
+// @Success 200 {string} string "Action result page"
+// @Failure 400 {string} string "Invalid or expired link"
+// @Failure 404 {string} string "Incident not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /incident-actions [post]
+func (h *Handler) PerformIncidentAction(c echo.Context) error {
+	claims, ok := parseIncidentActionToken(c.FormValue("token"))
+	if !ok {
+		return renderExpiredIncidentAction(c)
+	}
+
+	incidentID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return renderExpiredIncidentAction(c)
+	}
+
+	teamID, err := strconv.ParseInt(claims.TeamID, 10, 64)
+	if err != nil {
+		return renderExpiredIncidentAction(c)
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	incident, err := h.Repo.GetIncidentByIDForTeam(ctx, tx, teamID, incidentID)
+	if err != nil {
+		zap.L().Error("Failed to get incident", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get incident")
+	}
+
+	if incident == nil {
+		return renderIncidentAction(c, http.StatusNotFound, incidentActionView{
+			Title:   "Incident not found",
+			Message: "The incident no longer exists.",
+		})
+	}
+
+	var view incidentActionView
+	switch models.IncidentAction(claims.Action) {
+	case models.IncidentActionAcknowledge:
+		note := "Acknowledged via notification link"
+		if _, _, err := h.acknowledge(ctx, tx, incident.ID, nil, &note); err != nil {
+			if errors.Is(err, errIncidentNotAcknowledgeable) {
+				return renderIncidentAction(c, http.StatusOK, incidentActionView{
+					Title:   "Already handled",
+					Message: "This incident is already acknowledged or resolved.",
+				})
+			}
+			zap.L().Error("Failed to acknowledge incident", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to acknowledge incident")
+		}
+		view = incidentActionView{Title: "Incident acknowledged", Message: "Thanks, reminders and escalations for this incident have stopped."}
+	default:
+		return renderIncidentAction(c, http.StatusBadRequest, incidentActionView{
+			Title:   "Unsupported action",
+			Message: "This link cannot be used.",
+		})
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return renderIncidentAction(c, http.StatusOK, view)
+}
+
+func parseIncidentActionToken(token string) (encrypt.IncidentActionClaims, bool) {
+	if strings.TrimSpace(token) == "" {
+		return encrypt.IncidentActionClaims{}, false
+	}
+
+	secret := encrypt.JWTSecret{
+		Secret: config.Env().JWTSecretKey,
+	}
+
+	valid, claims, err := secret.ValidateIncidentActionToken(token)
+	if err != nil {
+		zap.L().Warn("Failed to validate incident action token", zap.Error(err))
+		return encrypt.IncidentActionClaims{}, false
+	}
+
+	return claims, valid
+}
+
+func renderIncidentAction(c echo.Context, status int, view incidentActionView) error {
+	var builder strings.Builder
+	if err := incidentActionPage.Execute(&builder, view); err != nil {
+		zap.L().Error("Failed to render incident action page", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render page")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.HTML(status, builder.String())
+}
+
+func renderExpiredIncidentAction(c echo.Context) error {
+	return renderIncidentAction(c, http.StatusBadRequest, incidentActionView{
+		Title:   "Link expired",
+		Message: "This link is invalid or has expired.",
+	})
+}
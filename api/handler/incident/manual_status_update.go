@@ -0,0 +1,38 @@
+package incident
+
+import (
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// publishManualStatusUpdate queues a committed status change or update for the incident's notification
+// channels, which receive it as a manual_status_update event when a routing rule names it.
+// Failures are logged only, since the event itself was already recorded.
+func (h *Handler) publishManualStatusUpdate(teamID int64, incident models.Incident, event models.EventTimeline) {
+	if h.Notifier == nil || event.ID == 0 {
+		return
+	}
+
+	task, err := tasks.NewIncidentManualUpdate(tasks.IncidentManualUpdatePayload{
+		TeamID:     teamID,
+		IncidentID: incident.ID,
+		EventID:    event.ID,
+		Status:     incident.Status,
+		Message:    event.Message,
+	})
+	if err != nil {
+		zap.L().Error("Failed to create incident manual update task", zap.Error(err))
+		return
+	}
+
+	if _, err := h.Notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		zap.L().Error("Failed to enqueue incident manual update task",
+			zap.Int64("incident_id", incident.ID),
+			zap.Int64("event_id", event.ID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,194 @@
+package incident
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"go.uber.org/zap"
+)
+
+// maxSlackInteractionBody caps the interaction payload read before the signature is checked.
+const maxSlackInteractionBody = 1 << 20
+
+// slackInteraction is the subset of a Slack block_actions payload used by the incident buttons.
+type slackInteraction struct {
+	Type string `json:"type"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Message struct {
+		TS   string `json:"ts"`
+		Text string `json:"text"`
+	} `json:"message"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+}
+
+// HandleSlackInteraction godoc
+// @Summary Handle Slack interactive buttons
+// @Description Acknowledges or resolves an incident from the buttons of a Slack notification and updates the original message. Requests must be signed with the Slack app signing secret, carry a button value signed by the server and come from the channel of the notification.
+// @Tags integrations
+// @Accept x-www-form-urlencoded
+// @Produce plain
+// @Param payload formData string true "Slack interaction payload"
+// @Success 200 {string} string "Interaction handled"
+// @Failure 401 {object} response.ErrorResponse "Invalid signature"
+// @Failure 404 {object} response.ErrorResponse "Slack integration not configured"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /integrations/slack/interactions [post]
+func (h *Handler) HandleSlackInteraction(c echo.Context) error {
+	secret := config.Env().SlackSigningSecret
+	if secret == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "Slack integration not configured")
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxSlackInteractionBody))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	header := c.Request().Header
+	if !notificationcore.VerifySlackSignature(secret, header.Get("X-Slack-Request-Timestamp"), header.Get("X-Slack-Signature"), body, time.Now()) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid signature")
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	var interaction slackInteraction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &interaction); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	// Slack only needs a 200 for payloads we do not act on.
+	if interaction.Type != "block_actions" || len(interaction.Actions) == 0 {
+		return c.NoContent(http.StatusOK)
+	}
+
+	action := interaction.Actions[0]
+	teamID, incidentID, notificationID, err := notificationcore.ParseSlackActionValue(config.Env().JWTSecretKey, action.Value)
+	if err != nil {
+		zap.L().Warn("Invalid slack action value", zap.String("value", action.Value), zap.Error(err))
+		return c.NoContent(http.StatusOK)
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	notification, err := h.Repo.GetNotificationByID(ctx, tx, teamID, notificationID)
+	if err != nil {
+		zap.L().Error("Failed to get notification", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification")
+	}
+
+	if notification == nil || notification.Type != models.NotificationTypeSlack {
+		return c.NoContent(http.StatusOK)
+	}
+
+	// The Slack app is shared by every team, so the click must come from the channel this notification posts to.
+	var cfg models.SlackNotificationConfig
+	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
+		zap.L().Error("Failed to decode slack config", zap.Error(err))
+		return c.NoContent(http.StatusOK)
+	}
+
+	if cfg.ChannelID == "" || cfg.ChannelID != interaction.Channel.ID {
+		zap.L().Warn("Slack interaction from a channel the notification does not post to",
+			zap.Int64("notification_id", notification.ID),
+			zap.String("channel_id", interaction.Channel.ID))
+		return c.NoContent(http.StatusOK)
+	}
+
+	incident, err := h.Repo.GetIncidentByIDForTeam(ctx, tx, teamID, incidentID)
+	if err != nil {
+		zap.L().Error("Failed to get incident", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get incident")
+	}
+
+	if incident == nil {
+		return c.NoContent(http.StatusOK)
+	}
+
+	actor := "@" + interaction.User.Username
+	if interaction.User.Username == "" {
+		actor = "<@" + interaction.User.ID + ">"
+	}
+
+	msg := notificationcore.Message{
+		Title:       interaction.Message.Text,
+		TeamID:      teamID,
+		IncidentID:  incident.ID,
+		IncidentURL: notificationcore.IncidentURL(teamID, incident.ID),
+	}
+	if strings.TrimSpace(msg.Title) == "" {
+		msg.Title = "Incident " + strconv.FormatInt(incident.ID, 10)
+	}
+
+	switch action.ActionID {
+	case notificationcore.SlackActionAcknowledge:
+		note := "Acknowledged in Slack by " + actor
+		if _, _, err := h.acknowledge(ctx, tx, incident.ID, nil, &note); err != nil {
+			if errors.Is(err, errIncidentNotAcknowledgeable) {
+				return c.NoContent(http.StatusOK)
+			}
+			zap.L().Error("Failed to acknowledge incident", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to acknowledge incident")
+		}
+		msg.Description = note
+		msg.Status = models.PingStatusFailed
+		msg.EventType = models.NotificationEventTypeDown
+		msg.Acknowledged = true
+	case notificationcore.SlackActionResolve:
+		if incident.Status == models.IncidentStatusResolved {
+			return c.NoContent(http.StatusOK)
+		}
+		message := "Resolved in Slack by " + actor
+		if err := h.resolveFromChat(ctx, tx, incident.ID, message); err != nil {
+			zap.L().Error("Failed to resolve incident", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve incident")
+		}
+		msg.Description = message
+		msg.Status = models.PingStatusSuccessful
+		msg.EventType = models.NotificationEventTypeRecovered
+	default:
+		return c.NoContent(http.StatusOK)
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	// Edit the message the button was clicked on so the channel keeps one message per incident.
+	msg.ReplaceMessageID = notificationcore.SlackMessageID(interaction.Channel.ID, interaction.Message.TS)
+	if _, err := notificationcore.DeliverMessage(ctx, http.DefaultClient, *notification, msg); err != nil {
+		zap.L().Warn("Failed to update slack message",
+			zap.Int64("incident_id", incident.ID),
+			zap.Int64("notification_id", notification.ID),
+			zap.Error(err))
+	}
+
+	return c.NoContent(http.StatusOK)
+}
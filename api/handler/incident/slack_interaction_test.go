@@ -0,0 +1,80 @@
+package incident
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+// signedSlackInteraction builds a block_actions request signed with the Slack signing secret.
+func signedSlackInteraction(t *testing.T, channelID, value string) echo.Context {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]any{
+		"type":    "block_actions",
+		"user":    map[string]string{"id": "U1", "username": "alice"},
+		"channel": map[string]string{"id": channelID},
+		"message": map[string]string{"ts": "1700000000.000100", "text": "api is FAILED"},
+		"actions": []map[string]string{{"action_id": notificationcore.SlackActionAcknowledge, "value": value}},
+	})
+	require.NoError(t, err)
+	body := "payload=" + url.QueryEscape(string(payload))
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(config.Env().SlackSigningSecret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/integrations/slack/interactions", strings.NewReader(body))
+	c.Request().Header.Set("X-Slack-Request-Timestamp", timestamp)
+	c.Request().Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+	return c
+}
+
+func TestHandleSlackInteraction_RejectsForgedButtons(t *testing.T) {
+	testutil.InitTestEnv(t)
+	config.Env().SlackSigningSecret = "slack-secret"
+	t.Cleanup(func() { config.Env().SlackSigningSecret = "" })
+
+	// An unsigned value is dropped before anything is loaded; the mock fails on any call.
+	mockRepo := &repository.MockRepository{}
+	h := &Handler{Repo: mockRepo}
+	require.NoError(t, h.HandleSlackInteraction(signedSlackInteraction(t, "C123", "1:42:7")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHandleSlackInteraction_RejectsOtherChannels(t *testing.T) {
+	testutil.InitTestEnv(t)
+	config.Env().SlackSigningSecret = "slack-secret"
+	t.Cleanup(func() { config.Env().SlackSigningSecret = "" })
+
+	cfg, err := json.Marshal(models.SlackNotificationConfig{BotToken: "xoxb-test", ChannelID: "C123"})
+	require.NoError(t, err)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetNotificationByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.Notification{ID: 7, TeamID: 1, Type: models.NotificationTypeSlack, Config: cfg}, nil)
+	h := &Handler{Repo: mockRepo}
+
+	value := notificationcore.SlackActionValue(config.Env().JWTSecretKey, 1, 42, 7)
+	require.NoError(t, h.HandleSlackInteraction(signedSlackInteraction(t, "C999", value)))
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetIncidentByIDForTeam", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,35 @@
+package incident
+
+import (
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// publishStatusPageUpdate queues a committed timeline event for status page subscribers.
+// Failures are logged only, since the event itself was already recorded.
+func (h *Handler) publishStatusPageUpdate(teamID int64, incident models.Incident, event models.EventTimeline) {
+	if h.Notifier == nil || !incident.IsPublic || event.ID == 0 {
+		return
+	}
+
+	task, err := tasks.NewStatusPageUpdate(tasks.StatusPageUpdatePayload{
+		TeamID:     teamID,
+		IncidentID: incident.ID,
+		EventID:    event.ID,
+	})
+	if err != nil {
+		zap.L().Error("Failed to create status page update task", zap.Error(err))
+		return
+	}
+
+	if _, err := h.Notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		zap.L().Error("Failed to enqueue status page update task",
+			zap.Int64("incident_id", incident.ID),
+			zap.Int64("event_id", event.ID),
+			zap.Error(err))
+	}
+}
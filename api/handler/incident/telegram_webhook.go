@@ -0,0 +1,234 @@
+package incident
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/id"
+	"go.uber.org/zap"
+)
+
+// telegramUpdate is the subset of a Telegram webhook update used by the incident buttons.
+type telegramUpdate struct {
+	CallbackQuery *struct {
+		ID   string `json:"id"`
+		From struct {
+			Username  string `json:"username"`
+			FirstName string `json:"first_name"`
+		} `json:"from"`
+		Message *struct {
+			MessageID int64 `json:"message_id"`
+			Chat      struct {
+				ID       int64  `json:"id"`
+				Username string `json:"username"`
+			} `json:"chat"`
+			Text string `json:"text"`
+		} `json:"message"`
+		Data string `json:"data"`
+	} `json:"callback_query"`
+}
+
+// HandleTelegramWebhook godoc
+// @Summary Handle Telegram inline buttons
+// @Description Acknowledges, resolves or mutes an incident from the buttons of a Telegram notification and edits the original message. Requests must carry the secret token registered with setWebhook, callback data signed by the server and come from the chat of the notification.
+// @Tags integrations
+// @Accept json
+// @Produce plain
+// @Success 200 {string} string "Update handled"
+// @Failure 401 {object} response.ErrorResponse "Invalid secret token"
+// @Failure 404 {object} response.ErrorResponse "Telegram integration not configured"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /integrations/telegram/webhook [post]
+func (h *Handler) HandleTelegramWebhook(c echo.Context) error {
+	secret := config.Env().TelegramWebhookSecret
+	if secret == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "Telegram integration not configured")
+	}
+
+	if !notificationcore.VerifyTelegramSecret(secret, c.Request().Header.Get("X-Telegram-Bot-Api-Secret-Token")) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid secret token")
+	}
+
+	var update telegramUpdate
+	if err := json.NewDecoder(c.Request().Body).Decode(&update); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	// Telegram retries updates that do not get a 200, so everything we ignore is still acknowledged.
+	query := update.CallbackQuery
+	if query == nil || query.Message == nil {
+		return c.NoContent(http.StatusOK)
+	}
+
+	action, teamID, incidentID, notificationID, err := notificationcore.ParseTelegramCallbackData(config.Env().JWTSecretKey, query.Data)
+	if err != nil {
+		zap.L().Warn("Invalid telegram callback data", zap.String("data", query.Data), zap.Error(err))
+		return c.NoContent(http.StatusOK)
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	notification, err := h.Repo.GetNotificationByID(ctx, tx, teamID, notificationID)
+	if err != nil {
+		zap.L().Error("Failed to get notification", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification")
+	}
+
+	if notification == nil || notification.Type != models.NotificationTypeTelegram {
+		return c.NoContent(http.StatusOK)
+	}
+
+	var cfg models.TelegramNotificationConfig
+	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
+		zap.L().Error("Failed to decode telegram config", zap.Error(err))
+		return c.NoContent(http.StatusOK)
+	}
+
+	// The webhook secret is shared by every team, so the press must come from the chat this notification posts to.
+	if !notificationcore.TelegramChatMatches(cfg.ChatID, query.Message.Chat.ID, query.Message.Chat.Username) {
+		zap.L().Warn("Telegram callback from a chat the notification does not post to",
+			zap.Int64("notification_id", notification.ID),
+			zap.Int64("chat_id", query.Message.Chat.ID))
+		return c.NoContent(http.StatusOK)
+	}
+
+	incident, err := h.Repo.GetIncidentByIDForTeam(ctx, tx, teamID, incidentID)
+	if err != nil {
+		zap.L().Error("Failed to get incident", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get incident")
+	}
+
+	if incident == nil || incident.Status == models.IncidentStatusResolved {
+		answerTelegramCallback(c, cfg.BotToken, query.ID, "This incident is already resolved")
+		return c.NoContent(http.StatusOK)
+	}
+
+	stored, err := h.Repo.GetNotificationMessage(ctx, tx, notification.ID, incident.ID)
+	if err != nil {
+		zap.L().Error("Failed to get notification message", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification message")
+	}
+
+	actor := "@" + query.From.Username
+	if query.From.Username == "" {
+		actor = query.From.FirstName
+	}
+
+	messageID := notificationcore.TelegramMessageID(query.Message.Chat.ID, query.Message.MessageID)
+	msg := notificationcore.Message{
+		Title:        telegramTitle(query.Message.Text, incident.ID),
+		Status:       models.PingStatusFailed,
+		TeamID:       teamID,
+		IncidentID:   incident.ID,
+		EventType:    models.NotificationEventTypeDown,
+		Acknowledged: incident.AcknowledgedAt != nil,
+		Muted:        stored != nil && stored.MutedAt != nil,
+		IncidentURL:  notificationcore.IncidentURL(teamID, incident.ID),
+	}
+
+	var answer string
+	switch action {
+	case notificationcore.TelegramActionAcknowledge:
+		note := "Acknowledged in Telegram by " + actor
+		if _, _, err := h.acknowledge(ctx, tx, incident.ID, nil, &note); err != nil {
+			if errors.Is(err, errIncidentNotAcknowledgeable) {
+				answerTelegramCallback(c, cfg.BotToken, query.ID, "This incident is already acknowledged")
+				return c.NoContent(http.StatusOK)
+			}
+			zap.L().Error("Failed to acknowledge incident", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to acknowledge incident")
+		}
+		msg.Description = note
+		msg.Acknowledged = true
+		answer = "Incident acknowledged"
+	case notificationcore.TelegramActionResolve:
+		message := "Resolved in Telegram by " + actor
+		if err := h.resolveFromChat(ctx, tx, incident.ID, message); err != nil {
+			zap.L().Error("Failed to resolve incident", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve incident")
+		}
+		msg.Description = message
+		msg.Status = models.PingStatusSuccessful
+		msg.EventType = models.NotificationEventTypeRecovered
+		answer = "Incident resolved"
+	case notificationcore.TelegramActionMute:
+		rowID, err := id.GetID()
+		if err != nil {
+			zap.L().Error("Failed to generate notification message ID", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate notification message ID")
+		}
+
+		now := time.Now().UTC()
+		if err := h.Repo.MuteNotificationMessage(ctx, tx, models.NotificationMessage{
+			ID:             rowID,
+			NotificationID: notification.ID,
+			IncidentID:     incident.ID,
+			MessageID:      messageID,
+			CreatedAt:      now,
+			MutedAt:        &now,
+		}); err != nil {
+			zap.L().Error("Failed to mute notification message", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to mute incident")
+		}
+		msg.Description = "Reminders muted in this chat by " + actor
+		msg.Muted = true
+		answer = "Reminders muted"
+	default:
+		return c.NoContent(http.StatusOK)
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	answerTelegramCallback(c, cfg.BotToken, query.ID, answer)
+
+	// Edit the message the button was pressed on so the chat keeps one message per incident.
+	msg.ReplaceMessageID = messageID
+	if _, err := notificationcore.DeliverMessage(ctx, http.DefaultClient, *notification, msg); err != nil {
+		zap.L().Warn("Failed to update telegram message",
+			zap.Int64("incident_id", incident.ID),
+			zap.Int64("notification_id", notification.ID),
+			zap.Error(err))
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// telegramTitle recovers the title from the plain text Telegram echoes back, which starts with the status emoji.
+func telegramTitle(text string, incidentID int64) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	for _, emoji := range []string{"🔴", "🟢", "🟡", "⚪"} {
+		if rest, ok := strings.CutPrefix(title, emoji); ok {
+			title = rest
+			break
+		}
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "Incident " + strconv.FormatInt(incidentID, 10)
+	}
+
+	return title
+}
+
+func answerTelegramCallback(c echo.Context, botToken, callbackID, text string) {
+	if err := notificationcore.AnswerTelegramCallback(c.Request().Context(), http.DefaultClient, botToken, callbackID, text); err != nil {
+		zap.L().Warn("Failed to answer telegram callback", zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package incident
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+func TestHandleTelegramWebhook_RejectsInvalidSecret(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: &repository.MockRepository{}}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/integrations/telegram/webhook", strings.NewReader(`{}`))
+	testutil.SetJSONHeader(c)
+	c.Request().Header.Set("X-Telegram-Bot-Api-Secret-Token", "wrong")
+
+	err := h.HandleTelegramWebhook(c)
+	require.Error(t, err)
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	require.Equal(t, http.StatusUnauthorized, httpErr.Code)
+}
+
+func TestHandleTelegramWebhook_IgnoresUpdatesWithoutCallback(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: &repository.MockRepository{}}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/integrations/telegram/webhook", strings.NewReader(`{"update_id":1,"message":{"text":"hi"}}`))
+	testutil.SetJSONHeader(c)
+	c.Request().Header.Set("X-Telegram-Bot-Api-Secret-Token", "telegram-secret")
+
+	err := h.HandleTelegramWebhook(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestHandleTelegramWebhook_RejectsForgedCallbacks(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	// Unsigned callback data is dropped before anything is loaded; the mock fails on any call.
+	mockRepo := &repository.MockRepository{}
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/integrations/telegram/webhook", strings.NewReader(
+		`{"callback_query":{"id":"q1","message":{"message_id":55,"chat":{"id":-100200}},"data":"a:1:42:7"}}`))
+	testutil.SetJSONHeader(c)
+	c.Request().Header.Set("X-Telegram-Bot-Api-Secret-Token", "telegram-secret")
+
+	require.NoError(t, h.HandleTelegramWebhook(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestHandleTelegramWebhook_RejectsOtherChats(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	cfg, err := json.Marshal(models.TelegramNotificationConfig{BotToken: "123:abc", ChatID: "-100200"})
+	require.NoError(t, err)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetNotificationByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.Notification{ID: 7, TeamID: 1, Type: models.NotificationTypeTelegram, Config: cfg}, nil)
+	h := &Handler{Repo: mockRepo}
+
+	data := notificationcore.TelegramCallbackData(config.Env().JWTSecretKey, notificationcore.TelegramActionAcknowledge, 1, 42, 7)
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/integrations/telegram/webhook", strings.NewReader(
+		`{"callback_query":{"id":"q1","message":{"message_id":55,"chat":{"id":-100999}},"data":"`+data+`"}}`))
+	testutil.SetJSONHeader(c)
+	c.Request().Header.Set("X-Telegram-Bot-Api-Secret-Token", "telegram-secret")
+
+	require.NoError(t, h.HandleTelegramWebhook(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "GetIncidentByIDForTeam", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTelegramTitle(t *testing.T) {
+	require.Equal(t, "api is FAILED", telegramTitle("🔴 api is FAILED\n\nStatus: FAILED", 9))
+	require.Equal(t, "Incident 9", telegramTitle("", 9))
+}
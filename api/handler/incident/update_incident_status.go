@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/yorukot/kymarium/models"
 	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
 	"github.com/yorukot/kymarium/utils/response"
 	"go.uber.org/zap"
 )
@@ -117,7 +118,14 @@ func (h *Handler) UpdateIncidentStatus(c echo.Context) error {
 		msg = string(req.Status)
 	}
 
+	eventID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate incident event ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record incident status event")
+	}
+
 	event := models.EventTimeline{
+		ID:         eventID,
 		IncidentID: updatedIncident.ID,
 		CreatedBy:  userID,
 		Message:    msg,
@@ -135,6 +143,9 @@ func (h *Handler) UpdateIncidentStatus(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
 	}
 
+	h.publishStatusPageUpdate(teamID, *updatedIncident, event)
+	h.publishManualStatusUpdate(teamID, *updatedIncident, event)
+
 	resp := struct {
 		Incident models.Incident      `json:"incident"`
 		Event    models.EventTimeline `json:"event"`
@@ -0,0 +1,136 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// CreateMaintenance godoc
+// @Summary Schedule maintenance
+// @Description Announces a maintenance window on a status page (owner/admin only). Subscribers are notified and the scheduler moves it to in progress and completed as the window starts and ends; failed checks of the listed monitors inside the window count as maintenance instead of downtime.
+// @Tags maintenances
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param request body maintenanceRequest true "Maintenance payload"
+// @Success 200 {object} response.SuccessResponse "Maintenance scheduled"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances [post]
+func (h *Handler) CreateMaintenance(c echo.Context) error {
+	teamID, statusPageID, _, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req maintenanceRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	now := time.Now().UTC()
+	if !req.EndsAt.After(now) {
+		return echo.NewHTTPError(http.StatusBadRequest, "ends_at must be in the future")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	monitorIDs, err := h.maintenanceMonitorIDs(ctx, tx, page.ID, req.MonitorIDs)
+	if err != nil {
+		return err
+	}
+
+	maintenanceID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate maintenance ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance")
+	}
+
+	updateID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate maintenance update ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance")
+	}
+
+	maintenance := models.Maintenance{
+		ID:           maintenanceID,
+		TeamID:       page.TeamID,
+		StatusPageID: page.ID,
+		Title:        req.Title,
+		Description:  req.Description,
+		MonitorIDs:   monitorIDs,
+		StartsAt:     req.StartsAt.UTC(),
+		EndsAt:       req.EndsAt.UTC(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	maintenance.Status = statuspagecore.MaintenanceStatusAt(maintenance, now)
+
+	update := models.MaintenanceUpdate{
+		ID:            updateID,
+		MaintenanceID: maintenance.ID,
+		Status:        maintenance.Status,
+		Message:       statuspagecore.MaintenanceStatusMessage(maintenance.Status),
+		CreatedAt:     now,
+	}
+
+	if err := h.Repo.CreateMaintenance(ctx, tx, maintenance); err != nil {
+		zap.L().Error("Failed to create maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance")
+	}
+
+	if err := h.Repo.CreateMaintenanceUpdate(ctx, tx, update); err != nil {
+		zap.L().Error("Failed to create maintenance update", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	h.publishMaintenanceUpdate(maintenance, update)
+
+	return c.JSON(http.StatusOK, response.Success("Maintenance scheduled", maintenanceResponse{
+		Maintenance: maintenance,
+		Updates:     []models.MaintenanceUpdate{update},
+	}))
+}
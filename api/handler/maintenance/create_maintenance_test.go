@@ -0,0 +1,191 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestCreateMaintenance_Success(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.StatusPage{ID: 7, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 7, MonitorID: 100}}, nil)
+	var captured models.Maintenance
+	mockRepo.On("CreateMaintenance", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		captured = args.Get(2).(models.Maintenance)
+	})
+	var capturedUpdate models.MaintenanceUpdate
+	mockRepo.On("CreateMaintenanceUpdate", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		capturedUpdate = args.Get(2).(models.MaintenanceUpdate)
+	})
+
+	startsAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	body := fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":["100","100"]}`,
+		startsAt.Format(time.RFC3339), startsAt.Add(2*time.Hour).Format(time.RFC3339))
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	require.NoError(t, h.CreateMaintenance(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, int64(7), captured.StatusPageID)
+	require.Equal(t, int64(1), captured.TeamID)
+	require.Equal(t, models.MaintenanceStatusScheduled, captured.Status)
+	require.Equal(t, []int64{100}, captured.MonitorIDs)
+	require.True(t, startsAt.Equal(captured.StartsAt))
+	require.Equal(t, captured.ID, capturedUpdate.MaintenanceID)
+	require.Equal(t, models.MaintenanceStatusScheduled, capturedUpdate.Status)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "Maintenance scheduled", resp["message"])
+}
+
+func TestCreateMaintenance_StartedWindowIsInProgress(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleOwner}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.StatusPage{ID: 7, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 7, MonitorID: 100}}, nil)
+	var captured models.Maintenance
+	mockRepo.On("CreateMaintenance", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		captured = args.Get(2).(models.Maintenance)
+	})
+	mockRepo.On("CreateMaintenanceUpdate", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	now := time.Now()
+	body := fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":[]}`,
+		now.Add(-time.Hour).Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339))
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	require.NoError(t, h.CreateMaintenance(c))
+	require.Equal(t, models.MaintenanceStatusInProgress, captured.Status)
+	require.Empty(t, captured.MonitorIDs)
+}
+
+func TestCreateMaintenance_InvalidWindow(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: &repository.MockRepository{}}
+	now := time.Now()
+
+	body := fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":[]}`,
+		now.Add(2*time.Hour).Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339))
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	err := h.CreateMaintenance(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.Code)
+
+	body = fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":[]}`,
+		now.Add(-2*time.Hour).Format(time.RFC3339), now.Add(-time.Hour).Format(time.RFC3339))
+	c, _ = testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	err = h.CreateMaintenance(c)
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.Code)
+	require.Equal(t, "ends_at must be in the future", httpErr.Message)
+}
+
+func TestCreateMaintenance_MonitorNotOnPage(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(1), int64(7)).
+		Return(&models.StatusPage{ID: 7, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(7)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 7, MonitorID: 100}}, nil)
+
+	startsAt := time.Now().Add(time.Hour)
+	body := fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":["100","200"]}`,
+		startsAt.Format(time.RFC3339), startsAt.Add(time.Hour).Format(time.RFC3339))
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	err := h.CreateMaintenance(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.Code)
+	mockRepo.AssertNotCalled(t, "CreateMaintenance", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateMaintenance_MemberForbidden(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleMember}, nil)
+
+	startsAt := time.Now().Add(time.Hour)
+	body := fmt.Sprintf(`{"title":"Database upgrade","starts_at":%q,"ends_at":%q,"monitor_ids":[]}`,
+		startsAt.Format(time.RFC3339), startsAt.Add(time.Hour).Format(time.RFC3339))
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/status-pages/7/maintenances", strings.NewReader(body))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "7")
+
+	err := h.CreateMaintenance(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusForbidden, httpErr.Code)
+}
@@ -0,0 +1,112 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type createMaintenanceUpdateRequest struct {
+	Message string `json:"message" validate:"required,min=1,max=5000"`
+}
+
+// CreateMaintenanceUpdate godoc
+// @Summary Post a maintenance update
+// @Description Adds a message to the timeline of a maintenance at its current status and notifies subscribers (owner/admin only)
+// @Tags maintenances
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param maintenanceID path string true "Maintenance ID"
+// @Param request body createMaintenanceUpdateRequest true "Maintenance update payload"
+// @Success 200 {object} response.SuccessResponse "Maintenance update created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Maintenance not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances/{maintenanceID}/updates [post]
+func (h *Handler) CreateMaintenanceUpdate(c echo.Context) error {
+	teamID, statusPageID, maintenanceID, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req createMaintenanceUpdateRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	maintenance, err := h.Repo.GetMaintenanceByID(ctx, tx, page.ID, maintenanceID)
+	if err != nil {
+		zap.L().Error("Failed to get maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get maintenance")
+	}
+
+	if maintenance == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Maintenance not found")
+	}
+
+	updateID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate maintenance update ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance update")
+	}
+
+	update := models.MaintenanceUpdate{
+		ID:            updateID,
+		MaintenanceID: maintenance.ID,
+		Status:        maintenance.Status,
+		Message:       req.Message,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	if err := h.Repo.CreateMaintenanceUpdate(ctx, tx, update); err != nil {
+		zap.L().Error("Failed to create maintenance update", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create maintenance update")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	h.publishMaintenanceUpdate(*maintenance, update)
+
+	return c.JSON(http.StatusOK, response.Success("Maintenance update created", update))
+}
@@ -0,0 +1,71 @@
+package maintenance
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteMaintenance godoc
+// @Summary Delete a maintenance
+// @Description Removes a maintenance and its timeline from a status page (owner/admin only). Its window no longer excuses failed checks.
+// @Tags maintenances
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param maintenanceID path string true "Maintenance ID"
+// @Success 200 {object} response.SuccessResponse "Maintenance deleted"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Maintenance not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances/{maintenanceID} [delete]
+func (h *Handler) DeleteMaintenance(c echo.Context) error {
+	teamID, statusPageID, maintenanceID, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Repo.DeleteMaintenance(ctx, tx, page.ID, maintenanceID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "Maintenance not found")
+		}
+		zap.L().Error("Failed to delete maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete maintenance")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Maintenance deleted"))
+}
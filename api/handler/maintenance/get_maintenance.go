@@ -0,0 +1,84 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// GetMaintenance godoc
+// @Summary Get a maintenance
+// @Description Returns a maintenance of a status page with its timeline
+// @Tags maintenances
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param maintenanceID path string true "Maintenance ID"
+// @Success 200 {object} response.SuccessResponse "Maintenance retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Maintenance not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances/{maintenanceID} [get]
+func (h *Handler) GetMaintenance(c echo.Context) error {
+	teamID, statusPageID, maintenanceID, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, false)
+	if err != nil {
+		return err
+	}
+
+	maintenance, err := h.Repo.GetMaintenanceByID(ctx, tx, page.ID, maintenanceID)
+	if err != nil {
+		zap.L().Error("Failed to get maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get maintenance")
+	}
+
+	if maintenance == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Maintenance not found")
+	}
+
+	updates, err := h.Repo.ListMaintenanceUpdatesByMaintenanceIDs(ctx, tx, []int64{maintenance.ID})
+	if err != nil {
+		zap.L().Error("Failed to list maintenance updates", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list maintenance updates")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if updates == nil {
+		updates = []models.MaintenanceUpdate{}
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Maintenance retrieved", maintenanceResponse{
+		Maintenance: *maintenance,
+		Updates:     updates,
+	}))
+}
@@ -0,0 +1,12 @@
+package maintenance
+
+import (
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// Handler handles scheduled maintenance requests of status pages.
+type Handler struct {
+	Repo     repository.Repository
+	Notifier *asynq.Client
+}
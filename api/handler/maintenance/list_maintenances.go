@@ -0,0 +1,70 @@
+package maintenance
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListMaintenances godoc
+// @Summary List maintenances
+// @Description Lists the scheduled, in-progress and completed maintenances of a status page, latest window first
+// @Tags maintenances
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Success 200 {object} response.SuccessResponse "Maintenances retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances [get]
+func (h *Handler) ListMaintenances(c echo.Context) error {
+	teamID, statusPageID, _, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, false)
+	if err != nil {
+		return err
+	}
+
+	maintenances, err := h.Repo.ListMaintenancesByStatusPageID(ctx, tx, page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list maintenances", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list maintenances")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if maintenances == nil {
+		maintenances = []models.Maintenance{}
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Maintenances retrieved", maintenances))
+}
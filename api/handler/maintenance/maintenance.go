@@ -0,0 +1,136 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+type maintenanceRequest struct {
+	Title       string       `json:"title" validate:"required,min=1,max=255"`
+	Description string       `json:"description" validate:"max=5000"`
+	StartsAt    time.Time    `json:"starts_at" validate:"required"`
+	EndsAt      time.Time    `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	MonitorIDs  utils.IDList `json:"monitor_ids"`
+}
+
+type maintenanceResponse struct {
+	models.Maintenance
+	Updates []models.MaintenanceUpdate `json:"updates"`
+}
+
+// parseMaintenanceParams reads the team, status page and, when present, maintenance IDs of the path.
+func parseMaintenanceParams(c echo.Context) (teamID, statusPageID, maintenanceID int64, err error) {
+	teamID, err = strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err = strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	if c.Param("maintenanceID") != "" {
+		maintenanceID, err = strconv.ParseInt(c.Param("maintenanceID"), 10, 64)
+		if err != nil {
+			return 0, 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid maintenance ID")
+		}
+	}
+
+	return teamID, statusPageID, maintenanceID, nil
+}
+
+// statusPageForMember loads a team's status page for a member; writes additionally need an owner or admin.
+// Errors are already HTTP errors.
+func (h *Handler) statusPageForMember(ctx context.Context, tx pgx.Tx, teamID, statusPageID, userID int64, write bool) (*models.StatusPage, error) {
+	member, err := h.Repo.GetTeamMemberByUserID(ctx, tx, teamID, userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if write && member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "You do not have permission to manage maintenance for this team")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(ctx, tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	return page, nil
+}
+
+// maintenanceMonitorIDs deduplicates the requested monitors and checks that each one is shown on the page.
+// Errors are already HTTP errors.
+func (h *Handler) maintenanceMonitorIDs(ctx context.Context, tx pgx.Tx, statusPageID int64, requested utils.IDList) ([]int64, error) {
+	monitorIDs := utils.UniqueInt64s(requested.Int64s())
+	if len(monitorIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	pageMonitors, err := h.Repo.ListStatusPageMonitorsByStatusPageID(ctx, tx, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to list status page monitors", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page monitors")
+	}
+
+	onPage := make(map[int64]struct{}, len(pageMonitors))
+	for _, monitor := range pageMonitors {
+		onPage[monitor.MonitorID] = struct{}{}
+	}
+	for _, monitorID := range monitorIDs {
+		if _, ok := onPage[monitorID]; !ok {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Monitor %d is not on this status page", monitorID))
+		}
+	}
+
+	return monitorIDs, nil
+}
+
+// publishMaintenanceUpdate queues a committed timeline entry for status page subscribers.
+// Failures are logged only, since the entry itself was already recorded.
+func (h *Handler) publishMaintenanceUpdate(maintenance models.Maintenance, update models.MaintenanceUpdate) {
+	if h.Notifier == nil {
+		return
+	}
+
+	task, err := tasks.NewMaintenanceUpdate(tasks.MaintenanceUpdatePayload{
+		TeamID:        maintenance.TeamID,
+		StatusPageID:  maintenance.StatusPageID,
+		MaintenanceID: maintenance.ID,
+		UpdateID:      update.ID,
+	})
+	if err != nil {
+		zap.L().Error("Failed to create maintenance update task", zap.Error(err))
+		return
+	}
+
+	if _, err := h.Notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		zap.L().Error("Failed to enqueue maintenance update task",
+			zap.Int64("maintenance_id", maintenance.ID),
+			zap.Int64("update_id", update.ID),
+			zap.Error(err))
+	}
+}
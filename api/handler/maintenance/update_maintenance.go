@@ -0,0 +1,144 @@
+package maintenance
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateMaintenance godoc
+// @Summary Update a maintenance
+// @Description Replaces the announcement, monitors and window of a maintenance that has not completed (owner/admin only). Moving the window so it has started or ended changes the status right away, e.g. setting ends_at to now completes the maintenance early.
+// @Tags maintenances
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param maintenanceID path string true "Maintenance ID"
+// @Param request body maintenanceRequest true "Maintenance payload"
+// @Success 200 {object} response.SuccessResponse "Maintenance updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Maintenance not found"
+// @Failure 409 {object} response.ErrorResponse "Maintenance already completed"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/maintenances/{maintenanceID} [put]
+func (h *Handler) UpdateMaintenance(c echo.Context) error {
+	teamID, statusPageID, maintenanceID, err := parseMaintenanceParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req maintenanceRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.statusPageForMember(ctx, tx, teamID, statusPageID, *userID, true)
+	if err != nil {
+		return err
+	}
+
+	existing, err := h.Repo.GetMaintenanceByID(ctx, tx, page.ID, maintenanceID)
+	if err != nil {
+		zap.L().Error("Failed to get maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get maintenance")
+	}
+
+	if existing == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Maintenance not found")
+	}
+
+	if existing.Status == models.MaintenanceStatusCompleted {
+		return echo.NewHTTPError(http.StatusConflict, "Completed maintenance cannot be changed")
+	}
+
+	monitorIDs, err := h.maintenanceMonitorIDs(ctx, tx, page.ID, req.MonitorIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	maintenance := *existing
+	maintenance.Title = req.Title
+	maintenance.Description = req.Description
+	maintenance.MonitorIDs = monitorIDs
+	maintenance.StartsAt = req.StartsAt.UTC()
+	maintenance.EndsAt = req.EndsAt.UTC()
+	maintenance.Status = statuspagecore.MaintenanceStatusAt(maintenance, now)
+	maintenance.UpdatedAt = now
+
+	updated, err := h.Repo.UpdateMaintenance(ctx, tx, maintenance)
+	if err != nil {
+		zap.L().Error("Failed to update maintenance", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update maintenance")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Maintenance not found")
+	}
+
+	// A moved window that changes the status gets the same timeline entry the scheduler would record.
+	var update *models.MaintenanceUpdate
+	if updated.Status != existing.Status {
+		updateID, err := id.GetID()
+		if err != nil {
+			zap.L().Error("Failed to generate maintenance update ID", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update maintenance")
+		}
+
+		update = &models.MaintenanceUpdate{
+			ID:            updateID,
+			MaintenanceID: updated.ID,
+			Status:        updated.Status,
+			Message:       statuspagecore.MaintenanceStatusMessage(updated.Status),
+			CreatedAt:     now,
+		}
+		if err := h.Repo.CreateMaintenanceUpdate(ctx, tx, *update); err != nil {
+			zap.L().Error("Failed to create maintenance update", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update maintenance")
+		}
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if update != nil {
+		h.publishMaintenanceUpdate(*updated, *update)
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Maintenance updated", updated))
+}
@@ -0,0 +1,25 @@
+package monitor
+
+import (
+	"github.com/yorukot/kymarium/core/badge"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+// monitorBadgeTokenLength is long enough that badge URLs cannot be guessed.
+const monitorBadgeTokenLength = 32
+
+type monitorBadgeResponse struct {
+	Enabled bool    `json:"enabled"`
+	Token   *string `json:"token,omitempty"`
+	URL     *string `json:"url,omitempty"`
+}
+
+func newMonitorBadgeResponse(stored *models.MonitorBadge) monitorBadgeResponse {
+	if stored == nil {
+		return monitorBadgeResponse{Enabled: false}
+	}
+
+	badgeURL := badge.MonitorBadgeURL(config.Env().BackendURL, stored.Token)
+	return monitorBadgeResponse{Enabled: true, Token: &stored.Token, URL: &badgeURL}
+}
@@ -3,6 +3,7 @@ package monitor
 import (
 	"encoding/json"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/yorukot/kymarium/models"
@@ -10,23 +11,27 @@ import (
 )
 
 type monitorResponse struct {
-	ID                string               `json:"id"`
-	TeamID            string               `json:"team_id"`
-	Name              string               `json:"name"`
-	Type              models.MonitorType   `json:"type"`
-	Config            json.RawMessage      `json:"config"`
-	Interval          int                  `json:"interval"`
-	Status            models.MonitorStatus `json:"status"`
-	UptimeSLI30       *float64             `json:"uptime_sli_30,omitempty"`
-	LastChecked       time.Time            `json:"last_checked"`
-	NextCheck         time.Time            `json:"next_check"`
-	FailureThreshold  int16                `json:"failure_threshold"`
-	RecoveryThreshold int16                `json:"recovery_threshold"`
-	RegionIDs         []string             `json:"regions"`
-	NotificationIDs   []string             `json:"notification"`
-	Incidents         []incidentResponse   `json:"incidents,omitempty"`
-	UpdatedAt         time.Time            `json:"updated_at"`
-	CreatedAt         time.Time            `json:"created_at"`
+	ID                 string               `json:"id"`
+	TeamID             string               `json:"team_id"`
+	Name               string               `json:"name"`
+	Type               models.MonitorType   `json:"type"`
+	Config             json.RawMessage      `json:"config"`
+	Interval           int                  `json:"interval"`
+	Status             models.MonitorStatus `json:"status"`
+	UptimeSLI30        *float64             `json:"uptime_sli_30,omitempty"`
+	LastChecked        time.Time            `json:"last_checked"`
+	NextCheck          time.Time            `json:"next_check"`
+	FailureThreshold   int16                `json:"failure_threshold"`
+	RecoveryThreshold  int16                `json:"recovery_threshold"`
+	ReminderInterval   int                  `json:"reminder_interval"`
+	ReminderMaxCount   int                  `json:"reminder_max_count"`
+	Tags               []string             `json:"tags"`
+	EscalationPolicyID *string              `json:"escalation_policy_id,omitempty"`
+	RegionIDs          []string             `json:"regions"`
+	NotificationIDs    []string             `json:"notification"`
+	Incidents          []incidentResponse   `json:"incidents,omitempty"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+	CreatedAt          time.Time            `json:"created_at"`
 }
 
 type incidentResponse struct {
@@ -49,23 +54,27 @@ func newMonitorResponse(m models.Monitor) monitorResponse {
 
 func newMonitorResponseWithUptime(m models.Monitor, uptimeSLI30 *float64) monitorResponse {
 	return monitorResponse{
-		ID:                strconv.FormatInt(m.ID, 10),
-		TeamID:            strconv.FormatInt(m.TeamID, 10),
-		Name:              m.Name,
-		Type:              m.Type,
-		Config:            m.Config,
-		Interval:          m.Interval,
-		Status:            m.Status,
-		UptimeSLI30:       uptimeSLI30,
-		LastChecked:       m.LastChecked,
-		NextCheck:         m.NextCheck,
-		FailureThreshold:  m.FailureThreshold,
-		RecoveryThreshold: m.RecoveryThreshold,
-		RegionIDs:         formatRegionIDs(m.RegionIDs),
-		NotificationIDs:   formatNotificationIDs(m.NotificationIDs),
-		Incidents:         []incidentResponse{},
-		UpdatedAt:         m.UpdatedAt,
-		CreatedAt:         m.CreatedAt,
+		ID:                 strconv.FormatInt(m.ID, 10),
+		TeamID:             strconv.FormatInt(m.TeamID, 10),
+		Name:               m.Name,
+		Type:               m.Type,
+		Config:             m.Config,
+		Interval:           m.Interval,
+		Status:             m.Status,
+		UptimeSLI30:        uptimeSLI30,
+		LastChecked:        m.LastChecked,
+		NextCheck:          m.NextCheck,
+		FailureThreshold:   m.FailureThreshold,
+		RecoveryThreshold:  m.RecoveryThreshold,
+		ReminderInterval:   m.ReminderInterval,
+		ReminderMaxCount:   m.ReminderMaxCount,
+		Tags:               normalizeTags(m.Tags),
+		EscalationPolicyID: formatOptionalID(m.EscalationPolicyID),
+		RegionIDs:          formatRegionIDs(m.RegionIDs),
+		NotificationIDs:    formatNotificationIDs(m.NotificationIDs),
+		Incidents:          []incidentResponse{},
+		UpdatedAt:          m.UpdatedAt,
+		CreatedAt:          m.CreatedAt,
 	}
 }
 
@@ -107,6 +116,24 @@ func formatNotificationIDs(ids []int64) []string {
 	return result
 }
 
+// normalizeTags trims, lowercases and de-duplicates monitor tags.
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result
+}
+
 func formatRegionIDs(ids []int64) []string {
 	if len(ids) == 0 {
 		return []string{}
@@ -119,6 +146,15 @@ func formatRegionIDs(ids []int64) []string {
 	return result
 }
 
+func formatOptionalID(id *int64) *string {
+	if id == nil {
+		return nil
+	}
+
+	formatted := strconv.FormatInt(*id, 10)
+	return &formatted
+}
+
 func formatIncidents(monitorID int64, incidents []models.Incident) []incidentResponse {
 	if len(incidents) == 0 {
 		return []incidentResponse{}
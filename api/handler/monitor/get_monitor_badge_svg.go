@@ -0,0 +1,94 @@
+package monitor
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/core/badge"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// GetMonitorBadgeSVG godoc
+// @Summary Get monitor badge SVG
+// @Description Renders a shields-style SVG badge of a monitor with an enabled public badge. The label defaults to the metric so the monitor name is never exposed.
+// @Tags monitors
+// @Produce image/svg+xml
+// @Param token path string true "Badge token"
+// @Param metric query string false "status (default), uptime or response_time"
+// @Param period query int false "Uptime/response time window in days: 30 (default), 60 or 90"
+// @Param style query string false "flat (default), flat-square or for-the-badge"
+// @Param label query string false "Custom label"
+// @Success 200 {string} string "SVG badge"
+// @Failure 400 {object} response.ErrorResponse "Invalid badge options"
+// @Failure 404 {object} response.ErrorResponse "Badge not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /badges/monitors/{token}/badge.svg [get]
+func (h *Handler) GetMonitorBadgeSVG(c echo.Context) error {
+	opts, err := badge.ParseOptions(c.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	stored, err := h.Repo.GetMonitorBadgeByToken(ctx, tx, c.Param("token"))
+	if err != nil {
+		zap.L().Error("Failed to get monitor badge", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get monitor badge")
+	}
+
+	if stored == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Badge not found")
+	}
+
+	monitor, err := h.Repo.GetMonitorByID(ctx, tx, stored.TeamID, stored.MonitorID)
+	if err != nil {
+		zap.L().Error("Failed to get monitor", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get monitor")
+	}
+
+	if monitor == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Badge not found")
+	}
+
+	var result badge.Badge
+	start, end := badge.Window(opts.Period, time.Now())
+	switch opts.Metric {
+	case badge.MetricUptime:
+		summaries, err := h.Repo.ListMonitorDailySummaryByMonitorIDs(ctx, tx, []int64{monitor.ID}, start, end)
+		if err != nil {
+			zap.L().Error("Failed to list daily summaries", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get monitor uptime")
+		}
+		good, total := badge.CountChecks(summaries)
+		result = badge.Uptime(opts, good, total)
+	case badge.MetricResponseTime:
+		latency, err := h.Repo.GetAverageLatencyByMonitorIDs(ctx, tx, []int64{monitor.ID}, start, end)
+		if err != nil {
+			zap.L().Error("Failed to get average latency", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get monitor response time")
+		}
+		result = badge.ResponseTime(opts, latency)
+	default:
+		status := badge.StatusUp
+		if monitor.Status == models.MonitorStatusDown {
+			status = badge.StatusDown
+		}
+		result = badge.Status(opts, status)
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	c.Response().Header().Set("Cache-Control", "public, max-age="+badge.MaxAge)
+	return c.Blob(http.StatusOK, badge.ContentType, badge.Render(result, opts.Style))
+}
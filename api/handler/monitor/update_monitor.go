@@ -17,14 +17,18 @@ import (
 )
 
 type updateMonitorRequest struct {
-	Name              string             `json:"name" validate:"required,min=1,max=255"`
-	Type              models.MonitorType `json:"type" validate:"required,oneof=http ping"`
-	Interval          int                `json:"interval" validate:"required,min=30,max=2592000"`
-	Config            json.RawMessage    `json:"config" validate:"required"`
-	FailureThreshold  int16              `json:"failure_threshold" validate:"required,gt=0"`
-	RecoveryThreshold int16              `json:"recovery_threshold" validate:"required,gt=0"`
-	Regions           regionIDList       `json:"regions" validate:"required,min=1"`
-	NotificationIDs   notificationIDList `json:"notification"`
+	Name               string             `json:"name" validate:"required,min=1,max=255"`
+	Type               models.MonitorType `json:"type" validate:"required,oneof=http ping"`
+	Interval           int                `json:"interval" validate:"required,min=30,max=2592000"`
+	Config             json.RawMessage    `json:"config" validate:"required"`
+	FailureThreshold   int16              `json:"failure_threshold" validate:"required,gt=0"`
+	RecoveryThreshold  int16              `json:"recovery_threshold" validate:"required,gt=0"`
+	ReminderInterval   int                `json:"reminder_interval" validate:"omitempty,min=300,max=86400"`
+	ReminderMaxCount   int                `json:"reminder_max_count" validate:"required_with=ReminderInterval,omitempty,min=1,max=100"`
+	Tags               []string           `json:"tags" validate:"omitempty,max=20,dive,required,max=50"`
+	EscalationPolicyID *int64             `json:"escalation_policy_id,string,omitempty"`
+	Regions            regionIDList       `json:"regions" validate:"required,min=1"`
+	NotificationIDs    notificationIDList `json:"notification"`
 }
 
 // UpdateMonitor godoc
@@ -124,23 +128,39 @@ func (h *Handler) UpdateMonitor(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "One or more regions do not exist")
 	}
 
+	if req.EscalationPolicyID != nil {
+		policy, err := h.Repo.GetEscalationPolicyByID(c.Request().Context(), tx, teamID, *req.EscalationPolicyID)
+		if err != nil {
+			zap.L().Error("Failed to get escalation policy", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get escalation policy")
+		}
+
+		if policy == nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Escalation policy does not exist")
+		}
+	}
+
 	notificationIDs := req.NotificationIDs.Int64s()
 	monitor := models.Monitor{
-		ID:                monitorID,
-		TeamID:            teamID,
-		Name:              req.Name,
-		Type:              req.Type,
-		Status:            existing.Status, // preserve current status when updating config
-		Interval:          req.Interval,
-		Config:            req.Config,
-		LastChecked:       existing.LastChecked,
-		NextCheck:         now.Add(time.Duration(req.Interval) * time.Second),
-		FailureThreshold:  req.FailureThreshold,
-		RecoveryThreshold: req.RecoveryThreshold,
-		RegionIDs:         regionIDs,
-		NotificationIDs:   notificationIDs,
-		UpdatedAt:         now,
-		CreatedAt:         existing.CreatedAt,
+		ID:                 monitorID,
+		TeamID:             teamID,
+		Name:               req.Name,
+		Type:               req.Type,
+		Status:             existing.Status, // preserve current status when updating config
+		Interval:           req.Interval,
+		Config:             req.Config,
+		LastChecked:        existing.LastChecked,
+		NextCheck:          now.Add(time.Duration(req.Interval) * time.Second),
+		FailureThreshold:   req.FailureThreshold,
+		RecoveryThreshold:  req.RecoveryThreshold,
+		ReminderInterval:   req.ReminderInterval,
+		ReminderMaxCount:   req.ReminderMaxCount,
+		Tags:               normalizeTags(req.Tags),
+		EscalationPolicyID: req.EscalationPolicyID,
+		RegionIDs:          regionIDs,
+		NotificationIDs:    notificationIDs,
+		UpdatedAt:          now,
+		CreatedAt:          existing.CreatedAt,
 	}
 
 	updated, err := h.Repo.UpdateMonitor(c.Request().Context(), tx, monitor)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
 	"github.com/yorukot/kymarium/models"
 	authutil "github.com/yorukot/kymarium/utils/auth"
 	"github.com/yorukot/kymarium/utils/id"
@@ -16,9 +17,13 @@ import (
 )
 
 type createNotificationRequest struct {
-	Type   models.NotificationType `json:"type" validate:"required,oneof=discord telegram slack email"`
-	Name   string                  `json:"name" validate:"required,min=1,max=255"`
-	Config json.RawMessage         `json:"config" validate:"required"`
+	Type           models.NotificationType          `json:"type" validate:"required,oneof=discord telegram slack email oncall"`
+	Name           string                           `json:"name" validate:"required,min=1,max=255"`
+	Config         json.RawMessage                  `json:"config" validate:"required"`
+	RoutingRules   []models.NotificationRoutingRule `json:"routing_rules" validate:"omitempty,max=20,dive"`
+	RateLimit      *int                             `json:"rate_limit" validate:"omitempty,min=0,max=1000"`
+	DigestInterval *int                             `json:"digest_interval" validate:"omitempty,min=60,max=3600"`
+	QuietHours     json.RawMessage                  `json:"quiet_hours"`
 }
 
 // New godoc
@@ -55,10 +60,15 @@ func (h *Handler) New(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Notification config is required")
 	}
 
-	if err := validateNotificationConfig(req.Type, req.Config); err != nil {
+	if err := notificationcore.ValidateConfig(req.Type, req.Config); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid notification config")
 	}
 
+	quietHours, err := notificationcore.ParseQuietHours(req.QuietHours)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid quiet hours")
+	}
+
 	userID, err := authutil.GetUserIDFromContext(c)
 	if err != nil {
 		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
@@ -90,6 +100,16 @@ func (h *Handler) New(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to create notifications for this team")
 	}
 
+	exists, err := h.onCallScheduleExists(c.Request().Context(), tx, teamID, req.Type, req.Config)
+	if err != nil {
+		zap.L().Error("Failed to get on-call schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get on-call schedule")
+	}
+
+	if !exists {
+		return echo.NewHTTPError(http.StatusBadRequest, "On-call schedule does not exist")
+	}
+
 	notificationID, err := id.GetID()
 	if err != nil {
 		zap.L().Error("Failed to generate notification ID", zap.Error(err))
@@ -97,14 +117,33 @@ func (h *Handler) New(c echo.Context) error {
 	}
 
 	now := time.Now()
+	routingRules := req.RoutingRules
+	if routingRules == nil {
+		routingRules = []models.NotificationRoutingRule{}
+	}
+
+	rateLimit := models.DefaultNotificationRateLimit
+	if req.RateLimit != nil {
+		rateLimit = *req.RateLimit
+	}
+
+	digestInterval := models.DefaultNotificationDigestInterval
+	if req.DigestInterval != nil {
+		digestInterval = *req.DigestInterval
+	}
+
 	notification := models.Notification{
-		ID:        notificationID,
-		TeamID:    teamID,
-		Type:      req.Type,
-		Name:      req.Name,
-		Config:    req.Config,
-		UpdatedAt: now,
-		CreatedAt: now,
+		ID:             notificationID,
+		TeamID:         teamID,
+		Type:           req.Type,
+		Name:           req.Name,
+		Config:         req.Config,
+		RoutingRules:   routingRules,
+		RateLimit:      rateLimit,
+		DigestInterval: digestInterval,
+		QuietHours:     quietHours,
+		UpdatedAt:      now,
+		CreatedAt:      now,
 	}
 
 	if err := h.Repo.CreateNotification(c.Request().Context(), tx, notification); err != nil {
@@ -1,8 +1,12 @@
 package notification
 
-import "github.com/yorukot/kymarium/repository"
+import (
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/repository"
+)
 
 // Handler handles notification-related requests.
 type Handler struct {
-	Repo repository.Repository
+	Repo     repository.Repository
+	Notifier *asynq.Client
 }
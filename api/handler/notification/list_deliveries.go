@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 200
+)
+
+// ListDeliveries godoc
+// @Summary List notification deliveries
+// @Description Lists recent delivery attempts for a notification, newest first
+// @Tags notifications
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Notification ID"
+// @Param limit query int false "Maximum number of deliveries to return (default 50, max 200)"
+// @Success 200 {object} response.SuccessResponse "Notification deliveries retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID, notification ID or limit"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Notification not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/notifications/{id}/deliveries [get]
+func (h *Handler) ListDeliveries(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	notificationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid notification ID")
+	}
+
+	limit := defaultDeliveryLimit
+	if limitParam := c.QueryParam("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed <= 0 || parsed > maxDeliveryLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid limit")
+		}
+		limit = parsed
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
+	}
+
+	notification, err := h.Repo.GetNotificationByID(c.Request().Context(), tx, teamID, notificationID)
+	if err != nil {
+		zap.L().Error("Failed to get notification", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification")
+	}
+
+	if notification == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
+	}
+
+	deliveries, err := h.Repo.ListNotificationDeliveriesByNotificationID(c.Request().Context(), tx, notificationID, limit)
+	if err != nil {
+		zap.L().Error("Failed to list notification deliveries", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list notification deliveries")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Notification deliveries retrieved successfully", deliveries))
+}
@@ -0,0 +1,124 @@
+package notification
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// ResendDelivery godoc
+// @Summary Resend a notification delivery
+// @Description Re-enqueues a previously attempted delivery using its original payload (owner/admin only)
+// @Tags notifications
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Notification ID"
+// @Param deliveryID path string true "Delivery ID"
+// @Success 200 {object} response.SuccessResponse "Notification delivery resent successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid IDs or digest delivery"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Notification delivery not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/notifications/{id}/deliveries/{deliveryID}/resend [post]
+func (h *Handler) ResendDelivery(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	notificationID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid notification ID")
+	}
+
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid delivery ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification delivery not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to resend notifications for this team")
+	}
+
+	notification, err := h.Repo.GetNotificationByID(c.Request().Context(), tx, teamID, notificationID)
+	if err != nil {
+		zap.L().Error("Failed to get notification", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification")
+	}
+
+	if notification == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification delivery not found")
+	}
+
+	delivery, err := h.Repo.GetNotificationDeliveryByID(c.Request().Context(), tx, notificationID, deliveryID)
+	if err != nil {
+		zap.L().Error("Failed to get notification delivery", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get notification delivery")
+	}
+
+	if delivery == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Notification delivery not found")
+	}
+
+	if delivery.MonitorID == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Digest deliveries cannot be resent")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	var payload tasks.NotificationPayload
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		zap.L().Error("Failed to decode notification delivery payload", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resend notification delivery")
+	}
+
+	task, err := tasks.NewNotificationDispatch(payload)
+	if err != nil {
+		zap.L().Error("Failed to create notification task", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resend notification delivery")
+	}
+
+	if _, err := h.Notifier.Enqueue(task); err != nil {
+		zap.L().Error("Failed to enqueue notification task", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resend notification delivery")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Notification delivery resent successfully"))
+}
@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// onCallScheduleExists reports whether an on-call channel points at a schedule of the team.
+// Other channel types have no schedule and always pass.
+func (h *Handler) onCallScheduleExists(ctx context.Context, tx pgx.Tx, teamID int64, notificationType models.NotificationType, raw json.RawMessage) (bool, error) {
+	if notificationType != models.NotificationTypeOnCall {
+		return true, nil
+	}
+
+	var cfg models.OnCallNotificationConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return false, nil
+	}
+
+	schedule, err := h.Repo.GetOnCallScheduleByID(ctx, tx, teamID, cfg.ScheduleID)
+	if err != nil {
+		return false, err
+	}
+
+	return schedule != nil, nil
+}
@@ -79,6 +79,11 @@ func (h *Handler) TestNotification(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
 	}
 
+	// Paging whoever is on call would wake a real person; test their contact methods instead.
+	if notification.Type == models.NotificationTypeOnCall {
+		return echo.NewHTTPError(http.StatusBadRequest, "On-call channels cannot be tested directly")
+	}
+
 	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
 	}
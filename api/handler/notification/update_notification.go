@@ -9,6 +9,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
 	"github.com/yorukot/kymarium/models"
 	authutil "github.com/yorukot/kymarium/utils/auth"
 	"github.com/yorukot/kymarium/utils/response"
@@ -16,9 +17,13 @@ import (
 )
 
 type updateNotificationRequest struct {
-	Type   models.NotificationType `json:"type" validate:"omitempty,oneof=discord telegram slack email"`
-	Name   string                  `json:"name" validate:"omitempty,min=1,max=255"`
-	Config json.RawMessage         `json:"config"`
+	Type           models.NotificationType          `json:"type" validate:"omitempty,oneof=discord telegram slack email oncall"`
+	Name           string                           `json:"name" validate:"omitempty,min=1,max=255"`
+	Config         json.RawMessage                  `json:"config"`
+	RoutingRules   []models.NotificationRoutingRule `json:"routing_rules" validate:"omitempty,max=20,dive"`
+	RateLimit      *int                             `json:"rate_limit" validate:"omitempty,min=0,max=1000"`
+	DigestInterval *int                             `json:"digest_interval" validate:"omitempty,min=60,max=3600"`
+	QuietHours     json.RawMessage                  `json:"quiet_hours"`
 }
 
 // UpdateNotification godoc
@@ -61,10 +66,15 @@ func (h *Handler) UpdateNotification(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Notification config cannot be empty")
 	}
 
-	if err := validateNotificationConfig(req.Type, req.Config); err != nil {
+	if err := notificationcore.ValidateConfig(req.Type, req.Config); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid notification config")
 	}
 
+	quietHours, err := notificationcore.ParseQuietHours(req.QuietHours)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid quiet hours")
+	}
+
 	userID, err := authutil.GetUserIDFromContext(c)
 	if err != nil {
 		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
@@ -106,9 +116,36 @@ func (h *Handler) UpdateNotification(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Notification not found")
 	}
 
+	exists, err := h.onCallScheduleExists(c.Request().Context(), tx, teamID, req.Type, req.Config)
+	if err != nil {
+		zap.L().Error("Failed to get on-call schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get on-call schedule")
+	}
+
+	if !exists {
+		return echo.NewHTTPError(http.StatusBadRequest, "On-call schedule does not exist")
+	}
+
 	existing.Type = req.Type
 	existing.Name = req.Name
 	existing.Config = req.Config
+	// Routing rules are only replaced when provided; send an empty list to clear them.
+	if req.RoutingRules != nil {
+		existing.RoutingRules = req.RoutingRules
+	}
+
+	if req.RateLimit != nil {
+		existing.RateLimit = *req.RateLimit
+	}
+
+	if req.DigestInterval != nil {
+		existing.DigestInterval = *req.DigestInterval
+	}
+
+	// Quiet hours are only replaced when provided; send null to remove them.
+	if len(req.QuietHours) > 0 {
+		existing.QuietHours = quietHours
+	}
 
 	existing.UpdatedAt = time.Now()
 
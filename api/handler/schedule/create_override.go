@@ -0,0 +1,138 @@
+package schedule
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type createOverrideRequest struct {
+	UserID   int64     `json:"user_id,string" validate:"required"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+	EndsAt   time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+}
+
+// CreateOverride godoc
+// @Summary Create an on-call override
+// @Description Temporarily puts a team member on call. Owners and admins can override anyone; other members only themselves.
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Schedule ID"
+// @Param request body createOverrideRequest true "Override create request"
+// @Success 200 {object} response.SuccessResponse "Override created successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or IDs"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Schedule not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules/{id}/overrides [post]
+func (h *Handler) CreateOverride(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	scheduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid schedule ID")
+	}
+
+	var req createOverrideRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	isManager := member.Role == models.MemberRoleOwner || member.Role == models.MemberRoleAdmin
+	if !isManager && (member.Role == models.MemberRoleViewer || req.UserID != *userID) {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to override this schedule")
+	}
+
+	schedule, err := h.Repo.GetOnCallScheduleByID(c.Request().Context(), tx, teamID, scheduleID)
+	if err != nil {
+		zap.L().Error("Failed to get schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get schedule")
+	}
+
+	if schedule == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	target, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, req.UserID)
+	if err != nil {
+		zap.L().Error("Failed to get override member", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get override member")
+	}
+
+	if target == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Override user does not belong to this team")
+	}
+
+	overrideID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate override ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate override ID")
+	}
+
+	override := models.OnCallOverride{
+		ID:         overrideID,
+		ScheduleID: schedule.ID,
+		UserID:     req.UserID,
+		StartsAt:   req.StartsAt.UTC(),
+		EndsAt:     req.EndsAt.UTC(),
+		CreatedBy:  userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	if err := h.Repo.CreateOnCallOverride(c.Request().Context(), tx, override); err != nil {
+		zap.L().Error("Failed to create override", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create override")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Override created successfully", override))
+}
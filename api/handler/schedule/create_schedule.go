@@ -0,0 +1,127 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// CreateSchedule godoc
+// @Summary Create an on-call schedule
+// @Description Creates an on-call schedule with rotation layers for the given team (owner/admin only)
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param request body scheduleUpsertRequest true "Schedule create request"
+// @Success 200 {object} response.SuccessResponse "Schedule created successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or team ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules [post]
+func (h *Handler) CreateSchedule(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	var req scheduleUpsertRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	req = normalizeScheduleUpsert(req)
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Team not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to create schedules for this team")
+	}
+
+	if err := validateLayerMembers(c.Request().Context(), h.Repo, tx, teamID, req.Layers); err != nil {
+		if errors.Is(err, errUnknownMember) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to validate layer members", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate layer members")
+	}
+
+	scheduleID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate schedule ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate schedule ID")
+	}
+
+	now := time.Now()
+	schedule := models.OnCallSchedule{
+		ID:          scheduleID,
+		TeamID:      teamID,
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := h.Repo.CreateOnCallSchedule(c.Request().Context(), tx, schedule); err != nil {
+		zap.L().Error("Failed to create schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create schedule")
+	}
+
+	layers, err := buildLayers(req.Layers, schedule.ID)
+	if err != nil {
+		zap.L().Error("Failed to build schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to build schedule layers")
+	}
+
+	if err := h.Repo.CreateOnCallLayers(c.Request().Context(), tx, layers); err != nil {
+		zap.L().Error("Failed to create schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create schedule layers")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Schedule created successfully", newScheduleResponse(schedule, layers)))
+}
@@ -0,0 +1,101 @@
+package schedule
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteOverride godoc
+// @Summary Delete an on-call override
+// @Description Removes an override from a schedule (owner/admin only)
+// @Tags schedules
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Schedule ID"
+// @Param overrideID path string true "Override ID"
+// @Success 200 {object} response.SuccessResponse "Override deleted successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid IDs"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Override not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules/{id}/overrides/{overrideID} [delete]
+func (h *Handler) DeleteOverride(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	scheduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid schedule ID")
+	}
+
+	overrideID, err := strconv.ParseInt(c.Param("overrideID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid override ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Override not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to delete overrides for this schedule")
+	}
+
+	schedule, err := h.Repo.GetOnCallScheduleByID(c.Request().Context(), tx, teamID, scheduleID)
+	if err != nil {
+		zap.L().Error("Failed to get schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get schedule")
+	}
+
+	if schedule == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Override not found")
+	}
+
+	if err := h.Repo.DeleteOnCallOverride(c.Request().Context(), tx, schedule.ID, overrideID); err != nil {
+		if err == pgx.ErrNoRows {
+			return echo.NewHTTPError(http.StatusNotFound, "Override not found")
+		}
+
+		zap.L().Error("Failed to delete override", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete override")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Override deleted successfully"))
+}
@@ -0,0 +1,152 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils"
+	"github.com/yorukot/kymarium/utils/id"
+)
+
+const startDateLayout = "2006-01-02"
+
+var errUnknownMember = errors.New("layer member does not belong to this team")
+
+type layerInput struct {
+	Name         string       `json:"name" validate:"required,min=1,max=255"`
+	StartDate    string       `json:"start_date" validate:"required,datetime=2006-01-02"`
+	HandoffTime  string       `json:"handoff_time" validate:"required,datetime=15:04"`
+	RotationDays int          `json:"rotation_days" validate:"required,min=1,max=365"`
+	UserIDs      utils.IDList `json:"user_ids" validate:"required,min=1,max=50"`
+}
+
+type scheduleUpsertRequest struct {
+	Name        string       `json:"name" validate:"required,min=1,max=255"`
+	Description *string      `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Timezone    string       `json:"timezone" validate:"required,timezone"`
+	Layers      []layerInput `json:"layers" validate:"omitempty,max=10,dive"`
+}
+
+type layerResponse struct {
+	ID           string   `json:"id"`
+	Position     int      `json:"position"`
+	Name         string   `json:"name"`
+	StartDate    string   `json:"start_date"`
+	HandoffTime  string   `json:"handoff_time"`
+	RotationDays int      `json:"rotation_days"`
+	UserIDs      []string `json:"user_ids"`
+}
+
+type scheduleResponse struct {
+	ID          string                  `json:"id"`
+	TeamID      string                  `json:"team_id"`
+	Name        string                  `json:"name"`
+	Description *string                 `json:"description,omitempty"`
+	Timezone    string                  `json:"timezone"`
+	Layers      []layerResponse         `json:"layers"`
+	Overrides   []models.OnCallOverride `json:"overrides,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+// normalizeScheduleUpsert trims the name and drops a blank description.
+func normalizeScheduleUpsert(req scheduleUpsertRequest) scheduleUpsertRequest {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Timezone = strings.TrimSpace(req.Timezone)
+	if req.Description != nil {
+		trimmed := strings.TrimSpace(*req.Description)
+		if trimmed == "" {
+			req.Description = nil
+		} else {
+			req.Description = &trimmed
+		}
+	}
+	for i := range req.Layers {
+		req.Layers[i].Name = strings.TrimSpace(req.Layers[i].Name)
+		req.Layers[i].UserIDs = utils.UniqueInt64s(req.Layers[i].UserIDs.Int64s())
+	}
+	return req
+}
+
+// validateLayerMembers ensures every rotation member belongs to the team.
+func validateLayerMembers(ctx context.Context, repo repository.Repository, tx pgx.Tx, teamID int64, layers []layerInput) error {
+	for i, layer := range layers {
+		for _, userID := range layer.UserIDs {
+			member, err := repo.GetTeamMemberByUserID(ctx, tx, teamID, userID)
+			if err != nil {
+				return err
+			}
+			if member == nil {
+				return fmt.Errorf("layer %d: %w", i+1, errUnknownMember)
+			}
+		}
+	}
+	return nil
+}
+
+// buildLayers assigns IDs and positions in request order; later layers take precedence.
+func buildLayers(layers []layerInput, scheduleID int64) ([]models.OnCallLayer, error) {
+	result := make([]models.OnCallLayer, 0, len(layers))
+	for i, layer := range layers {
+		layerID, err := id.GetID()
+		if err != nil {
+			return nil, err
+		}
+		startDate, err := time.Parse(startDateLayout, layer.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, models.OnCallLayer{
+			ID:           layerID,
+			ScheduleID:   scheduleID,
+			Position:     i + 1,
+			Name:         layer.Name,
+			StartDate:    startDate,
+			HandoffTime:  layer.HandoffTime,
+			RotationDays: layer.RotationDays,
+			UserIDs:      layer.UserIDs.Int64s(),
+		})
+	}
+	return result, nil
+}
+
+func newScheduleResponse(schedule models.OnCallSchedule, layers []models.OnCallLayer) scheduleResponse {
+	resp := scheduleResponse{
+		ID:          strconv.FormatInt(schedule.ID, 10),
+		TeamID:      strconv.FormatInt(schedule.TeamID, 10),
+		Name:        schedule.Name,
+		Description: schedule.Description,
+		Timezone:    schedule.Timezone,
+		Layers:      make([]layerResponse, 0, len(layers)),
+		CreatedAt:   schedule.CreatedAt,
+		UpdatedAt:   schedule.UpdatedAt,
+	}
+
+	for _, layer := range layers {
+		if layer.ScheduleID != schedule.ID {
+			continue
+		}
+		userIDs := make([]string, len(layer.UserIDs))
+		for i, userID := range layer.UserIDs {
+			userIDs[i] = strconv.FormatInt(userID, 10)
+		}
+		resp.Layers = append(resp.Layers, layerResponse{
+			ID:           strconv.FormatInt(layer.ID, 10),
+			Position:     layer.Position,
+			Name:         layer.Name,
+			StartDate:    layer.StartDate.Format(startDateLayout),
+			HandoffTime:  layer.HandoffTime,
+			RotationDays: layer.RotationDays,
+			UserIDs:      userIDs,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,132 @@
+package schedule
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/core/oncall"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type onCallResponse struct {
+	ScheduleID  string        `json:"schedule_id"`
+	At          time.Time     `json:"at"`
+	Shift       *oncall.Shift `json:"shift"`
+	DisplayName *string       `json:"display_name,omitempty"`
+}
+
+// GetOnCall godoc
+// @Summary Get who is on call
+// @Description Resolves the on-call user of a schedule at the given time (defaults to now). Overrides win over layers.
+// @Tags schedules
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Schedule ID"
+// @Param at query string false "RFC3339 timestamp"
+// @Success 200 {object} response.SuccessResponse "On-call user retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID, schedule ID or timestamp"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Schedule not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules/{id}/oncall [get]
+func (h *Handler) GetOnCall(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	scheduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid schedule ID")
+	}
+
+	at := time.Now().UTC()
+	if raw := c.QueryParam("at"); raw != "" {
+		at, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid at timestamp")
+		}
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	schedule, err := h.Repo.GetOnCallScheduleByID(c.Request().Context(), tx, teamID, scheduleID)
+	if err != nil {
+		zap.L().Error("Failed to get schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get schedule")
+	}
+
+	if schedule == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	layers, err := h.Repo.ListOnCallLayersByScheduleIDs(c.Request().Context(), tx, []int64{schedule.ID})
+	if err != nil {
+		zap.L().Error("Failed to list schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedule layers")
+	}
+
+	overrides, err := h.Repo.ListOnCallOverridesByScheduleID(c.Request().Context(), tx, schedule.ID, at)
+	if err != nil {
+		zap.L().Error("Failed to list schedule overrides", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedule overrides")
+	}
+
+	shift, err := oncall.Resolve(*schedule, layers, overrides, at)
+	if err != nil {
+		zap.L().Error("Failed to resolve on-call user", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve on-call user")
+	}
+
+	resp := onCallResponse{
+		ScheduleID: strconv.FormatInt(schedule.ID, 10),
+		At:         at,
+		Shift:      shift,
+	}
+
+	if shift != nil {
+		user, err := h.Repo.GetUserByID(c.Request().Context(), tx, shift.UserID)
+		if err != nil {
+			zap.L().Error("Failed to get on-call user", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get on-call user")
+		}
+		if user != nil {
+			resp.DisplayName = &user.DisplayName
+		}
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("On-call user retrieved successfully", resp))
+}
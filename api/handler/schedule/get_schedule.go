@@ -0,0 +1,95 @@
+package schedule
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// GetSchedule godoc
+// @Summary Get an on-call schedule
+// @Description Retrieves an on-call schedule with its layers and current or upcoming overrides
+// @Tags schedules
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Schedule ID"
+// @Success 200 {object} response.SuccessResponse "Schedule retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID or schedule ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Schedule not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules/{id} [get]
+func (h *Handler) GetSchedule(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	scheduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid schedule ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	schedule, err := h.Repo.GetOnCallScheduleByID(c.Request().Context(), tx, teamID, scheduleID)
+	if err != nil {
+		zap.L().Error("Failed to get schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get schedule")
+	}
+
+	if schedule == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	layers, err := h.Repo.ListOnCallLayersByScheduleIDs(c.Request().Context(), tx, []int64{schedule.ID})
+	if err != nil {
+		zap.L().Error("Failed to list schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedule layers")
+	}
+
+	overrides, err := h.Repo.ListOnCallOverridesByScheduleID(c.Request().Context(), tx, schedule.ID, time.Now())
+	if err != nil {
+		zap.L().Error("Failed to list schedule overrides", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedule overrides")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	resp := newScheduleResponse(*schedule, layers)
+	resp.Overrides = overrides
+
+	return c.JSON(http.StatusOK, response.Success("Schedule retrieved successfully", resp))
+}
@@ -0,0 +1,8 @@
+package schedule
+
+import "github.com/yorukot/kymarium/repository"
+
+// Handler handles on-call schedule requests.
+type Handler struct {
+	Repo repository.Repository
+}
@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListSchedules godoc
+// @Summary List on-call schedules
+// @Description Lists on-call schedules and their layers for a team the user belongs to
+// @Tags schedules
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Success 200 {object} response.SuccessResponse "Schedules retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules [get]
+func (h *Handler) ListSchedules(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Team not found")
+	}
+
+	schedules, err := h.Repo.ListOnCallSchedulesByTeamID(c.Request().Context(), tx, teamID)
+	if err != nil {
+		zap.L().Error("Failed to list schedules", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedules")
+	}
+
+	scheduleIDs := make([]int64, 0, len(schedules))
+	for _, schedule := range schedules {
+		scheduleIDs = append(scheduleIDs, schedule.ID)
+	}
+
+	layers, err := h.Repo.ListOnCallLayersByScheduleIDs(c.Request().Context(), tx, scheduleIDs)
+	if err != nil {
+		zap.L().Error("Failed to list schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list schedule layers")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	resp := make([]scheduleResponse, 0, len(schedules))
+	for _, schedule := range schedules {
+		resp = append(resp, newScheduleResponse(schedule, layers))
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Schedules retrieved successfully", resp))
+}
@@ -0,0 +1,132 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateSchedule godoc
+// @Summary Update an on-call schedule
+// @Description Replaces an on-call schedule and its layers (owner/admin only); overrides are kept
+// @Tags schedules
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Schedule ID"
+// @Param request body scheduleUpsertRequest true "Schedule update request"
+// @Success 200 {object} response.SuccessResponse "Schedule updated successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or IDs"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Schedule not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/schedules/{id} [put]
+func (h *Handler) UpdateSchedule(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	scheduleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid schedule ID")
+	}
+
+	var req scheduleUpsertRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	req = normalizeScheduleUpsert(req)
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to update this schedule")
+	}
+
+	if err := validateLayerMembers(c.Request().Context(), h.Repo, tx, teamID, req.Layers); err != nil {
+		if errors.Is(err, errUnknownMember) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to validate layer members", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate layer members")
+	}
+
+	updated, err := h.Repo.UpdateOnCallSchedule(c.Request().Context(), tx, models.OnCallSchedule{
+		ID:          scheduleID,
+		TeamID:      teamID,
+		Name:        req.Name,
+		Description: req.Description,
+		Timezone:    req.Timezone,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		zap.L().Error("Failed to update schedule", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update schedule")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Schedule not found")
+	}
+
+	if err := h.Repo.DeleteOnCallLayersByScheduleID(c.Request().Context(), tx, updated.ID); err != nil {
+		zap.L().Error("Failed to delete schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete schedule layers")
+	}
+
+	layers, err := buildLayers(req.Layers, updated.ID)
+	if err != nil {
+		zap.L().Error("Failed to build schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to build schedule layers")
+	}
+
+	if err := h.Repo.CreateOnCallLayers(c.Request().Context(), tx, layers); err != nil {
+		zap.L().Error("Failed to create schedule layers", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create schedule layers")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Schedule updated successfully", newScheduleResponse(*updated, layers)))
+}
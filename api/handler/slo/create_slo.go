@@ -0,0 +1,108 @@
+package slo
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// CreateSLO godoc
+// @Summary Create an SLO
+// @Description Creates a service level objective over one or more monitors of the team (owner/admin only): a target_pct such as 99.9, a rolling (window_days) or calendar (week, month or quarter, UTC) window, and an availability or latency (latency_percentile within latency_threshold_ms) indicator. The response includes the current error budget and burn rates.
+// @Tags slos
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param request body sloRequest true "SLO definition"
+// @Success 200 {object} response.SuccessResponse "SLO created"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/slos [post]
+func (h *Handler) CreateSLO(c echo.Context) error {
+	teamID, _, err := parseSLOParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req sloRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := checkSLORequest(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.checkTeamMember(ctx, tx, teamID, *userID, true); err != nil {
+		return err
+	}
+
+	monitorIDs, err := h.sloMonitorIDs(ctx, tx, teamID, req.MonitorIDs)
+	if err != nil {
+		return err
+	}
+
+	sloID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate SLO ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create SLO")
+	}
+
+	now := time.Now().UTC()
+	slo := models.SLO{
+		ID:        sloID,
+		TeamID:    teamID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	applySLORequest(&slo, req, monitorIDs)
+
+	if err := h.Repo.CreateSLO(ctx, tx, slo); err != nil {
+		zap.L().Error("Failed to create SLO", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create SLO")
+	}
+
+	resp, err := h.evaluateSLO(ctx, tx, slo, now)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("SLO created", resp))
+}
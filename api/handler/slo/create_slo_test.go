@@ -0,0 +1,182 @@
+package slo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestCreateSLO_Success(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("ListMonitorsByIDs", mock.Anything, mock.Anything, int64(1), []int64{100}).
+		Return([]models.Monitor{{ID: 100, TeamID: 1}}, nil)
+	var captured models.SLO
+	mockRepo.On("CreateSLO", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		captured = args.Get(2).(models.SLO)
+	})
+	mockRepo.On("ListSLOBuckets", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]models.SLOBucket{}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/teams/1/slos",
+		strings.NewReader(`{"name":"API latency","monitor_ids":["100","100"],"indicator":"latency","latency_threshold_ms":300,"latency_percentile":95,"target_pct":99.5,"window_type":"calendar","calendar_period":"month"}`))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID")
+	c.SetParamValues("1")
+
+	require.NoError(t, h.CreateSLO(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, int64(1), captured.TeamID)
+	require.Equal(t, []int64{100}, captured.MonitorIDs)
+	require.Equal(t, models.SLOIndicatorLatency, captured.Indicator)
+	require.Equal(t, 300, *captured.LatencyThresholdMs)
+	require.Equal(t, 95, *captured.LatencyPercentile)
+	require.Equal(t, 99.5, captured.TargetPct)
+	require.Equal(t, models.SLOCalendarMonth, *captured.CalendarPeriod)
+	require.Nil(t, captured.WindowDays)
+
+	var resp struct {
+		Message string `json:"message"`
+		Data    struct {
+			Status struct {
+				SLIPct                  *float64 `json:"sli_pct"`
+				ErrorBudgetRemainingPct float64  `json:"error_budget_remaining_pct"`
+			} `json:"status"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "SLO created", resp.Message)
+	require.Nil(t, resp.Data.Status.SLIPct)
+	require.Equal(t, float64(100), resp.Data.Status.ErrorBudgetRemainingPct)
+}
+
+func TestCreateSLO_InvalidDefinition(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleAdmin}, nil)
+	mockRepo.On("ListMonitorsByIDs", mock.Anything, mock.Anything, int64(1), []int64{100}).
+		Return([]models.Monitor{{ID: 100, TeamID: 1}}, nil)
+	mockRepo.On("ListMonitorsByIDs", mock.Anything, mock.Anything, int64(1), []int64{100, 200}).
+		Return([]models.Monitor{{ID: 100, TeamID: 1}}, nil)
+	h := &Handler{Repo: mockRepo}
+
+	for _, body := range []string{
+		`{"name":"API","monitor_ids":[],"indicator":"availability","target_pct":99.9,"window_type":"rolling","window_days":30}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"availability","target_pct":100,"window_type":"rolling","window_days":30}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"latency","latency_threshold_ms":300,"target_pct":99,"window_type":"rolling","window_days":30}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"latency","latency_threshold_ms":300,"latency_percentile":42,"target_pct":99,"window_type":"rolling","window_days":30}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"availability","latency_percentile":95,"target_pct":99,"window_type":"rolling","window_days":30}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"availability","target_pct":99.9,"window_type":"rolling"}`,
+		`{"name":"API","monitor_ids":["100"],"indicator":"availability","target_pct":99.9,"window_type":"calendar","calendar_period":"month","window_days":30}`,
+		`{"name":"API","monitor_ids":["100","200"],"indicator":"availability","target_pct":99.9,"window_type":"rolling","window_days":30}`,
+	} {
+		c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/slos", strings.NewReader(body))
+		testutil.SetJSONHeader(c)
+		testutil.Authenticate(c, 123)
+		c.SetParamNames("teamID")
+		c.SetParamValues("1")
+
+		err := h.CreateSLO(c)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr, body)
+		require.Equal(t, http.StatusBadRequest, httpErr.Code, body)
+	}
+	mockRepo.AssertNotCalled(t, "CreateSLO", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateSLO_MemberForbidden(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleMember}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/teams/1/slos",
+		strings.NewReader(`{"name":"API","monitor_ids":["100"],"indicator":"availability","target_pct":99.9,"window_type":"rolling","window_days":30}`))
+	testutil.SetJSONHeader(c)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID")
+	c.SetParamValues("1")
+
+	err := h.CreateSLO(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusForbidden, httpErr.Code)
+}
+
+func TestGetSLO(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	days := 30
+	slo := models.SLO{ID: 9, TeamID: 1, Name: "API", MonitorIDs: []int64{100}, Indicator: models.SLOIndicatorAvailability, TargetPct: 99, WindowType: models.SLOWindowRolling, WindowDays: &days}
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(1), int64(123)).
+		Return(&models.TeamMember{TeamID: 1, UserID: 123, Role: models.MemberRoleMember}, nil)
+	mockRepo.On("GetSLOByID", mock.Anything, mock.Anything, int64(1), int64(9)).Return(&slo, nil)
+	mockRepo.On("GetSLOByID", mock.Anything, mock.Anything, int64(1), int64(10)).Return(nil, nil)
+	mockRepo.On("ListSLOBuckets", mock.Anything, mock.Anything, slo, mock.Anything, mock.Anything).
+		Return([]models.SLOBucket{{Bucket: time.Now().UTC().Add(-48 * time.Hour), TotalCount: 1000, GoodCount: 995}}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/teams/1/slos/9", nil)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "9")
+
+	require.NoError(t, h.GetSLO(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data struct {
+			ID     string `json:"id"`
+			Status struct {
+				SLIPct                  float64 `json:"sli_pct"`
+				ErrorBudgetRemainingPct float64 `json:"error_budget_remaining_pct"`
+				BurnRate                float64 `json:"burn_rate"`
+			} `json:"status"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "9", resp.Data.ID)
+	require.InDelta(t, 99.5, resp.Data.Status.SLIPct, 1e-9)
+	require.InDelta(t, 50, resp.Data.Status.ErrorBudgetRemainingPct, 1e-9)
+	require.InDelta(t, 0.5, resp.Data.Status.BurnRate, 1e-9)
+
+	c, _ = testutil.NewEchoContext(http.MethodGet, "/teams/1/slos/10", nil)
+	testutil.Authenticate(c, 123)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("1", "10")
+
+	err := h.GetSLO(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusNotFound, httpErr.Code)
+}
@@ -0,0 +1,69 @@
+package slo
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteSLO godoc
+// @Summary Delete an SLO
+// @Description Deletes an SLO of the team (owner/admin only). Monitor data is kept.
+// @Tags slos
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "SLO ID"
+// @Success 200 {object} response.SuccessResponse "SLO deleted"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "SLO not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/slos/{id} [delete]
+func (h *Handler) DeleteSLO(c echo.Context) error {
+	teamID, sloID, err := parseSLOParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.checkTeamMember(ctx, tx, teamID, *userID, true); err != nil {
+		return err
+	}
+
+	if err := h.Repo.DeleteSLO(ctx, tx, teamID, sloID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return echo.NewHTTPError(http.StatusNotFound, "SLO not found")
+		}
+		zap.L().Error("Failed to delete SLO", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete SLO")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("SLO deleted"))
+}
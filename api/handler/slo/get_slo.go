@@ -0,0 +1,74 @@
+package slo
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// GetSLO godoc
+// @Summary Get an SLO
+// @Description Fetches an SLO with its current window, SLI, remaining error budget and burn rate, plus burn rates over the last 1h, 6h and 24h
+// @Tags slos
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "SLO ID"
+// @Success 200 {object} response.SuccessResponse "SLO retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "SLO not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/slos/{id} [get]
+func (h *Handler) GetSLO(c echo.Context) error {
+	teamID, sloID, err := parseSLOParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.checkTeamMember(ctx, tx, teamID, *userID, false); err != nil {
+		return err
+	}
+
+	slo, err := h.Repo.GetSLOByID(ctx, tx, teamID, sloID)
+	if err != nil {
+		zap.L().Error("Failed to get SLO", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get SLO")
+	}
+
+	if slo == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "SLO not found")
+	}
+
+	resp, err := h.evaluateSLO(ctx, tx, *slo, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("SLO retrieved", resp))
+}
@@ -0,0 +1,8 @@
+package slo
+
+import "github.com/yorukot/kymarium/repository"
+
+// Handler handles SLO requests.
+type Handler struct {
+	Repo repository.Repository
+}
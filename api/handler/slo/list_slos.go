@@ -0,0 +1,74 @@
+package slo
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListSLOs godoc
+// @Summary List SLOs
+// @Description Lists the SLOs of a team by name, each with its current error budget and burn rates
+// @Tags slos
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Success 200 {object} response.SuccessResponse "SLOs retrieved"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Team not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/slos [get]
+func (h *Handler) ListSLOs(c echo.Context) error {
+	teamID, _, err := parseSLOParams(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.checkTeamMember(ctx, tx, teamID, *userID, false); err != nil {
+		return err
+	}
+
+	slos, err := h.Repo.ListSLOsByTeamID(ctx, tx, teamID)
+	if err != nil {
+		zap.L().Error("Failed to list SLOs", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list SLOs")
+	}
+
+	now := time.Now().UTC()
+	resp := make([]sloResponse, 0, len(slos))
+	for _, slo := range slos {
+		item, err := h.evaluateSLO(ctx, tx, slo, now)
+		if err != nil {
+			return err
+		}
+		resp = append(resp, item)
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("SLOs retrieved", resp))
+}
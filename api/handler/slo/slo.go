@@ -0,0 +1,150 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	slocore "github.com/yorukot/kymarium/core/slo"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils"
+	"go.uber.org/zap"
+)
+
+type sloRequest struct {
+	Name        string       `json:"name" validate:"required,min=1,max=255"`
+	Description string       `json:"description" validate:"max=1000"`
+	MonitorIDs  utils.IDList `json:"monitor_ids" validate:"min=1,max=100"`
+	// Indicator is availability or latency; latency SLOs also need latency_threshold_ms and latency_percentile.
+	Indicator          models.SLOIndicator `json:"indicator" validate:"required,oneof=availability latency"`
+	LatencyThresholdMs *int                `json:"latency_threshold_ms,omitempty" validate:"omitempty,min=1,max=60000"`
+	LatencyPercentile  *int                `json:"latency_percentile,omitempty" validate:"omitempty,oneof=50 75 90 95 99"`
+	TargetPct          float64             `json:"target_pct" validate:"gt=0,lt=100"`
+	// WindowType is rolling, with window_days, or calendar, with calendar_period.
+	WindowType     models.SLOWindowType      `json:"window_type" validate:"required,oneof=rolling calendar"`
+	WindowDays     *int                      `json:"window_days,omitempty" validate:"omitempty,min=1,max=365"`
+	CalendarPeriod *models.SLOCalendarPeriod `json:"calendar_period,omitempty" validate:"omitempty,oneof=week month quarter"`
+}
+
+type sloResponse struct {
+	models.SLO
+	Status slocore.Status `json:"status"`
+}
+
+// parseSLOParams reads the team and, when present, SLO IDs of the path.
+func parseSLOParams(c echo.Context) (teamID, sloID int64, err error) {
+	teamID, err = strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	if c.Param("id") != "" {
+		sloID, err = strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid SLO ID")
+		}
+	}
+
+	return teamID, sloID, nil
+}
+
+// checkSLORequest validates the fields of a request that depend on each other.
+func checkSLORequest(req sloRequest) error {
+	switch req.Indicator {
+	case models.SLOIndicatorLatency:
+		if req.LatencyThresholdMs == nil || req.LatencyPercentile == nil {
+			return fmt.Errorf("latency SLOs need latency_threshold_ms and latency_percentile")
+		}
+	default:
+		if req.LatencyThresholdMs != nil || req.LatencyPercentile != nil {
+			return fmt.Errorf("latency_threshold_ms and latency_percentile are only allowed for latency SLOs")
+		}
+	}
+
+	switch req.WindowType {
+	case models.SLOWindowRolling:
+		if req.WindowDays == nil || req.CalendarPeriod != nil {
+			return fmt.Errorf("rolling windows need window_days and no calendar_period")
+		}
+	default:
+		if req.CalendarPeriod == nil || req.WindowDays != nil {
+			return fmt.Errorf("calendar windows need calendar_period and no window_days")
+		}
+	}
+
+	return nil
+}
+
+// applySLORequest copies the definition of a request onto an SLO.
+func applySLORequest(slo *models.SLO, req sloRequest, monitorIDs []int64) {
+	slo.Name = req.Name
+	slo.Description = req.Description
+	slo.MonitorIDs = monitorIDs
+	slo.Indicator = req.Indicator
+	slo.LatencyThresholdMs = req.LatencyThresholdMs
+	slo.LatencyPercentile = req.LatencyPercentile
+	slo.TargetPct = req.TargetPct
+	slo.WindowType = req.WindowType
+	slo.WindowDays = req.WindowDays
+	slo.CalendarPeriod = req.CalendarPeriod
+}
+
+// checkTeamMember makes sure the user is a member of the team; writes additionally need an owner or admin.
+// Errors are already HTTP errors.
+func (h *Handler) checkTeamMember(ctx context.Context, tx pgx.Tx, teamID, userID int64, write bool) error {
+	member, err := h.Repo.GetTeamMemberByUserID(ctx, tx, teamID, userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Team not found")
+	}
+
+	if write && member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to manage SLOs for this team")
+	}
+
+	return nil
+}
+
+// sloMonitorIDs deduplicates the requested monitors and checks that each one belongs to the team.
+// Errors are already HTTP errors.
+func (h *Handler) sloMonitorIDs(ctx context.Context, tx pgx.Tx, teamID int64, requested utils.IDList) ([]int64, error) {
+	monitorIDs := utils.UniqueInt64s(requested.Int64s())
+
+	monitors, err := h.Repo.ListMonitorsByIDs(ctx, tx, teamID, monitorIDs)
+	if err != nil {
+		zap.L().Error("Failed to list monitors", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list monitors")
+	}
+
+	found := make(map[int64]struct{}, len(monitors))
+	for _, monitor := range monitors {
+		found[monitor.ID] = struct{}{}
+	}
+	for _, monitorID := range monitorIDs {
+		if _, ok := found[monitorID]; !ok {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Monitor %d not found in this team", monitorID))
+		}
+	}
+
+	return monitorIDs, nil
+}
+
+// evaluateSLO computes the current status of an SLO from the monitor rollups.
+// Errors are already HTTP errors.
+func (h *Handler) evaluateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO, now time.Time) (sloResponse, error) {
+	buckets, err := h.Repo.ListSLOBuckets(ctx, tx, slo, slocore.Lookback(slo, now), now)
+	if err != nil {
+		zap.L().Error("Failed to list SLO buckets", zap.Error(err), zap.Int64("slo_id", slo.ID))
+		return sloResponse{}, echo.NewHTTPError(http.StatusInternalServerError, "Failed to compute SLO status")
+	}
+
+	return sloResponse{SLO: slo, Status: slocore.Evaluate(slo, buckets, now)}, nil
+}
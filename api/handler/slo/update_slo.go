@@ -0,0 +1,102 @@
+package slo
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateSLO godoc
+// @Summary Update an SLO
+// @Description Replaces the definition of an SLO (owner/admin only). The budget is always computed from the rollups, so a changed target or window applies to past checks too.
+// @Tags slos
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "SLO ID"
+// @Param request body sloRequest true "SLO definition"
+// @Success 200 {object} response.SuccessResponse "SLO updated"
+// @Failure 400 {object} response.ErrorResponse "Invalid request"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "SLO not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/slos/{id} [put]
+func (h *Handler) UpdateSLO(c echo.Context) error {
+	teamID, sloID, err := parseSLOParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req sloRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := checkSLORequest(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.checkTeamMember(ctx, tx, teamID, *userID, true); err != nil {
+		return err
+	}
+
+	monitorIDs, err := h.sloMonitorIDs(ctx, tx, teamID, req.MonitorIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	slo := models.SLO{ID: sloID, TeamID: teamID, UpdatedAt: now}
+	applySLORequest(&slo, req, monitorIDs)
+
+	updated, err := h.Repo.UpdateSLO(ctx, tx, slo)
+	if err != nil {
+		zap.L().Error("Failed to update SLO", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update SLO")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "SLO not found")
+	}
+
+	resp, err := h.evaluateSLO(ctx, tx, *updated, now)
+	if err != nil {
+		return err
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("SLO updated", resp))
+}
@@ -0,0 +1,53 @@
+package statuspage
+
+import (
+	"fmt"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+)
+
+// validateStatusPageBranding checks the uploaded images and the branding settings of a request.
+func validateStatusPageBranding(req statusPageUpsertRequest) error {
+	images := []struct {
+		name   string
+		data   []byte
+		limits statuspagecore.ImageLimits
+	}{
+		{"icon", req.Icon, statuspagecore.IconLimits},
+		{"logo", req.Logo, statuspagecore.LogoLimits},
+		{"favicon", req.Favicon, statuspagecore.FaviconLimits},
+	}
+	for _, img := range images {
+		if _, err := statuspagecore.ValidateImage(img.data, img.limits); err != nil {
+			return fmt.Errorf("%s: %w", img.name, err)
+		}
+	}
+
+	if req.Branding == nil {
+		return nil
+	}
+	return statuspagecore.ValidateBranding(*req.Branding)
+}
+
+// applyStatusPageBranding sets the images and branding of page from the request. An omitted branding
+// keeps the existing one; the theme defaults to auto.
+func applyStatusPageBranding(page *models.StatusPage, req statusPageUpsertRequest, existing *models.StatusPage) {
+	page.Logo = req.Logo
+	page.Favicon = req.Favicon
+
+	var branding models.StatusPageBranding
+	switch {
+	case req.Branding != nil:
+		branding = *req.Branding
+	case existing != nil:
+		branding = existing.Branding
+	}
+	if branding.Theme == "" {
+		branding.Theme = models.StatusPageThemeAuto
+	}
+	if branding.HeaderLinks == nil {
+		branding.HeaderLinks = []models.StatusPageHeaderLink{}
+	}
+	page.Branding = branding
+}
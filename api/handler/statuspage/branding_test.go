@@ -0,0 +1,64 @@
+package statuspage
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestStatusPageBrandingValidation(t *testing.T) {
+	req := statusPageUpsertRequest{
+		Title: "Acme",
+		Slug:  "acme",
+		Branding: &models.StatusPageBranding{
+			Theme:        models.StatusPageThemeDark,
+			PrimaryColor: "#1a2b3c",
+			StatusColors: models.StatusPageStatusColors{Down: "#f00"},
+			HeaderLinks:  []models.StatusPageHeaderLink{{Label: "Docs", URL: "https://docs.acme.com"}},
+		},
+	}
+	require.NoError(t, validator.New().Struct(req))
+	require.NoError(t, validateStatusPagePayload(req))
+
+	req.Branding.PrimaryColor = "blue"
+	assert.Error(t, validator.New().Struct(req))
+
+	req.Branding.PrimaryColor = ""
+	req.Branding.Theme = "sepia"
+	assert.Error(t, validator.New().Struct(req))
+
+	req.Branding.Theme = ""
+	req.Branding.CustomCSS = "</style>"
+	assert.Error(t, validateStatusPagePayload(req))
+
+	req.Branding = nil
+	req.Icon = []byte("GIF89a not really")
+	assert.ErrorContains(t, validateStatusPagePayload(req), "icon:")
+}
+
+func TestApplyStatusPageBranding(t *testing.T) {
+	existing := &models.StatusPage{
+		Logo:     []byte("old"),
+		Branding: models.StatusPageBranding{Theme: models.StatusPageThemeDark, FooterText: "Acme Inc."},
+	}
+
+	var page models.StatusPage
+	applyStatusPageBranding(&page, statusPageUpsertRequest{Logo: []byte("new")}, existing)
+	assert.Equal(t, []byte("new"), page.Logo)
+	assert.Equal(t, models.StatusPageThemeDark, page.Branding.Theme)
+	assert.Equal(t, "Acme Inc.", page.Branding.FooterText)
+	assert.NotNil(t, page.Branding.HeaderLinks)
+
+	page = models.StatusPage{}
+	applyStatusPageBranding(&page, statusPageUpsertRequest{Branding: &models.StatusPageBranding{PrimaryColor: "#ffffff"}}, existing)
+	assert.Equal(t, models.StatusPageThemeAuto, page.Branding.Theme)
+	assert.Equal(t, "#ffffff", page.Branding.PrimaryColor)
+	assert.Empty(t, page.Branding.FooterText)
+
+	page = models.StatusPage{}
+	applyStatusPageBranding(&page, statusPageUpsertRequest{}, nil)
+	assert.Equal(t, models.StatusPageThemeAuto, page.Branding.Theme)
+}
@@ -1,6 +1,7 @@
 package statuspage
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -111,12 +112,23 @@ func (h *Handler) CreateStatusPage(c echo.Context) error {
 		UpdatedAt: now,
 	}
 
+	applyStatusPageBranding(&page, normalizedReq, nil)
+	applyStatusPageLocale(&page, normalizedReq, nil)
+
+	if err := applyStatusPageVisibility(&page, normalizedReq, nil); err != nil {
+		if errors.Is(err, errStatusPagePasswordRequired) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to hash status page password", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create status page")
+	}
+
 	if err := h.Repo.CreateStatusPage(c.Request().Context(), tx, page); err != nil {
 		zap.L().Error("Failed to create status page", zap.Error(err))
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create status page")
 	}
 
-	groups, monitors, err := buildStatusPageElements(normalizedReq, page.ID)
+	groups, monitors, err := buildStatusPageElements(normalizedReq, page.ID, nil)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
@@ -145,21 +157,32 @@ func (h *Handler) CreateStatusPage(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.Success("Status page created successfully", resp))
 }
 
-// buildStatusPageElements assigns IDs and fan-out relationships.
-func buildStatusPageElements(req statusPageUpsertRequest, statusPageID int64) ([]models.StatusPageGroup, []models.StatusPageMonitor, error) {
+// buildStatusPageElements assigns IDs and fan-out relationships. Groups sent with one of existingGroupIDs
+// keep it, so component overrides and Statuspage v2 component IDs survive page edits.
+func buildStatusPageElements(req statusPageUpsertRequest, statusPageID int64, existingGroupIDs map[int64]bool) ([]models.StatusPageGroup, []models.StatusPageMonitor, error) {
 	groupIDMap := make(map[int64]int64) // client temp ID -> generated ID
 	groups := make([]models.StatusPageGroup, 0, len(req.Groups))
 	for _, g := range req.Groups {
-		gid, err := id.GetID()
-		if err != nil {
-			return nil, nil, err
+		var gid int64
+		if g.ID != nil && existingGroupIDs[*g.ID] {
+			gid = *g.ID
+			delete(existingGroupIDs, gid)
+		} else {
+			generated, err := id.GetID()
+			if err != nil {
+				return nil, nil, err
+			}
+			gid = generated
 		}
 		groups = append(groups, models.StatusPageGroup{
-			ID:           gid,
-			StatusPageID: statusPageID,
-			Name:         g.Name,
-			Type:         g.Type,
-			SortOrder:    g.SortOrder,
+			ID:               gid,
+			StatusPageID:     statusPageID,
+			Name:             g.Name,
+			Type:             g.Type,
+			SortOrder:        g.SortOrder,
+			Days:             g.Days,
+			PerRegion:        g.PerRegion,
+			NameTranslations: g.NameTranslations,
 		})
 		if g.ID != nil {
 			groupIDMap[*g.ID] = gid
@@ -181,13 +204,16 @@ func buildStatusPageElements(req statusPageUpsertRequest, statusPageID int64) ([
 			}
 		}
 		monitors = append(monitors, models.StatusPageMonitor{
-			ID:           mid,
-			StatusPageID: statusPageID,
-			MonitorID:    m.MonitorID,
-			GroupID:      groupID,
-			Name:         m.Name,
-			Type:         m.Type,
-			SortOrder:    m.SortOrder,
+			ID:               mid,
+			StatusPageID:     statusPageID,
+			MonitorID:        m.MonitorID,
+			GroupID:          groupID,
+			Name:             m.Name,
+			Type:             m.Type,
+			SortOrder:        m.SortOrder,
+			Days:             m.Days,
+			PerRegion:        m.PerRegion,
+			NameTranslations: m.NameTranslations,
 		})
 	}
 
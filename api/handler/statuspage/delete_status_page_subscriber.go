@@ -0,0 +1,101 @@
+package statuspage
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteStatusPageSubscriber godoc
+// @Summary Remove a status page subscriber
+// @Description Removes an email or webhook subscriber from a status page (owner/admin only)
+// @Tags status-pages
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param subscriberID path string true "Subscriber ID"
+// @Success 200 {object} response.SuccessResponse "Status page subscriber deleted successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID, status page ID or subscriber ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Status page subscriber not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/subscribers/{subscriberID} [delete]
+func (h *Handler) DeleteStatusPageSubscriber(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	subscriberID, err := strconv.ParseInt(c.Param("subscriberID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid subscriber ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to manage status page subscribers for this team")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(c.Request().Context(), tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.DeleteStatusPageSubscriber(c.Request().Context(), tx, page.ID, subscriberID); err != nil {
+		if err == pgx.ErrNoRows {
+			return echo.NewHTTPError(http.StatusNotFound, "Status page subscriber not found")
+		}
+
+		zap.L().Error("Failed to delete status page subscriber", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete status page subscriber")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Status page subscriber deleted successfully"))
+}
@@ -0,0 +1,75 @@
+package statuspage
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+type statusPageDomainRequest struct {
+	Domain string `json:"domain" validate:"required,max=253"`
+}
+
+type domainTXTRecord struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type domainHTTPToken struct {
+	URL     string `json:"url"`
+	Content string `json:"content"`
+}
+
+// statusPageDomainResponse describes a custom domain and how to verify it. ClaimExpiresAt is when an
+// unverified domain is released again.
+type statusPageDomainResponse struct {
+	Domain         *string          `json:"domain"`
+	Verified       bool             `json:"verified"`
+	VerifiedAt     *time.Time       `json:"verified_at,omitempty"`
+	ClaimExpiresAt *time.Time       `json:"claim_expires_at,omitempty"`
+	TXTRecord      *domainTXTRecord `json:"txt_record,omitempty"`
+	HTTPToken      *domainHTTPToken `json:"http_token,omitempty"`
+}
+
+func newStatusPageDomainResponse(page models.StatusPage) statusPageDomainResponse {
+	resp := statusPageDomainResponse{
+		Domain:     page.CustomDomain,
+		Verified:   page.CustomDomain != nil && page.DomainVerifiedAt != nil,
+		VerifiedAt: page.DomainVerifiedAt,
+	}
+
+	if page.CustomDomain != nil && page.DomainVerifiedAt == nil && page.DomainClaimedAt != nil {
+		expiresAt := page.DomainClaimedAt.Add(statuspagecore.DomainClaimTTL)
+		resp.ClaimExpiresAt = &expiresAt
+	}
+
+	if page.CustomDomain != nil && page.DomainVerificationToken != nil {
+		value := statuspagecore.DomainVerificationValue(*page.DomainVerificationToken)
+		resp.TXTRecord = &domainTXTRecord{Name: statuspagecore.DomainTXTRecordName(*page.CustomDomain), Value: value}
+		resp.HTTPToken = &domainHTTPToken{URL: statuspagecore.DomainVerificationURL(*page.CustomDomain), Content: value}
+	}
+
+	return resp
+}
+
+// reservedDomain reports whether a domain is one Kymarium itself is served on.
+func reservedDomain(domain string) bool {
+	env := config.Env()
+	for _, raw := range []string{env.FrontendDomain, env.BackendURL} {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		if parsed, err := url.Parse(raw); err == nil && strings.EqualFold(parsed.Hostname(), domain) {
+			return true
+		}
+	}
+	return false
+}
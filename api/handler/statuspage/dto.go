@@ -7,8 +7,12 @@ import (
 type statusPageGroupInput struct {
 	ID        *int64                       `json:"id,string,omitempty" form:"id"`
 	Name      string                       `json:"name" form:"name" validate:"required,min=1,max=255"`
-	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator"`
+	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator response_time_chart uptime_bars"`
 	SortOrder int                          `json:"sort_order" form:"sort_order" validate:"min=1"`
+	Days      *int                         `json:"days,omitempty" form:"days"`
+	PerRegion bool                         `json:"per_region" form:"per_region"`
+	// NameTranslations overrides Name per locale on the public page.
+	NameTranslations models.Translations `json:"name_translations,omitempty" form:"name_translations"`
 }
 
 type statusPageMonitorInput struct {
@@ -16,18 +20,26 @@ type statusPageMonitorInput struct {
 	MonitorID int64                        `json:"monitor_id,string" form:"monitor_id" validate:"required"`
 	GroupID   *int64                       `json:"group_id,string,omitempty" form:"group_id"`
 	Name      string                       `json:"name" form:"name" validate:"required,min=1,max=255"`
-	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator"`
+	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator response_time_chart uptime_bars"`
 	SortOrder int                          `json:"sort_order" form:"sort_order" validate:"min=1"`
+	Days      *int                         `json:"days,omitempty" form:"days"`
+	PerRegion bool                         `json:"per_region" form:"per_region"`
+	// NameTranslations overrides Name per locale on the public page.
+	NameTranslations models.Translations `json:"name_translations,omitempty" form:"name_translations"`
 }
 
 type statusPageElementInput struct {
 	ID        *int64                       `json:"id,string,omitempty" form:"id"`
 	Name      string                       `json:"name" form:"name" validate:"required,min=1,max=255"`
-	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator"`
+	Type      models.StatusPageElementType `json:"type" form:"type" validate:"required,oneof=historical_timeline current_status_indicator response_time_chart uptime_bars"`
 	SortOrder int                          `json:"sort_order" form:"sort_order" validate:"min=1"`
+	Days      *int                         `json:"days,omitempty" form:"days"`
+	PerRegion bool                         `json:"per_region" form:"per_region"`
 	Monitor   bool                         `json:"monitor" form:"monitor"`
 	MonitorID *int64                       `json:"monitor_id,string,omitempty" form:"monitor_id"`
 	Monitors  []statusPageMonitorInput     `json:"monitors" form:"monitors" validate:"dive"`
+	// NameTranslations overrides Name per locale on the public page.
+	NameTranslations models.Translations `json:"name_translations,omitempty" form:"name_translations"`
 }
 
 type statusPageUpsertRequest struct {
@@ -37,6 +49,21 @@ type statusPageUpsertRequest struct {
 	Elements []statusPageElementInput `json:"elements" form:"elements" validate:"dive"`
 	Groups   []statusPageGroupInput   `json:"groups" form:"groups" validate:"dive"`
 	Monitors []statusPageMonitorInput `json:"monitors" form:"monitors" validate:"dive"`
+
+	// Visibility defaults to the current one (public for new pages); Password is required when
+	// switching to password mode and otherwise keeps the existing password when omitted.
+	Visibility models.StatusPageVisibility `json:"visibility,omitempty" form:"visibility" validate:"omitempty,oneof=public password team"`
+	Password   *string                     `json:"password,omitempty" form:"password" validate:"omitempty,min=8,max=128"`
+
+	// Logo and Favicon are replaced like Icon; an omitted Branding keeps the current one.
+	Logo     []byte                     `json:"logo,omitempty" form:"logo"`
+	Favicon  []byte                     `json:"favicon,omitempty" form:"favicon"`
+	Branding *models.StatusPageBranding `json:"branding,omitempty" form:"branding"`
+
+	// DefaultLocale is the language of Title and the element names and keeps the current one when
+	// omitted (en for new pages); TitleTranslations overrides Title for other locales.
+	DefaultLocale     string              `json:"default_locale,omitempty" form:"default_locale"`
+	TitleTranslations models.Translations `json:"title_translations,omitempty" form:"title_translations"`
 }
 
 type statusPageElementResponse struct {
@@ -45,9 +72,13 @@ type statusPageElementResponse struct {
 	Name         string                       `json:"name"`
 	Type         models.StatusPageElementType `json:"type"`
 	SortOrder    int                          `json:"sort_order"`
+	Days         *int                         `json:"days,omitempty"`
+	PerRegion    bool                         `json:"per_region"`
 	Monitor      bool                         `json:"monitor"`
 	MonitorID    *string                      `json:"monitor_id,omitempty"`
 	Monitors     []models.StatusPageMonitor   `json:"monitors"`
+	// NameTranslations overrides Name per locale on the public page.
+	NameTranslations models.Translations `json:"name_translations"`
 }
 
 type statusPageResponse struct {
@@ -26,13 +26,16 @@ func buildStatusPageElementResponses(groups []models.StatusPageGroup, monitors [
 		}
 
 		element := statusPageElementResponse{
-			ID:           strconv.FormatInt(group.ID, 10),
-			StatusPageID: strconv.FormatInt(group.StatusPageID, 10),
-			Name:         group.Name,
-			Type:         group.Type,
-			SortOrder:    group.SortOrder,
-			Monitor:      false,
-			Monitors:     monitorList,
+			ID:               strconv.FormatInt(group.ID, 10),
+			StatusPageID:     strconv.FormatInt(group.StatusPageID, 10),
+			Name:             group.Name,
+			Type:             group.Type,
+			SortOrder:        group.SortOrder,
+			Days:             group.Days,
+			PerRegion:        group.PerRegion,
+			Monitor:          false,
+			Monitors:         monitorList,
+			NameTranslations: group.NameTranslations,
 		}
 
 		elements = append(elements, element)
@@ -41,14 +44,17 @@ func buildStatusPageElementResponses(groups []models.StatusPageGroup, monitors [
 	for _, monitor := range ungroupedMonitors {
 		monitorID := strconv.FormatInt(monitor.MonitorID, 10)
 		element := statusPageElementResponse{
-			ID:           strconv.FormatInt(monitor.ID, 10),
-			StatusPageID: strconv.FormatInt(monitor.StatusPageID, 10),
-			Name:         monitor.Name,
-			Type:         monitor.Type,
-			SortOrder:    monitor.SortOrder,
-			Monitor:      true,
-			MonitorID:    &monitorID,
-			Monitors:     []models.StatusPageMonitor{},
+			ID:               strconv.FormatInt(monitor.ID, 10),
+			StatusPageID:     strconv.FormatInt(monitor.StatusPageID, 10),
+			Name:             monitor.Name,
+			Type:             monitor.Type,
+			SortOrder:        monitor.SortOrder,
+			Days:             monitor.Days,
+			PerRegion:        monitor.PerRegion,
+			Monitor:          true,
+			MonitorID:        &monitorID,
+			Monitors:         []models.StatusPageMonitor{},
+			NameTranslations: monitor.NameTranslations,
 		}
 
 		elements = append(elements, element)
@@ -0,0 +1,90 @@
+package statuspage
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type publicIncidentDetailResponse struct {
+	publicHistoryIncident
+	Timeline []models.EventTimeline `json:"timeline"`
+	Locale   string                 `json:"locale"`
+}
+
+// GetPublicIncident godoc
+// @Summary Get public incident
+// @Description Fetches one public incident of a status page with its duration, affected components and public updates, for linking to it directly.
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Param id path string true "Incident ID"
+// @Param lang query string false "Locale, e.g. ja or zh-TW"
+// @Success 200 {object} response.SuccessResponse "Incident returned"
+// @Failure 400 {object} response.ErrorResponse "Invalid incident ID"
+// @Failure 401 {object} response.ErrorResponse "Password or sign-in required"
+// @Failure 404 {object} response.ErrorResponse "Status page or incident not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/incidents/{id} [get]
+func (h *Handler) GetPublicIncident(c echo.Context) error {
+	incidentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid incident ID")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	data, err := h.loadPublicStatusPageComponents(c, tx)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	rows, err := h.Repo.GetPublicIncidentByMonitorIDs(c.Request().Context(), tx, data.MonitorIDs, incidentID)
+	if err != nil {
+		zap.L().Error("Failed to get public incident", zap.Error(err), zap.Int64("incident_id", incidentID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get incident")
+	}
+	if len(rows) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "Incident not found")
+	}
+
+	events, err := h.Repo.ListPublicEventTimelinesByIncidentIDs(c.Request().Context(), tx, []int64{incidentID})
+	if err != nil {
+		zap.L().Error("Failed to list public incident timelines", zap.Error(err), zap.Int64("incident_id", incidentID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incident timelines")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	locale := newPublicLocale(data.Page, c.QueryParam("lang"), c.Request().Header.Get("Accept-Language"))
+	timeline := make([]models.EventTimeline, 0, len(events))
+	for _, event := range events {
+		event.Message = locale.translate(event.Message, event.MessageTranslations)
+		timeline = append(timeline, event)
+	}
+
+	resp := publicIncidentDetailResponse{
+		publicHistoryIncident: buildPublicHistoryIncidents(rows, data, locale, time.Now())[0],
+		Timeline:              timeline,
+		Locale:                locale.locale,
+	}
+
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+
+	return c.JSON(http.StatusOK, response.Success("Incident returned", resp))
+}
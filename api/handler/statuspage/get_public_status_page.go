@@ -1,11 +1,15 @@
 package statuspage
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
 	"github.com/yorukot/kymarium/models"
 	"github.com/yorukot/kymarium/utils/response"
 	"go.uber.org/zap"
@@ -15,20 +19,29 @@ type publicTimelinePoint struct {
 	Day     time.Time `json:"day"`
 	Success int64     `json:"success"`
 	Fail    int64     `json:"fail"`
+	// Maintenance counts failed checks inside maintenance windows; they are not part of Fail.
+	Maintenance int64 `json:"maintenance,omitempty"`
 }
 
 type publicStatusPageMonitor struct {
-	ID          string                       `json:"id"`
-	MonitorID   string                       `json:"monitor_id"`
-	GroupID     *string                      `json:"group_id,omitempty"`
-	Name        string                       `json:"name"`
-	Type        models.StatusPageElementType `json:"type"`
-	SortOrder   int                          `json:"sort_order"`
-	Status      string                       `json:"status,omitempty"`
-	UptimeSLI30 float64                      `json:"uptime_sli_30,omitempty"`
-	UptimeSLI60 float64                      `json:"uptime_sli_60,omitempty"`
-	UptimeSLI90 float64                      `json:"uptime_sli_90,omitempty"`
-	Timeline    []publicTimelinePoint        `json:"timeline,omitempty"`
+	ID        string                       `json:"id"`
+	MonitorID string                       `json:"monitor_id"`
+	GroupID   *string                      `json:"group_id,omitempty"`
+	Name      string                       `json:"name"`
+	Type      models.StatusPageElementType `json:"type"`
+	SortOrder int                          `json:"sort_order"`
+	Status    string                       `json:"status,omitempty"`
+	// Override is the status set by hand for the component, if any; Status stays the computed one.
+	Override    *publicComponentOverride `json:"override,omitempty"`
+	UptimeSLI30 float64                  `json:"uptime_sli_30,omitempty"`
+	UptimeSLI60 float64                  `json:"uptime_sli_60,omitempty"`
+	UptimeSLI90 float64                  `json:"uptime_sli_90,omitempty"`
+	Timeline    []publicTimelinePoint    `json:"timeline,omitempty"`
+	// Chart element data: Days is the window, UptimeSLI covers it for uptime_bars.
+	Days         int                  `json:"days,omitempty"`
+	UptimeSLI    float64              `json:"uptime_sli,omitempty"`
+	ResponseTime []publicLatencyPoint `json:"response_time,omitempty"`
+	Regions      []publicRegionSeries `json:"regions,omitempty"`
 }
 
 type publicStatusPageElement struct {
@@ -37,13 +50,19 @@ type publicStatusPageElement struct {
 	Type        models.StatusPageElementType `json:"type"`
 	SortOrder   int                          `json:"sort_order"`
 	Status      string                       `json:"status,omitempty"`
+	Override    *publicComponentOverride     `json:"override,omitempty"`
 	Monitor     bool                         `json:"monitor"`
 	MonitorID   *string                      `json:"monitor_id,omitempty"`
 	UptimeSLI30 float64                      `json:"uptime_sli_30,omitempty"`
 	UptimeSLI60 float64                      `json:"uptime_sli_60,omitempty"`
 	UptimeSLI90 float64                      `json:"uptime_sli_90,omitempty"`
 	Timeline    []publicTimelinePoint        `json:"timeline,omitempty"`
-	Monitors    []publicStatusPageMonitor    `json:"monitors"`
+	// Chart element data: Days is the window, UptimeSLI covers it for uptime_bars.
+	Days         int                       `json:"days,omitempty"`
+	UptimeSLI    float64                   `json:"uptime_sli,omitempty"`
+	ResponseTime []publicLatencyPoint      `json:"response_time,omitempty"`
+	Regions      []publicRegionSeries      `json:"regions,omitempty"`
+	Monitors     []publicStatusPageMonitor `json:"monitors"`
 }
 
 type publicIncidentResponse struct {
@@ -53,27 +72,48 @@ type publicIncidentResponse struct {
 }
 
 type publicStatusPageResponse struct {
-	StatusPage models.StatusPage         `json:"status_page"`
-	Elements   []publicStatusPageElement `json:"elements"`
-	Incidents  []publicIncidentResponse  `json:"incidents"`
+	StatusPage           models.StatusPage           `json:"status_page"`
+	Elements             []publicStatusPageElement   `json:"elements"`
+	Incidents            []publicIncidentResponse    `json:"incidents"`
+	ActiveMaintenances   []publicMaintenanceResponse `json:"active_maintenances"`
+	UpcomingMaintenances []publicMaintenanceResponse `json:"upcoming_maintenances"`
+	// Locale is the language of the response, Locales those the page has text for, and Labels the
+	// system strings in Locale.
+	Locale  string            `json:"locale"`
+	Locales []string          `json:"locales"`
+	Labels  map[string]string `json:"labels"`
 }
 
 // GetPublicStatusPage godoc
 // @Summary Get public status page
-// @Description Fetches a public status page by slug with computed status/timeline data
+// @Description Fetches a public status page by slug with computed status/timeline data. Password-protected pages need the access cookie from POST /status-pages/{slug}/access and team-only pages a session of a team member. Text is localized to lang, else Accept-Language, else the page's default locale. Public pages are served from a snapshot refreshed on changes and support If-None-Match.
 // @Tags status-pages
 // @Produce json
 // @Param slug path string true "Status Page Slug"
+// @Param lang query string false "Locale, e.g. ja or zh-TW"
 // @Success 200 {object} response.SuccessResponse "Public status page returned"
+// @Success 304 {string} string "Not modified"
+// @Failure 401 {object} response.ErrorResponse "Password or sign-in required"
 // @Failure 404 {object} response.ErrorResponse "Status page not found"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /status-pages/{slug} [get]
 func (h *Handler) GetPublicStatusPage(c echo.Context) error {
-	slug := c.Param("slug")
-	if slug == "" {
-		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	lookup := newPublicStatusPageLookup(c)
+	preference := statuspagecore.NegotiateLocale(c.QueryParam("lang"), c.Request().Header.Get("Accept-Language"), "")
+	key := publicSnapshotKey(lookup, preference)
+
+	// The body depends on Accept-Language unless lang pins the locale.
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+
+	if snapshot, ok, refresh := h.Snapshots.Get(key, time.Now()); ok {
+		if refresh {
+			go h.refreshPublicStatusPageSnapshot(key, lookup, preference)
+		}
+		return writePublicStatusPageSnapshot(c, snapshot, true)
 	}
 
+	generation := h.Snapshots.Begin()
+
 	tx, err := h.Repo.StartTransaction(c.Request().Context())
 	if err != nil {
 		zap.L().Error("Failed to begin transaction", zap.Error(err))
@@ -81,88 +121,84 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 	}
 	defer h.Repo.DeferRollback(c.Request().Context(), tx)
 
-	page, err := h.Repo.GetStatusPageBySlug(c.Request().Context(), tx, slug)
+	page, err := h.findStatusPage(c.Request().Context(), tx, lookup)
 	if err != nil {
-		zap.L().Error("Failed to get status page by slug", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+		return err
 	}
 	if page == nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
 	}
 
-	groups, err := h.Repo.ListStatusPageGroupsByStatusPageID(c.Request().Context(), tx, page.ID)
-	if err != nil {
-		zap.L().Error("Failed to list status page groups", zap.Error(err), zap.Int64("status_page_id", page.ID))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page groups")
+	if err := h.authorizePublicStatusPage(c, tx, page); err != nil {
+		return err
 	}
 
-	monitors, err := h.Repo.ListStatusPageMonitorsByStatusPageID(c.Request().Context(), tx, page.ID)
+	snapshot, err := h.renderPublicStatusPage(c.Request().Context(), tx, page, preference)
 	if err != nil {
-		zap.L().Error("Failed to list status page monitors", zap.Error(err), zap.Int64("status_page_id", page.ID))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page monitors")
+		return err
 	}
 
-	monitorIDs := make([]int64, 0, len(monitors))
-	monitorSeen := make(map[int64]struct{}, len(monitors))
-	for _, m := range monitors {
-		if _, exists := monitorSeen[m.MonitorID]; exists {
-			continue
-		}
-		monitorSeen[m.MonitorID] = struct{}{}
-		monitorIDs = append(monitorIDs, m.MonitorID)
-	}
-
-	monitorRows, err := h.Repo.ListMonitorsByIDs(c.Request().Context(), tx, page.TeamID, monitorIDs)
-	if err != nil {
-		zap.L().Error("Failed to list monitors by ids", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list monitors")
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
 	}
 
-	monitorByID := make(map[int64]models.Monitor, len(monitorRows))
-	for _, monitor := range monitorRows {
-		monitorByID[monitor.ID] = monitor
+	public := isPublicStatusPage(page)
+	if public {
+		h.Snapshots.Put(key, page.ID, snapshot, generation)
 	}
+	return writePublicStatusPageSnapshot(c, snapshot, public)
+}
 
-	incidents, err := h.Repo.ListPublicIncidentsByMonitorIDs(c.Request().Context(), tx, monitorIDs)
+// renderPublicStatusPage builds the public view of page in preference, a supported locale or empty for
+// the default locale of the page. Errors are already HTTP errors.
+func (h *Handler) renderPublicStatusPage(ctx context.Context, tx pgx.Tx, page *models.StatusPage, preference string) (statuspagecore.Snapshot, error) {
+	data, err := h.loadStatusPageComponents(ctx, tx, page)
 	if err != nil {
-		zap.L().Error("Failed to list public incidents", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incidents")
+		return statuspagecore.Snapshot{}, err
+	}
+	if err := h.loadStatusPageIncidents(ctx, tx, data); err != nil {
+		return statuspagecore.Snapshot{}, err
 	}
 
+	groups, monitors, monitorIDs, monitorByID := data.Groups, data.Monitors, data.MonitorIDs, data.MonitorByID
+	locale := newPublicLocale(page, preference, "")
+
 	openPublicIncident := make(map[int64]bool)
-	incidentIDs := make([]int64, 0, len(incidents))
-	incidentResponses := make([]publicIncidentResponse, 0, len(incidents))
-	for _, incident := range incidents {
+	incidentResponses := make([]publicIncidentResponse, 0, len(data.Incidents))
+	for _, incident := range data.Incidents {
 		if incident.Status != models.IncidentStatusResolved {
 			openPublicIncident[incident.MonitorID] = true
 		}
-		incidentIDs = append(incidentIDs, incident.ID)
 		incidentResponses = append(incidentResponses, publicIncidentResponse{
 			Incident:  incident.Incident,
 			MonitorID: formatID(incident.MonitorID),
 		})
 	}
+	eventTimelines := data.Events
 
-	eventTimelines, err := h.Repo.ListPublicEventTimelinesByIncidentIDs(c.Request().Context(), tx, incidentIDs)
+	start, end := publicTimelineWindow()
+	dailySummaries, err := h.Repo.ListMonitorDailySummaryByMonitorIDs(ctx, tx, monitorIDs, start, end)
 	if err != nil {
-		zap.L().Error("Failed to list public incident timelines", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incident timelines")
+		zap.L().Error("Failed to list daily summaries", zap.Error(err))
+		return statuspagecore.Snapshot{}, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list timeline data")
 	}
 
-	start, end := publicTimelineWindow()
-	dailySummaries, err := h.Repo.ListMonitorDailySummaryByMonitorIDs(c.Request().Context(), tx, monitorIDs, start, end)
+	maintenanceFailures, err := h.Repo.ListMonitorMaintenanceFailures(ctx, tx, monitorIDs, start, end)
 	if err != nil {
-		zap.L().Error("Failed to list daily summaries", zap.Error(err))
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list timeline data")
+		zap.L().Error("Failed to list maintenance failures", zap.Error(err))
+		return statuspagecore.Snapshot{}, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list timeline data")
 	}
 
-	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	now := time.Now()
+	charts, err := h.loadPublicChartData(ctx, tx, statusPageChartElements(groups, monitors), now)
+	if err != nil {
+		return statuspagecore.Snapshot{}, err
 	}
 
 	days := buildTimelineDays(start, end)
 	publicTimelinesByIncident := make(map[int64][]models.EventTimeline)
 	for _, event := range eventTimelines {
+		event.Message = locale.translate(event.Message, event.MessageTranslations)
 		publicTimelinesByIncident[event.IncidentID] = append(publicTimelinesByIncident[event.IncidentID], event)
 	}
 
@@ -175,6 +211,10 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 		}
 	}
 	perMonitorDaily := buildDailyIndex(dailySummaries)
+	applyMaintenanceFailures(perMonitorDaily, maintenanceFailures)
+	underMaintenance := activeMaintenanceMonitors(data.Maintenances, now)
+	overrides := indexComponentOverrides(data.Overrides, now)
+	activeMaintenances, upcomingMaintenances := splitPublicMaintenances(data.Maintenances, data.MaintenanceUpdates, monitorIDs, now)
 
 	groupMonitorIDs := make(map[int64][]int64, len(groups))
 	for _, m := range monitors {
@@ -187,7 +227,7 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 	groupMonitorResponses := make(map[int64][]publicStatusPageMonitor, len(groups))
 	ungroupedMonitors := make([]publicStatusPageMonitor, 0)
 	for _, monitor := range monitors {
-		status := computeMonitorStatus(monitor.MonitorID, monitorByID, openPublicIncident)
+		status := computeMonitorStatus(monitor.MonitorID, monitorByID, openPublicIncident, underMaintenance)
 		timeline, sli30, sli60, sli90 := buildTimelineSummary([]int64{monitor.MonitorID}, days, perMonitorDaily)
 
 		var groupID *string
@@ -200,17 +240,25 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 			ID:        formatID(monitor.ID),
 			MonitorID: formatID(monitor.MonitorID),
 			GroupID:   groupID,
-			Name:      monitor.Name,
+			Name:      locale.translate(monitor.Name, monitor.NameTranslations),
 			Type:      monitor.Type,
 			SortOrder: monitor.SortOrder,
 			Status:    status,
+			Override:  overrides.monitor(monitor.MonitorID),
 		}
 
-		if monitor.Type == models.StatusPageElementTypeHistoricalTimeline {
+		switch monitor.Type {
+		case models.StatusPageElementTypeHistoricalTimeline:
 			responseMonitor.Timeline = timeline
 			responseMonitor.UptimeSLI30 = sli30
 			responseMonitor.UptimeSLI60 = sli60
 			responseMonitor.UptimeSLI90 = sli90
+		case models.StatusPageElementTypeUptimeBars:
+			responseMonitor.Days = elementDays(monitor.Type, monitor.Days)
+			responseMonitor.Timeline, responseMonitor.UptimeSLI, responseMonitor.Regions = charts.uptimeBars([]int64{monitor.MonitorID}, responseMonitor.Days, monitor.PerRegion, now)
+		case models.StatusPageElementTypeResponseTimeChart:
+			responseMonitor.Days = elementDays(monitor.Type, monitor.Days)
+			responseMonitor.ResponseTime, responseMonitor.Regions = charts.responseTime([]int64{monitor.MonitorID}, responseMonitor.Days, monitor.PerRegion)
 		}
 
 		if monitor.GroupID == nil {
@@ -223,7 +271,7 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 	elements := make([]publicStatusPageElement, 0, len(groups)+len(ungroupedMonitors))
 	for _, group := range groups {
 		monitorIDs := groupMonitorIDs[group.ID]
-		status := computeGroupStatus(monitorIDs, monitorByID, openPublicIncident)
+		status := computeGroupStatus(monitorIDs, monitorByID, openPublicIncident, underMaintenance)
 		timeline, sli30, sli60, sli90 := buildTimelineSummary(monitorIDs, days, perMonitorDaily)
 
 		monitorList := groupMonitorResponses[group.ID]
@@ -233,19 +281,27 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 
 		responseElement := publicStatusPageElement{
 			ID:        formatID(group.ID),
-			Name:      group.Name,
+			Name:      locale.translate(group.Name, group.NameTranslations),
 			Type:      group.Type,
 			SortOrder: group.SortOrder,
 			Status:    status,
+			Override:  overrides.group(group.ID),
 			Monitor:   false,
 			Monitors:  monitorList,
 		}
 
-		if group.Type == models.StatusPageElementTypeHistoricalTimeline {
+		switch group.Type {
+		case models.StatusPageElementTypeHistoricalTimeline:
 			responseElement.Timeline = timeline
 			responseElement.UptimeSLI30 = sli30
 			responseElement.UptimeSLI60 = sli60
 			responseElement.UptimeSLI90 = sli90
+		case models.StatusPageElementTypeUptimeBars:
+			responseElement.Days = elementDays(group.Type, group.Days)
+			responseElement.Timeline, responseElement.UptimeSLI, responseElement.Regions = charts.uptimeBars(monitorIDs, responseElement.Days, group.PerRegion, now)
+		case models.StatusPageElementTypeResponseTimeChart:
+			responseElement.Days = elementDays(group.Type, group.Days)
+			responseElement.ResponseTime, responseElement.Regions = charts.responseTime(monitorIDs, responseElement.Days, group.PerRegion)
 		}
 
 		elements = append(elements, responseElement)
@@ -253,40 +309,61 @@ func (h *Handler) GetPublicStatusPage(c echo.Context) error {
 
 	for _, monitor := range ungroupedMonitors {
 		monitorID := monitor.MonitorID
+		// Chart fields are only populated for the monitor's own element type, so they copy over as-is.
 		element := publicStatusPageElement{
-			ID:        monitor.ID,
-			Name:      monitor.Name,
-			Type:      monitor.Type,
-			SortOrder: monitor.SortOrder,
-			Status:    monitor.Status,
-			Monitor:   true,
-			MonitorID: &monitorID,
-			Monitors:  []publicStatusPageMonitor{},
-		}
-
-		if monitor.Type == models.StatusPageElementTypeHistoricalTimeline {
-			element.Timeline = monitor.Timeline
-			element.UptimeSLI30 = monitor.UptimeSLI30
-			element.UptimeSLI60 = monitor.UptimeSLI60
-			element.UptimeSLI90 = monitor.UptimeSLI90
+			ID:           monitor.ID,
+			Name:         monitor.Name,
+			Type:         monitor.Type,
+			SortOrder:    monitor.SortOrder,
+			Status:       monitor.Status,
+			Override:     monitor.Override,
+			Monitor:      true,
+			MonitorID:    &monitorID,
+			UptimeSLI30:  monitor.UptimeSLI30,
+			UptimeSLI60:  monitor.UptimeSLI60,
+			UptimeSLI90:  monitor.UptimeSLI90,
+			Timeline:     monitor.Timeline,
+			Days:         monitor.Days,
+			UptimeSLI:    monitor.UptimeSLI,
+			ResponseTime: monitor.ResponseTime,
+			Regions:      monitor.Regions,
+			Monitors:     []publicStatusPageMonitor{},
 		}
 
 		elements = append(elements, element)
 	}
 
+	localizedPage := *page
+	localizedPage.Title = locale.translate(page.Title, page.TitleTranslations)
+
 	resp := publicStatusPageResponse{
-		StatusPage: *page,
-		Elements:   elements,
-		Incidents:  incidentResponses,
+		StatusPage:           localizedPage,
+		Elements:             elements,
+		Incidents:            incidentResponses,
+		ActiveMaintenances:   activeMaintenances,
+		UpcomingMaintenances: upcomingMaintenances,
+		Locale:               locale.locale,
+		Locales:              availableLocales(data),
+		Labels:               statuspagecore.Labels(locale.locale),
 	}
 
-	return c.JSON(http.StatusOK, response.Success("Status page returned", resp))
+	body, err := json.Marshal(response.Success("Status page returned", resp))
+	if err != nil {
+		zap.L().Error("Failed to encode status page", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return statuspagecore.Snapshot{}, echo.NewHTTPError(http.StatusInternalServerError, "Failed to encode status page")
+	}
+	return statuspagecore.NewSnapshot(body, now), nil
 }
 
 func publicTimelineWindow() (time.Time, time.Time) {
-	now := time.Now().UTC()
+	return timelineWindow(time.Now(), 90)
+}
+
+// timelineWindow covers the last days UTC days, today included.
+func timelineWindow(now time.Time, days int) (time.Time, time.Time) {
+	now = now.UTC()
 	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(24 * time.Hour)
-	start := end.AddDate(0, 0, -90)
+	start := end.AddDate(0, 0, -days)
 	return start, end
 }
 
@@ -298,9 +375,12 @@ func buildTimelineDays(start time.Time, end time.Time) []time.Time {
 	return days
 }
 
+// dailyCounts are the checks of a day; maintenance holds failed checks excused by a maintenance window
+// and not included in total.
 type dailyCounts struct {
-	total int64
-	good  int64
+	total       int64
+	good        int64
+	maintenance int64
 }
 
 func buildDailyIndex(summaries []models.MonitorDailySummary) map[int64]map[time.Time]dailyCounts {
@@ -326,11 +406,13 @@ func buildTimelineSummary(monitorIDs []int64, days []time.Time, daily map[int64]
 	for _, day := range days {
 		var dayTotal int64
 		var dayGood int64
+		var dayMaintenance int64
 		for _, monitorID := range monitorIDs {
 			if byDay, ok := daily[monitorID]; ok {
 				if counts, ok := byDay[day]; ok {
 					dayTotal += counts.total
 					dayGood += counts.good
+					dayMaintenance += counts.maintenance
 				}
 			}
 		}
@@ -339,9 +421,10 @@ func buildTimelineSummary(monitorIDs []int64, days []time.Time, daily map[int64]
 			good:  dayGood,
 		})
 		points = append(points, publicTimelinePoint{
-			Day:     day,
-			Success: dayGood,
-			Fail:    dayTotal - dayGood,
+			Day:         day,
+			Success:     dayGood,
+			Fail:        dayTotal - dayGood,
+			Maintenance: dayMaintenance,
 		})
 	}
 
@@ -369,11 +452,17 @@ func sumLast(counts []dailyCounts, days int) (int64, int64) {
 	return good, total
 }
 
-func computeMonitorStatus(monitorID int64, monitorByID map[int64]models.Monitor, openPublicIncident map[int64]bool) string {
+// computeMonitorStatus is down for monitors with an open public incident, maintenance for monitors in an
+// active maintenance window and otherwise follows the monitor.
+func computeMonitorStatus(monitorID int64, monitorByID map[int64]models.Monitor, openPublicIncident map[int64]bool, underMaintenance map[int64]bool) string {
 	if openPublicIncident[monitorID] {
 		return "down"
 	}
 
+	if underMaintenance[monitorID] {
+		return "maintenance"
+	}
+
 	monitor, ok := monitorByID[monitorID]
 	if !ok {
 		return "down"
@@ -386,16 +475,17 @@ func computeMonitorStatus(monitorID int64, monitorByID map[int64]models.Monitor,
 	return "up"
 }
 
-func computeGroupStatus(monitorIDs []int64, monitorByID map[int64]models.Monitor, openPublicIncident map[int64]bool) string {
-	if len(monitorIDs) == 0 {
-		return "up"
-	}
+func computeGroupStatus(monitorIDs []int64, monitorByID map[int64]models.Monitor, openPublicIncident map[int64]bool, underMaintenance map[int64]bool) string {
+	status := "up"
 	for _, monitorID := range monitorIDs {
-		if computeMonitorStatus(monitorID, monitorByID, openPublicIncident) == "down" {
+		switch computeMonitorStatus(monitorID, monitorByID, openPublicIncident, underMaintenance) {
+		case "down":
 			return "down"
+		case "maintenance":
+			status = "maintenance"
 		}
 	}
-	return "up"
+	return status
 }
 
 func formatID(id int64) string {
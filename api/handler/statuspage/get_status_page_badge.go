@@ -0,0 +1,109 @@
+package statuspage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/core/badge"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// GetStatusPageBadge godoc
+// @Summary Get status page badge
+// @Description Renders a shields-style SVG badge of the overall status, uptime or average response time of the monitors on a status page. Labels default to the metric so component names are never exposed.
+// @Tags status-pages
+// @Produce image/svg+xml
+// @Param slug path string true "Status Page Slug"
+// @Param metric query string false "status (default), uptime or response_time"
+// @Param period query int false "Uptime/response time window in days: 30 (default), 60 or 90"
+// @Param style query string false "flat (default), flat-square or for-the-badge"
+// @Param label query string false "Custom label"
+// @Success 200 {string} string "SVG badge"
+// @Failure 400 {object} response.ErrorResponse "Invalid badge options"
+// @Failure 401 {object} response.ErrorResponse "Password or sign-in required"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/badge.svg [get]
+func (h *Handler) GetStatusPageBadge(c echo.Context) error {
+	opts, err := badge.ParseOptions(c.QueryParams())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	data, err := h.loadPublicStatusPage(c, tx)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	var result badge.Badge
+	start, end := badge.Window(opts.Period, time.Now())
+	switch opts.Metric {
+	case badge.MetricUptime:
+		summaries, err := h.Repo.ListMonitorDailySummaryByMonitorIDs(ctx, tx, data.MonitorIDs, start, end)
+		if err != nil {
+			zap.L().Error("Failed to list daily summaries", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page uptime")
+		}
+		good, total := badge.CountChecks(summaries)
+		result = badge.Uptime(opts, good, total)
+	case badge.MetricResponseTime:
+		latency, err := h.Repo.GetAverageLatencyByMonitorIDs(ctx, tx, data.MonitorIDs, start, end)
+		if err != nil {
+			zap.L().Error("Failed to get average latency", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page response time")
+		}
+		result = badge.ResponseTime(opts, latency)
+	default:
+		result = badge.Status(opts, statusPageBadgeStatus(data))
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if isPublicStatusPage(data.Page) {
+		c.Response().Header().Set("Cache-Control", "public, max-age="+badge.MaxAge)
+	}
+	return c.Blob(http.StatusOK, badge.ContentType, badge.Render(result, opts.Style))
+}
+
+// statusPageBadgeStatus is down when every monitor on the page is down and degraded when only some are.
+func statusPageBadgeStatus(data *publicStatusPageData) string {
+	openPublicIncident := make(map[int64]bool)
+	for _, incident := range data.Incidents {
+		if incident.Status != models.IncidentStatusResolved {
+			openPublicIncident[incident.MonitorID] = true
+		}
+	}
+
+	// Monitors in an active maintenance window don't count as down.
+	underMaintenance := activeMaintenanceMonitors(data.Maintenances, time.Now())
+	down := 0
+	for _, monitorID := range data.MonitorIDs {
+		if computeMonitorStatus(monitorID, data.MonitorByID, openPublicIncident, underMaintenance) == "down" {
+			down++
+		}
+	}
+
+	switch {
+	case down == 0:
+		return badge.StatusUp
+	case down == len(data.MonitorIDs):
+		return badge.StatusDown
+	default:
+		return badge.StatusDegraded
+	}
+}
@@ -0,0 +1,54 @@
+package statuspage
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+)
+
+func TestGetStatusPageBadge(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: publicStatusPageRepository(time.Now().UTC())}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/badge.svg", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatusPageBadge(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/svg+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	require.Contains(t, rec.Body.String(), "<title>status: down</title>")
+}
+
+func TestGetStatusPageBadge_ResponseTime(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	latency := 412.6
+	mockRepo := publicStatusPageRepository(time.Now().UTC())
+	mockRepo.On("GetAverageLatencyByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}, mock.Anything, mock.Anything).Return(&latency, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/badge.svg?metric=response_time&period=90&label=api", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatusPageBadge(c))
+	require.Contains(t, rec.Body.String(), "<title>api: 413ms</title>")
+	require.NotContains(t, rec.Body.String(), "Database outage")
+}
+
+func TestGetStatusPageBadge_InvalidOptions(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: publicStatusPageRepository(time.Now().UTC())}
+	c, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/badge.svg?period=7", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	requireHTTPError(t, h.GetStatusPageBadge(c), http.StatusBadRequest)
+}
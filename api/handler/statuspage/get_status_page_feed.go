@@ -0,0 +1,96 @@
+package statuspage
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"go.uber.org/zap"
+)
+
+// GetStatusPageRSSFeed godoc
+// @Summary Get status page RSS feed
+// @Description Renders the public incidents of a status page and their public updates as RSS 2.0. Supports If-Modified-Since.
+// @Tags status-pages
+// @Produce xml
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {string} string "RSS feed"
+// @Success 304 {string} string "Not modified"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/feed.rss [get]
+func (h *Handler) GetStatusPageRSSFeed(c echo.Context) error {
+	return h.renderStatusPageFeed(c, "feed.rss", "application/rss+xml; charset=utf-8", statuspagecore.RenderRSS)
+}
+
+// GetStatusPageAtomFeed godoc
+// @Summary Get status page Atom feed
+// @Description Renders the public incidents of a status page and their public updates as Atom 1.0. Supports If-Modified-Since.
+// @Tags status-pages
+// @Produce xml
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {string} string "Atom feed"
+// @Success 304 {string} string "Not modified"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/feed.atom [get]
+func (h *Handler) GetStatusPageAtomFeed(c echo.Context) error {
+	return h.renderStatusPageFeed(c, "feed.atom", "application/atom+xml; charset=utf-8", statuspagecore.RenderAtom)
+}
+
+func (h *Handler) renderStatusPageFeed(c echo.Context, name, contentType string, render func(statuspagecore.Feed) ([]byte, error)) error {
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	data, err := h.loadPublicStatusPage(c, tx)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	// An incident affecting several monitors is listed once per monitor; the feed wants it once.
+	seen := make(map[int64]struct{}, len(data.Incidents))
+	incidents := make([]models.Incident, 0, len(data.Incidents))
+	for _, incident := range data.Incidents {
+		if _, ok := seen[incident.ID]; ok {
+			continue
+		}
+		seen[incident.ID] = struct{}{}
+		incidents = append(incidents, incident.Incident)
+	}
+
+	link := statuspagecore.PageURL(config.Env().BackendURL, data.Page.Slug)
+	feed := statuspagecore.BuildFeed(*data.Page, link, link+"/"+name, incidents, data.Events)
+
+	lastModified := feed.LastModified()
+	c.Response().Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	if since, err := http.ParseTime(c.Request().Header.Get("If-Modified-Since")); err == nil && !lastModified.After(since) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	body, err := render(feed)
+	if err != nil {
+		zap.L().Error("Failed to render status page feed", zap.Error(err), zap.Int64("status_page_id", data.Page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render feed")
+	}
+
+	if isPublicStatusPage(data.Page) {
+		c.Response().Header().Set("Cache-Control", "public, max-age="+feedMaxAge)
+	}
+	return c.Blob(http.StatusOK, contentType, body)
+}
+
+// feedMaxAge (seconds) lets feed readers and proxies reuse a feed briefly; If-Modified-Since keeps later polls cheap.
+const feedMaxAge = "60"
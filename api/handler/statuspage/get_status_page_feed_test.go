@@ -0,0 +1,47 @@
+package statuspage
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+)
+
+func TestGetStatusPageAtomFeed(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	updated := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	h := &Handler{Repo: publicStatusPageRepository(updated)}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/feed.atom", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatusPageAtomFeed(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, updated.Format(http.TimeFormat), rec.Header().Get("Last-Modified"))
+	require.Contains(t, rec.Header().Get("Content-Type"), "application/atom+xml")
+
+	body := rec.Body.String()
+	require.Equal(t, 1, strings.Count(body, "<entry>"))
+	require.Contains(t, body, "<id>urn:kymarium:incident:9</id>")
+	require.Contains(t, body, "<updated>2026-03-04T05:06:07Z</updated>")
+	require.Contains(t, body, "Looking into it")
+}
+
+func TestGetStatusPageRSSFeed_NotModified(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	updated := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	h := &Handler{Repo: publicStatusPageRepository(updated)}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/feed.rss", nil)
+	c.Request().Header.Set("If-Modified-Since", updated.Format(http.TimeFormat))
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatusPageRSSFeed(c))
+	require.Equal(t, http.StatusNotModified, rec.Code)
+	require.Empty(t, rec.Body.String())
+}
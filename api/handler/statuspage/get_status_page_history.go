@@ -0,0 +1,87 @@
+package statuspage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type publicHistoryResponse struct {
+	Month string `json:"month"`
+	// PreviousMonth and NextMonth are the month values of the neighbouring pages, nil past either end.
+	PreviousMonth *string                 `json:"previous_month"`
+	NextMonth     *string                 `json:"next_month"`
+	Incidents     []publicHistoryIncident `json:"incidents"`
+	Locale        string                  `json:"locale"`
+}
+
+// GetStatusPageHistory godoc
+// @Summary Get status page incident history
+// @Description Lists the public incidents of a status page that started in one calendar month (UTC), newest first, with their duration and affected components. Page through months with previous_month and next_month.
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Param month query string false "Month as YYYY-MM, defaults to the current month"
+// @Param lang query string false "Locale, e.g. ja or zh-TW"
+// @Success 200 {object} response.SuccessResponse "Incident history returned"
+// @Failure 400 {object} response.ErrorResponse "Invalid month"
+// @Failure 401 {object} response.ErrorResponse "Password or sign-in required"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/history [get]
+func (h *Handler) GetStatusPageHistory(c echo.Context) error {
+	now := time.Now()
+	month, err := parseHistoryMonth(c.QueryParam("month"), now)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	data, err := h.loadPublicStatusPageComponents(c, tx)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	rows, err := h.Repo.ListPublicIncidentsByMonitorIDsBetween(c.Request().Context(), tx, data.MonitorIDs, month, month.AddDate(0, 1, 0))
+	if err != nil {
+		zap.L().Error("Failed to list public incidents", zap.Error(err), zap.Int64("status_page_id", data.Page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incidents")
+	}
+
+	earliest, err := h.Repo.GetEarliestPublicIncidentStartByMonitorIDs(c.Request().Context(), tx, data.MonitorIDs)
+	if err != nil {
+		zap.L().Error("Failed to get earliest public incident", zap.Error(err), zap.Int64("status_page_id", data.Page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incidents")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	locale := newPublicLocale(data.Page, c.QueryParam("lang"), c.Request().Header.Get("Accept-Language"))
+	previous, next := historyCursors(month, earliest, now)
+
+	resp := publicHistoryResponse{
+		Month:         month.Format(historyMonthLayout),
+		PreviousMonth: previous,
+		NextMonth:     next,
+		Incidents:     buildPublicHistoryIncidents(rows, data, locale, now),
+		Locale:        locale.locale,
+	}
+
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
+
+	return c.JSON(http.StatusOK, response.Success("Incident history returned", resp))
+}
@@ -0,0 +1,162 @@
+package statuspage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// GetStatuspageV2Summary godoc
+// @Summary Get Statuspage v2 summary
+// @Description Returns the page status, components and unresolved incidents in the Atlassian Statuspage v2 summary.json format
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {object} map[string]interface{} "Statuspage v2 summary"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/api/v2/summary.json [get]
+func (h *Handler) GetStatuspageV2Summary(c echo.Context) error {
+	view, err := h.loadV2View(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"page":                   view.Page,
+		"components":             view.Components,
+		"incidents":              unresolvedV2Incidents(view.Incidents),
+		"scheduled_maintenances": view.ScheduledMaintenances,
+		"status":                 view.Status,
+	})
+}
+
+// GetStatuspageV2Status godoc
+// @Summary Get Statuspage v2 status
+// @Description Returns the overall page indicator in the Atlassian Statuspage v2 status.json format
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {object} map[string]interface{} "Statuspage v2 status"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/api/v2/status.json [get]
+func (h *Handler) GetStatuspageV2Status(c echo.Context) error {
+	view, err := h.loadV2View(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"page":   view.Page,
+		"status": view.Status,
+	})
+}
+
+// GetStatuspageV2Components godoc
+// @Summary Get Statuspage v2 components
+// @Description Returns the page's groups and monitors as components in the Atlassian Statuspage v2 components.json format
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {object} map[string]interface{} "Statuspage v2 components"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/api/v2/components.json [get]
+func (h *Handler) GetStatuspageV2Components(c echo.Context) error {
+	view, err := h.loadV2View(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"page":       view.Page,
+		"components": view.Components,
+	})
+}
+
+// GetStatuspageV2Incidents godoc
+// @Summary Get Statuspage v2 incidents
+// @Description Returns the 50 most recent public incidents in the Atlassian Statuspage v2 incidents.json format
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {object} map[string]interface{} "Statuspage v2 incidents"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/api/v2/incidents.json [get]
+func (h *Handler) GetStatuspageV2Incidents(c echo.Context) error {
+	view, err := h.loadV2View(c)
+	if err != nil {
+		return err
+	}
+
+	incidents := view.Incidents
+	if len(incidents) > v2IncidentLimit {
+		incidents = incidents[:v2IncidentLimit]
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"page":      view.Page,
+		"incidents": incidents,
+	})
+}
+
+// GetStatuspageV2UnresolvedIncidents godoc
+// @Summary Get Statuspage v2 unresolved incidents
+// @Description Returns open public incidents in the Atlassian Statuspage v2 incidents/unresolved.json format
+// @Tags status-pages
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Success 200 {object} map[string]interface{} "Statuspage v2 unresolved incidents"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/api/v2/incidents/unresolved.json [get]
+func (h *Handler) GetStatuspageV2UnresolvedIncidents(c echo.Context) error {
+	view, err := h.loadV2View(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"page":      view.Page,
+		"incidents": unresolvedV2Incidents(view.Incidents),
+	})
+}
+
+func (h *Handler) loadV2View(c echo.Context) (*v2View, error) {
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	data, err := h.loadPublicStatusPage(c, tx)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	view := buildV2View(data, time.Now())
+	return &view, nil
+}
+
+func unresolvedV2Incidents(incidents []v2Incident) []v2Incident {
+	unresolved := make([]v2Incident, 0)
+	for _, incident := range incidents {
+		if incident.Status != string(models.IncidentStatusResolved) {
+			unresolved = append(unresolved, incident)
+		}
+	}
+	return unresolved
+}
@@ -1,8 +1,22 @@
 package statuspage
 
-import "github.com/yorukot/kymarium/repository"
+import (
+	"net/http"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/repository"
+)
 
 // Handler contains dependencies for status page endpoints.
 type Handler struct {
 	Repo repository.Repository
+	// Snapshots caches rendered public pages; nil serves every request from the database.
+	Snapshots *statuspagecore.SnapshotCache
+	// WebhookClient verifies new webhook subscribers; nil uses statuspagecore.NewWebhookClient.
+	WebhookClient *http.Client
+	// UnlockLimiter limits password attempts per page and client IP; nil uses a shared default.
+	UnlockLimiter *statuspagecore.AttemptLimiter
 }
+
+// defaultUnlockLimiter is used by handlers without an UnlockLimiter.
+var defaultUnlockLimiter = statuspagecore.NewAttemptLimiter(statuspagecore.UnlockAttemptLimit, statuspagecore.UnlockAttemptWindow)
@@ -0,0 +1,89 @@
+package statuspage
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// ListStatusPageSubscribers godoc
+// @Summary List status page subscribers
+// @Description Lists the email and webhook subscribers of a status page, including unconfirmed emails
+// @Tags status-pages
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Success 200 {object} response.SuccessResponse "Status page subscribers retrieved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid team ID or status page ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/subscribers [get]
+func (h *Handler) ListStatusPageSubscribers(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(c.Request().Context(), tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	subscribers, err := h.Repo.ListStatusPageSubscribers(c.Request().Context(), tx, page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list status page subscribers", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page subscribers")
+	}
+	if subscribers == nil {
+		subscribers = []models.StatusPageSubscriber{}
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Status page subscribers retrieved successfully", subscribers))
+}
@@ -17,12 +17,15 @@ func normalizeStatusPageUpsert(req statusPageUpsertRequest) (statusPageUpsertReq
 				return req, fmt.Errorf("monitor_id is required for monitor elements")
 			}
 			monitors = append(monitors, statusPageMonitorInput{
-				ID:        nil,
-				MonitorID: *element.MonitorID,
-				GroupID:   nil,
-				Name:      element.Name,
-				Type:      element.Type,
-				SortOrder: element.SortOrder,
+				ID:               nil,
+				MonitorID:        *element.MonitorID,
+				GroupID:          nil,
+				Name:             element.Name,
+				Type:             element.Type,
+				SortOrder:        element.SortOrder,
+				Days:             element.Days,
+				PerRegion:        element.PerRegion,
+				NameTranslations: element.NameTranslations,
 			})
 			continue
 		}
@@ -35,10 +38,13 @@ func normalizeStatusPageUpsert(req statusPageUpsertRequest) (statusPageUpsertReq
 		}
 
 		groups = append(groups, statusPageGroupInput{
-			ID:        groupID,
-			Name:      element.Name,
-			Type:      element.Type,
-			SortOrder: element.SortOrder,
+			ID:               groupID,
+			Name:             element.Name,
+			Type:             element.Type,
+			SortOrder:        element.SortOrder,
+			Days:             element.Days,
+			PerRegion:        element.PerRegion,
+			NameTranslations: element.NameTranslations,
 		})
 
 		for _, monitor := range element.Monitors {
@@ -0,0 +1,304 @@
+package statuspage
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils"
+	"go.uber.org/zap"
+)
+
+type publicLatencyPoint struct {
+	Time      time.Time `json:"time"`
+	LatencyMs float64   `json:"latency_ms"`
+}
+
+// publicRegionSeries is the chart data of a single region for per_region elements.
+type publicRegionSeries struct {
+	RegionID     string                `json:"region_id"`
+	Region       string                `json:"region"`
+	UptimeSLI    float64               `json:"uptime_sli,omitempty"`
+	Timeline     []publicTimelinePoint `json:"timeline,omitempty"`
+	ResponseTime []publicLatencyPoint  `json:"response_time,omitempty"`
+}
+
+// publicChartData holds the rollup rows uptime_bars and response_time_chart elements are built from.
+type publicChartData struct {
+	daily       []models.MonitorRegionDailySummary
+	maintenance map[maintenanceKey]int64              // failed checks inside maintenance windows
+	latency     map[int][]models.MonitorLatencyBucket // keyed by chart days
+	regionNames map[int64]string
+}
+
+type maintenanceKey struct {
+	monitorID int64
+	regionID  int64
+	day       time.Time
+}
+
+// chartElement is the part of a group or monitor that decides its chart data.
+type chartElement struct {
+	Type       models.StatusPageElementType
+	Days       *int
+	MonitorIDs []int64
+}
+
+// statusPageChartElements lists the chart elements of a page with the monitors each one covers.
+func statusPageChartElements(groups []models.StatusPageGroup, monitors []models.StatusPageMonitor) []chartElement {
+	groupMonitorIDs := make(map[int64][]int64, len(groups))
+	elements := make([]chartElement, 0)
+	for _, monitor := range monitors {
+		if monitor.GroupID != nil {
+			groupMonitorIDs[*monitor.GroupID] = append(groupMonitorIDs[*monitor.GroupID], monitor.MonitorID)
+		}
+		elements = append(elements, chartElement{Type: monitor.Type, Days: monitor.Days, MonitorIDs: []int64{monitor.MonitorID}})
+	}
+	for _, group := range groups {
+		elements = append(elements, chartElement{Type: group.Type, Days: group.Days, MonitorIDs: groupMonitorIDs[group.ID]})
+	}
+	return elements
+}
+
+func elementDays(elementType models.StatusPageElementType, days *int) int {
+	if days != nil {
+		return *days
+	}
+	if elementType == models.StatusPageElementTypeResponseTimeChart {
+		return defaultResponseTimeChartDays
+	}
+	return defaultUptimeBarsDays
+}
+
+// responseTimeBucket keeps a chart between roughly 100 and 170 points.
+func responseTimeBucket(days int) time.Duration {
+	switch {
+	case days <= 1:
+		return 10 * time.Minute
+	case days <= 7:
+		return time.Hour
+	default:
+		return 6 * time.Hour
+	}
+}
+
+// loadPublicChartData reads the rollups needed by the chart elements of a page, one query for all
+// uptime bars and one per distinct response time window.
+func (h *Handler) loadPublicChartData(ctx context.Context, tx pgx.Tx, elements []chartElement, now time.Time) (*publicChartData, error) {
+	data := &publicChartData{
+		maintenance: make(map[maintenanceKey]int64),
+		latency:     make(map[int][]models.MonitorLatencyBucket),
+		regionNames: make(map[int64]string),
+	}
+
+	barsDays := 0
+	var barsMonitorIDs []int64
+	chartMonitorIDs := make(map[int][]int64)
+	for _, element := range elements {
+		days := elementDays(element.Type, element.Days)
+		switch element.Type {
+		case models.StatusPageElementTypeUptimeBars:
+			barsDays = max(barsDays, days)
+			barsMonitorIDs = append(barsMonitorIDs, element.MonitorIDs...)
+		case models.StatusPageElementTypeResponseTimeChart:
+			chartMonitorIDs[days] = append(chartMonitorIDs[days], element.MonitorIDs...)
+		}
+	}
+
+	if len(barsMonitorIDs) > 0 {
+		start, end := timelineWindow(now, barsDays)
+		daily, err := h.Repo.ListMonitorRegionDailySummaryByMonitorIDs(ctx, tx, utils.UniqueInt64s(barsMonitorIDs), start, end)
+		if err != nil {
+			zap.L().Error("Failed to list regional daily summaries", zap.Error(err))
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list uptime data")
+		}
+		data.daily = daily
+
+		failures, err := h.Repo.ListMonitorMaintenanceFailures(ctx, tx, utils.UniqueInt64s(barsMonitorIDs), start, end)
+		if err != nil {
+			zap.L().Error("Failed to list maintenance failures", zap.Error(err))
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list uptime data")
+		}
+		for _, failure := range failures {
+			data.maintenance[maintenanceKey{monitorID: failure.MonitorID, regionID: failure.RegionID, day: failure.Day.UTC()}] += failure.FailCount
+		}
+	}
+
+	for days, monitorIDs := range chartMonitorIDs {
+		buckets, err := h.Repo.ListMonitorLatencyBuckets(ctx, tx, utils.UniqueInt64s(monitorIDs), now.Add(-time.Duration(days)*24*time.Hour), now, responseTimeBucket(days))
+		if err != nil {
+			zap.L().Error("Failed to list latency buckets", zap.Error(err))
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list response time data")
+		}
+		data.latency[days] = buckets
+	}
+
+	regionIDs := make([]int64, 0)
+	for _, summary := range data.daily {
+		regionIDs = append(regionIDs, summary.RegionID)
+	}
+	for _, buckets := range data.latency {
+		for _, bucket := range buckets {
+			regionIDs = append(regionIDs, bucket.RegionID)
+		}
+	}
+	if len(regionIDs) > 0 {
+		regions, err := h.Repo.ListRegionsByIDs(ctx, tx, utils.UniqueInt64s(regionIDs))
+		if err != nil {
+			zap.L().Error("Failed to list regions", zap.Error(err))
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list regions")
+		}
+		for _, region := range regions {
+			data.regionNames[region.ID] = region.Name
+		}
+	}
+
+	return data, nil
+}
+
+// uptimeBars returns the daily bars of the last days days across the monitors, plus one series per
+// region when perRegion is set.
+func (d *publicChartData) uptimeBars(monitorIDs []int64, days int, perRegion bool, now time.Time) ([]publicTimelinePoint, float64, []publicRegionSeries) {
+	start, end := timelineWindow(now, days)
+	window := buildTimelineDays(start, end)
+	wanted := idSet(monitorIDs)
+
+	total := make(map[time.Time]dailyCounts)
+	byRegion := make(map[int64]map[time.Time]dailyCounts)
+	for _, summary := range d.daily {
+		if _, ok := wanted[summary.MonitorID]; !ok {
+			continue
+		}
+		day := summary.Day.UTC()
+		counts := dailyCounts{total: summary.TotalCount, good: summary.GoodCount}
+		counts = counts.excuse(d.maintenance[maintenanceKey{monitorID: summary.MonitorID, regionID: summary.RegionID, day: day}])
+		total[day] = addCounts(total[day], counts)
+		if perRegion {
+			if byRegion[summary.RegionID] == nil {
+				byRegion[summary.RegionID] = make(map[time.Time]dailyCounts)
+			}
+			byRegion[summary.RegionID][day] = addCounts(byRegion[summary.RegionID][day], counts)
+		}
+	}
+
+	timeline, sli := countsTimeline(window, total)
+	if !perRegion {
+		return timeline, sli, nil
+	}
+
+	regions := make([]publicRegionSeries, 0, len(byRegion))
+	for regionID, counts := range byRegion {
+		regionTimeline, regionSLI := countsTimeline(window, counts)
+		regions = append(regions, publicRegionSeries{
+			RegionID:  formatID(regionID),
+			Region:    d.regionNames[regionID],
+			UptimeSLI: regionSLI,
+			Timeline:  regionTimeline,
+		})
+	}
+	sortRegionSeries(regions)
+
+	return timeline, sli, regions
+}
+
+// responseTime returns the latency chart across the monitors, plus one series per region when perRegion is set.
+// Points are weighted by check count; buckets without checks are omitted.
+func (d *publicChartData) responseTime(monitorIDs []int64, days int, perRegion bool) ([]publicLatencyPoint, []publicRegionSeries) {
+	wanted := idSet(monitorIDs)
+
+	total := make(map[time.Time]latencySum)
+	byRegion := make(map[int64]map[time.Time]latencySum)
+	for _, bucket := range d.latency[days] {
+		if _, ok := wanted[bucket.MonitorID]; !ok {
+			continue
+		}
+		at := bucket.Bucket.UTC()
+		total[at] = total[at].add(bucket)
+		if perRegion {
+			if byRegion[bucket.RegionID] == nil {
+				byRegion[bucket.RegionID] = make(map[time.Time]latencySum)
+			}
+			byRegion[bucket.RegionID][at] = byRegion[bucket.RegionID][at].add(bucket)
+		}
+	}
+
+	points := latencyPoints(total)
+	if !perRegion {
+		return points, nil
+	}
+
+	regions := make([]publicRegionSeries, 0, len(byRegion))
+	for regionID, sums := range byRegion {
+		regions = append(regions, publicRegionSeries{
+			RegionID:     formatID(regionID),
+			Region:       d.regionNames[regionID],
+			ResponseTime: latencyPoints(sums),
+		})
+	}
+	sortRegionSeries(regions)
+
+	return points, regions
+}
+
+type latencySum struct {
+	weighted float64
+	count    int64
+}
+
+func (s latencySum) add(bucket models.MonitorLatencyBucket) latencySum {
+	s.weighted += bucket.LatencyMs * float64(bucket.TotalCount)
+	s.count += bucket.TotalCount
+	return s
+}
+
+func latencyPoints(sums map[time.Time]latencySum) []publicLatencyPoint {
+	points := make([]publicLatencyPoint, 0, len(sums))
+	for at, sum := range sums {
+		if sum.count == 0 {
+			continue
+		}
+		points = append(points, publicLatencyPoint{Time: at, LatencyMs: sum.weighted / float64(sum.count)})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].Time.Before(points[j].Time) })
+	return points
+}
+
+func addCounts(counts dailyCounts, other dailyCounts) dailyCounts {
+	counts.total += other.total
+	counts.good += other.good
+	counts.maintenance += other.maintenance
+	return counts
+}
+
+func countsTimeline(days []time.Time, counts map[time.Time]dailyCounts) ([]publicTimelinePoint, float64) {
+	points := make([]publicTimelinePoint, 0, len(days))
+	var total, good int64
+	for _, day := range days {
+		c := counts[day]
+		total += c.total
+		good += c.good
+		points = append(points, publicTimelinePoint{Day: day, Success: c.good, Fail: c.total - c.good, Maintenance: c.maintenance})
+	}
+	return points, percentage(good, total)
+}
+
+func sortRegionSeries(regions []publicRegionSeries) {
+	sort.Slice(regions, func(i, j int) bool {
+		if regions[i].Region == regions[j].Region {
+			return regions[i].RegionID < regions[j].RegionID
+		}
+		return regions[i].Region < regions[j].Region
+	})
+}
+
+func idSet(ids []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
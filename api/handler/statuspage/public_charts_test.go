@@ -0,0 +1,95 @@
+package statuspage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestValidateElementSettings(t *testing.T) {
+	days := func(v int) *int { return &v }
+
+	require.NoError(t, validateElementSettings("group", models.StatusPageElementTypeUptimeBars, nil, true))
+	require.NoError(t, validateElementSettings("group", models.StatusPageElementTypeUptimeBars, days(365), false))
+	require.NoError(t, validateElementSettings("group", models.StatusPageElementTypeResponseTimeChart, days(7), true))
+	require.NoError(t, validateElementSettings("group", models.StatusPageElementTypeHistoricalTimeline, nil, false))
+
+	require.Error(t, validateElementSettings("group", models.StatusPageElementTypeUptimeBars, days(0), false))
+	require.Error(t, validateElementSettings("group", models.StatusPageElementTypeResponseTimeChart, days(31), false))
+	require.Error(t, validateElementSettings("group", models.StatusPageElementTypeHistoricalTimeline, days(30), false))
+	require.Error(t, validateElementSettings("group", models.StatusPageElementTypeCurrentStatusIndicator, nil, true))
+}
+
+func TestPublicChartData_UptimeBars(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+
+	data := &publicChartData{
+		daily: []models.MonitorRegionDailySummary{
+			{MonitorDailySummary: models.MonitorDailySummary{MonitorID: 100, Day: today, TotalCount: 10, GoodCount: 10}, RegionID: 1},
+			{MonitorDailySummary: models.MonitorDailySummary{MonitorID: 100, Day: today, TotalCount: 10, GoodCount: 5}, RegionID: 2},
+			{MonitorDailySummary: models.MonitorDailySummary{MonitorID: 101, Day: yesterday, TotalCount: 4, GoodCount: 4}, RegionID: 1},
+			{MonitorDailySummary: models.MonitorDailySummary{MonitorID: 999, Day: today, TotalCount: 50, GoodCount: 0}, RegionID: 1},
+		},
+		regionNames: map[int64]string{1: "Tokyo", 2: "Frankfurt"},
+	}
+
+	timeline, sli, regions := data.uptimeBars([]int64{100, 101}, 3, false, now)
+	require.Len(t, timeline, 3)
+	require.Nil(t, regions)
+	require.Equal(t, yesterday, timeline[1].Day)
+	require.Equal(t, int64(4), timeline[1].Success)
+	require.Equal(t, int64(15), timeline[2].Success)
+	require.Equal(t, int64(5), timeline[2].Fail)
+	require.InDelta(t, 79.17, sli, 0.01)
+
+	_, _, regions = data.uptimeBars([]int64{100, 101}, 3, true, now)
+	require.Len(t, regions, 2)
+	require.Equal(t, "Frankfurt", regions[0].Region)
+	require.Equal(t, "2", regions[0].RegionID)
+	require.InDelta(t, 50, regions[0].UptimeSLI, 0.01)
+	require.Equal(t, "Tokyo", regions[1].Region)
+	require.InDelta(t, 100, regions[1].UptimeSLI, 0.01)
+}
+
+func TestPublicChartData_ResponseTime(t *testing.T) {
+	first := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	second := first.Add(10 * time.Minute)
+
+	data := &publicChartData{
+		latency: map[int][]models.MonitorLatencyBucket{
+			1: {
+				{MonitorID: 100, RegionID: 1, Bucket: second, TotalCount: 3, LatencyMs: 100},
+				{MonitorID: 100, RegionID: 2, Bucket: second, TotalCount: 1, LatencyMs: 300},
+				{MonitorID: 100, RegionID: 1, Bucket: first, TotalCount: 2, LatencyMs: 50},
+				{MonitorID: 100, RegionID: 2, Bucket: first, TotalCount: 0, LatencyMs: 0},
+			},
+		},
+		regionNames: map[int64]string{1: "Tokyo", 2: "Frankfurt"},
+	}
+
+	points, regions := data.responseTime([]int64{100}, 1, false)
+	require.Nil(t, regions)
+	require.Len(t, points, 2)
+	require.Equal(t, first, points[0].Time)
+	require.InDelta(t, 50, points[0].LatencyMs, 0.01)
+	require.InDelta(t, 150, points[1].LatencyMs, 0.01)
+
+	_, regions = data.responseTime([]int64{100}, 1, true)
+	require.Len(t, regions, 2)
+	require.Equal(t, "Frankfurt", regions[0].Region)
+	require.Len(t, regions[0].ResponseTime, 1)
+	require.InDelta(t, 300, regions[0].ResponseTime[0].LatencyMs, 0.01)
+
+	points, _ = data.responseTime([]int64{100}, 7, false)
+	require.Empty(t, points)
+}
+
+func TestResponseTimeBucket(t *testing.T) {
+	require.Equal(t, 10*time.Minute, responseTimeBucket(1))
+	require.Equal(t, time.Hour, responseTimeBucket(7))
+	require.Equal(t, 6*time.Hour, responseTimeBucket(30))
+}
@@ -0,0 +1,225 @@
+package statuspage
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// publicStatusPageData is everything a public view of a status page is built from.
+type publicStatusPageData struct {
+	Page        *models.StatusPage
+	Groups      []models.StatusPageGroup
+	Monitors    []models.StatusPageMonitor
+	MonitorIDs  []int64
+	MonitorByID map[int64]models.Monitor
+	// Incidents holds one row per public incident and affected monitor shown on the page.
+	Incidents []models.IncidentWithMonitorID
+	// Events are the public timeline events of Incidents, oldest first.
+	Events []models.EventTimeline
+	// Maintenances are the scheduled and in-progress maintenances of the page, soonest first, and
+	// MaintenanceUpdates their timelines, oldest first.
+	Maintenances       []models.Maintenance
+	MaintenanceUpdates []models.MaintenanceUpdate
+	// Overrides are the component overrides of the page in effect, newest first.
+	Overrides []models.ComponentOverride
+}
+
+// publicStatusPageLookup identifies the page of a public request by slug or, when empty, by custom domain.
+type publicStatusPageLookup struct {
+	Slug   string
+	Domain string
+}
+
+// newPublicStatusPageLookup reads the :slug path parameter when the route has one, otherwise the request host.
+func newPublicStatusPageLookup(c echo.Context) publicStatusPageLookup {
+	if slug := c.Param("slug"); slug != "" {
+		return publicStatusPageLookup{Slug: slug}
+	}
+
+	domain, err := statuspagecore.NormalizeDomain(requestHost(c))
+	if err != nil {
+		return publicStatusPageLookup{}
+	}
+	return publicStatusPageLookup{Domain: domain}
+}
+
+// findPublicStatusPage resolves the page of a public request: by the :slug path parameter when the
+// route has one, otherwise by the request host matched against verified custom domains.
+// It returns nil when no page matches; errors are already HTTP errors.
+func (h *Handler) findPublicStatusPage(c echo.Context, tx pgx.Tx) (*models.StatusPage, error) {
+	return h.findStatusPage(c.Request().Context(), tx, newPublicStatusPageLookup(c))
+}
+
+func (h *Handler) findStatusPage(ctx context.Context, tx pgx.Tx, lookup publicStatusPageLookup) (*models.StatusPage, error) {
+	if lookup.Slug != "" {
+		page, err := h.Repo.GetStatusPageBySlug(ctx, tx, lookup.Slug)
+		if err != nil {
+			zap.L().Error("Failed to get status page by slug", zap.Error(err))
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+		}
+		return page, nil
+	}
+
+	if lookup.Domain == "" {
+		return nil, nil
+	}
+
+	page, err := h.Repo.GetStatusPageByCustomDomain(ctx, tx, lookup.Domain)
+	if err != nil {
+		zap.L().Error("Failed to get status page by custom domain", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	// A claimed but unverified domain must not serve the page.
+	if page == nil || page.DomainVerifiedAt == nil {
+		return nil, nil
+	}
+
+	return page, nil
+}
+
+// requestHost is the host the visitor asked for. TLS is terminated by a proxy in front of the API,
+// so X-Forwarded-Host wins over Host when present.
+func requestHost(c echo.Context) string {
+	if forwarded := c.Request().Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(host)
+	}
+	return c.Request().Host
+}
+
+// loadPublicStatusPage loads the page of a public request, its components, their public incidents and
+// open maintenances, enforcing the page visibility. It returns nil data when no page matches; errors are already HTTP errors.
+func (h *Handler) loadPublicStatusPage(c echo.Context, tx pgx.Tx) (*publicStatusPageData, error) {
+	data, err := h.loadPublicStatusPageComponents(c, tx)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	if err := h.loadStatusPageIncidents(c.Request().Context(), tx, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// loadStatusPageIncidents adds the public incidents, open maintenances and component overrides of the
+// components in data.
+func (h *Handler) loadStatusPageIncidents(ctx context.Context, tx pgx.Tx, data *publicStatusPageData) error {
+	incidents, err := h.Repo.ListPublicIncidentsByMonitorIDs(ctx, tx, data.MonitorIDs)
+	if err != nil {
+		zap.L().Error("Failed to list public incidents", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incidents")
+	}
+
+	incidentIDs := make([]int64, 0, len(incidents))
+	for _, incident := range incidents {
+		incidentIDs = append(incidentIDs, incident.ID)
+	}
+
+	events, err := h.Repo.ListPublicEventTimelinesByIncidentIDs(ctx, tx, incidentIDs)
+	if err != nil {
+		zap.L().Error("Failed to list public incident timelines", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list incident timelines")
+	}
+
+	maintenances, err := h.Repo.ListOpenMaintenancesByStatusPageID(ctx, tx, data.Page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list open maintenances", zap.Error(err), zap.Int64("status_page_id", data.Page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list maintenances")
+	}
+
+	maintenanceIDs := make([]int64, 0, len(maintenances))
+	for _, maintenance := range maintenances {
+		maintenanceIDs = append(maintenanceIDs, maintenance.ID)
+	}
+
+	maintenanceUpdates, err := h.Repo.ListMaintenanceUpdatesByMaintenanceIDs(ctx, tx, maintenanceIDs)
+	if err != nil {
+		zap.L().Error("Failed to list maintenance updates", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list maintenance updates")
+	}
+
+	overrides, err := h.Repo.ListActiveComponentOverridesByStatusPageID(ctx, tx, data.Page.ID, time.Now())
+	if err != nil {
+		zap.L().Error("Failed to list component overrides", zap.Error(err), zap.Int64("status_page_id", data.Page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list component overrides")
+	}
+
+	data.Incidents = incidents
+	data.Events = events
+	data.Maintenances = maintenances
+	data.MaintenanceUpdates = maintenanceUpdates
+	data.Overrides = overrides
+
+	return nil
+}
+
+// loadPublicStatusPageComponents loads the page of a public request with its groups and monitors only,
+// enforcing the page visibility. It returns nil data when no page matches; errors are already HTTP errors.
+func (h *Handler) loadPublicStatusPageComponents(c echo.Context, tx pgx.Tx) (*publicStatusPageData, error) {
+	page, err := h.findPublicStatusPage(c, tx)
+	if err != nil {
+		return nil, err
+	}
+	if page == nil {
+		return nil, nil
+	}
+
+	if err := h.authorizePublicStatusPage(c, tx, page); err != nil {
+		return nil, err
+	}
+
+	return h.loadStatusPageComponents(c.Request().Context(), tx, page)
+}
+
+// loadStatusPageComponents loads the groups and monitors of a page without checking its visibility.
+func (h *Handler) loadStatusPageComponents(ctx context.Context, tx pgx.Tx, page *models.StatusPage) (*publicStatusPageData, error) {
+	groups, err := h.Repo.ListStatusPageGroupsByStatusPageID(ctx, tx, page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list status page groups", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page groups")
+	}
+
+	monitors, err := h.Repo.ListStatusPageMonitorsByStatusPageID(ctx, tx, page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list status page monitors", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page monitors")
+	}
+
+	monitorIDs := make([]int64, 0, len(monitors))
+	monitorSeen := make(map[int64]struct{}, len(monitors))
+	for _, m := range monitors {
+		if _, exists := monitorSeen[m.MonitorID]; exists {
+			continue
+		}
+		monitorSeen[m.MonitorID] = struct{}{}
+		monitorIDs = append(monitorIDs, m.MonitorID)
+	}
+
+	monitorRows, err := h.Repo.ListMonitorsByIDs(ctx, tx, page.TeamID, monitorIDs)
+	if err != nil {
+		zap.L().Error("Failed to list monitors by ids", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to list monitors")
+	}
+
+	monitorByID := make(map[int64]models.Monitor, len(monitorRows))
+	for _, monitor := range monitorRows {
+		monitorByID[monitor.ID] = monitor
+	}
+
+	return &publicStatusPageData{
+		Page:        page,
+		Groups:      groups,
+		Monitors:    monitors,
+		MonitorIDs:  monitorIDs,
+		MonitorByID: monitorByID,
+	}, nil
+}
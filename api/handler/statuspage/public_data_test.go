@@ -0,0 +1,144 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// publicStatusPageRepository mocks everything loadPublicStatusPage reads for the "acme" page: two monitors
+// sharing one open public incident whose latest update was at updated.
+func publicStatusPageRepository(updated time.Time) *repository.MockRepository {
+	title := "Database outage"
+	userID := int64(5)
+	incident := models.Incident{ID: 9, Title: &title, Status: models.IncidentStatusInvestigating, IsPublic: true, StartedAt: updated.Add(-time.Hour), UpdatedAt: updated.Add(-time.Hour)}
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 2, Title: "Acme", Slug: "acme", UpdatedAt: updated.Add(-2 * time.Hour)}, nil)
+	mockRepo.On("ListStatusPageGroupsByStatusPageID", mock.Anything, mock.Anything, int64(1)).Return([]models.StatusPageGroup{}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(1)).
+		Return([]models.StatusPageMonitor{{ID: 11, StatusPageID: 1, MonitorID: 100}, {ID: 12, StatusPageID: 1, MonitorID: 101}}, nil)
+	mockRepo.On("ListMonitorsByIDs", mock.Anything, mock.Anything, int64(2), []int64{100, 101}).Return([]models.Monitor{}, nil)
+	mockRepo.On("ListPublicIncidentsByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}).
+		Return([]models.IncidentWithMonitorID{{Incident: incident, MonitorID: 100}, {Incident: incident, MonitorID: 101}}, nil)
+	mockRepo.On("ListPublicEventTimelinesByIncidentIDs", mock.Anything, mock.Anything, []int64{9, 9}).
+		Return([]models.EventTimeline{{ID: 50, IncidentID: 9, CreatedBy: &userID, Message: "Looking into it", EventType: models.IncidentEventTypeInvestigating, CreatedAt: updated, UpdatedAt: updated}}, nil)
+	mockRepo.On("ListOpenMaintenancesByStatusPageID", mock.Anything, mock.Anything, int64(1)).Return([]models.Maintenance{}, nil)
+	mockRepo.On("ListMaintenanceUpdatesByMaintenanceIDs", mock.Anything, mock.Anything, []int64{}).Return([]models.MaintenanceUpdate{}, nil)
+	mockRepo.On("ListActiveComponentOverridesByStatusPageID", mock.Anything, mock.Anything, int64(1), mock.Anything).Return([]models.ComponentOverride{}, nil)
+
+	return mockRepo
+}
+
+func TestFindPublicStatusPage_CustomDomain(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	domain := "status.acme.com"
+	verifiedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("GetStatusPageByCustomDomain", mock.Anything, mock.Anything, domain).
+		Return(&models.StatusPage{ID: 1, Slug: "acme", CustomDomain: &domain, DomainVerifiedAt: &verifiedAt}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodGet, "/status-page", nil)
+	c.Request().Host = "internal:8000"
+	c.Request().Header.Set("X-Forwarded-Host", "Status.Acme.com:443, proxy.local")
+
+	page, err := h.findPublicStatusPage(c, nil)
+	require.NoError(t, err)
+	require.NotNil(t, page)
+	require.Equal(t, int64(1), page.ID)
+}
+
+func TestFindPublicStatusPage_UnverifiedDomain(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	domain := "status.acme.com"
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("GetStatusPageByCustomDomain", mock.Anything, mock.Anything, domain).
+		Return(&models.StatusPage{ID: 1, Slug: "acme", CustomDomain: &domain}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodGet, "/status-page", nil)
+	c.Request().Host = domain
+
+	page, err := h.findPublicStatusPage(c, nil)
+	require.NoError(t, err)
+	require.Nil(t, page)
+}
+
+func TestUpdateStatusPageDomain_Conflict(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	domain := "status.acme.com"
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(2), int64(7)).
+		Return(&models.TeamMember{TeamID: 2, UserID: 7, Role: models.MemberRoleOwner}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(2), int64(1)).
+		Return(&models.StatusPage{ID: 1, TeamID: 2, Slug: "acme"}, nil)
+	verifiedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockRepo.On("GetStatusPageByCustomDomain", mock.Anything, mock.Anything, domain).
+		Return(&models.StatusPage{ID: 3, TeamID: 4, Slug: "other", CustomDomain: &domain, DomainVerifiedAt: &verifiedAt}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, _ := testutil.NewEchoContext(http.MethodPut, "/teams/2/status-pages/1/domain", strings.NewReader(`{"domain":"Status.Acme.com"}`))
+	testutil.Authenticate(c, 7)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("2", "1")
+
+	requireHTTPError(t, h.UpdateStatusPageDomain(c), http.StatusConflict)
+	mockRepo.AssertNotCalled(t, "UpdateStatusPageDomain", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateStatusPageDomain_UnverifiedClaimDoesNotBlock(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	domain := "status.acme.com"
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(2), int64(7)).
+		Return(&models.TeamMember{TeamID: 2, UserID: 7, Role: models.MemberRoleOwner}, nil)
+	mockRepo.On("GetStatusPageByID", mock.Anything, mock.Anything, int64(2), int64(1)).
+		Return(&models.StatusPage{ID: 1, TeamID: 2, Slug: "acme"}, nil)
+	// Another page only claimed the domain, so no verified page is found.
+	mockRepo.On("GetStatusPageByCustomDomain", mock.Anything, mock.Anything, domain).Return(nil, nil)
+
+	claimedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	token := "token"
+	mockRepo.On("UpdateStatusPageDomain", mock.Anything, mock.Anything, int64(2), int64(1), &domain, mock.Anything, mock.Anything).
+		Return(&models.StatusPage{ID: 1, TeamID: 2, Slug: "acme", CustomDomain: &domain, DomainVerificationToken: &token, DomainClaimedAt: &claimedAt}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPut, "/teams/2/status-pages/1/domain", strings.NewReader(`{"domain":"status.acme.com"}`))
+	testutil.Authenticate(c, 7)
+	c.SetParamNames("teamID", "id")
+	c.SetParamValues("2", "1")
+
+	require.NoError(t, h.UpdateStatusPageDomain(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data statusPageDomainResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.False(t, resp.Data.Verified)
+	require.NotNil(t, resp.Data.ClaimExpiresAt)
+	require.True(t, resp.Data.ClaimExpiresAt.Equal(claimedAt.Add(statuspagecore.DomainClaimTTL)))
+}
@@ -0,0 +1,102 @@
+package statuspage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// historyMonthLayout is the format of the month query parameter and cursors of the incident history.
+const historyMonthLayout = "2006-01"
+
+type publicIncidentComponent struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type publicHistoryIncident struct {
+	models.Incident
+	// DurationSeconds runs until now for incidents that are not resolved yet.
+	DurationSeconds int64                     `json:"duration_seconds"`
+	Components      []publicIncidentComponent `json:"components"`
+}
+
+// parseHistoryMonth returns the first instant of the month named by value, or of the month of now
+// when value is empty. Months after the one of now are rejected.
+func parseHistoryMonth(value string, now time.Time) (time.Time, error) {
+	current := monthStart(now)
+	if value == "" {
+		return current, nil
+	}
+
+	month, err := time.Parse(historyMonthLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("month must be formatted as YYYY-MM")
+	}
+	if month.After(current) {
+		return time.Time{}, fmt.Errorf("month must not be in the future")
+	}
+	return month, nil
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// historyCursors returns the neighbouring months of month that can hold incidents: none before the
+// month of the earliest incident and none after the month of now.
+func historyCursors(month time.Time, earliest *time.Time, now time.Time) (*string, *string) {
+	var previous, next *string
+	if earliest != nil && earliest.Before(month) {
+		value := month.AddDate(0, -1, 0).Format(historyMonthLayout)
+		previous = &value
+	}
+	if month.Before(monthStart(now)) {
+		value := month.AddDate(0, 1, 0).Format(historyMonthLayout)
+		next = &value
+	}
+	return previous, next
+}
+
+// buildPublicHistoryIncidents collapses incident rows into one entry per incident, in the order of
+// rows, naming the affected components as they are shown on the page.
+func buildPublicHistoryIncidents(rows []models.IncidentWithMonitorID, data *publicStatusPageData, locale publicLocale, now time.Time) []publicHistoryIncident {
+	componentNames := make(map[int64]string, len(data.Monitors))
+	for _, monitor := range sortedStatusPageMonitors(data.Monitors) {
+		if _, ok := componentNames[monitor.MonitorID]; !ok {
+			componentNames[monitor.MonitorID] = locale.translate(monitor.Name, monitor.NameTranslations)
+		}
+	}
+
+	incidents, monitorsByIncident := uniquePublicIncidents(rows)
+	result := make([]publicHistoryIncident, 0, len(incidents))
+	for _, incident := range incidents {
+		components := make([]publicIncidentComponent, 0, len(monitorsByIncident[incident.ID]))
+		for _, monitorID := range monitorsByIncident[incident.ID] {
+			components = append(components, publicIncidentComponent{
+				ID:   formatID(monitorID),
+				Name: componentNames[monitorID],
+			})
+		}
+
+		result = append(result, publicHistoryIncident{
+			Incident:        incident,
+			DurationSeconds: incidentDuration(incident, now),
+			Components:      components,
+		})
+	}
+	return result
+}
+
+func incidentDuration(incident models.Incident, now time.Time) int64 {
+	end := now
+	if incident.ResolvedAt != nil {
+		end = *incident.ResolvedAt
+	}
+	if end.Before(incident.StartedAt) {
+		return 0
+	}
+	return int64(end.Sub(incident.StartedAt) / time.Second)
+}
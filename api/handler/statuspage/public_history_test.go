@@ -0,0 +1,157 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestParseHistoryMonth(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	month, err := parseHistoryMonth("", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), month)
+
+	month, err = parseHistoryMonth("2025-12", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), month)
+
+	_, err = parseHistoryMonth("2026-04", now)
+	require.Error(t, err)
+	_, err = parseHistoryMonth("March 2026", now)
+	require.Error(t, err)
+}
+
+func TestHistoryCursors(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	earliest := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	previous, next := historyCursors(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), &earliest, now)
+	require.Equal(t, "2026-02", *previous)
+	require.Nil(t, next)
+
+	previous, next = historyCursors(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), &earliest, now)
+	require.Nil(t, previous)
+	require.Equal(t, "2026-02", *next)
+
+	previous, _ = historyCursors(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), nil, now)
+	require.Nil(t, previous)
+}
+
+func TestBuildPublicHistoryIncidents(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	resolvedAt := now.Add(-time.Hour)
+	resolved := models.Incident{ID: 1, Status: models.IncidentStatusResolved, StartedAt: now.Add(-3 * time.Hour), ResolvedAt: &resolvedAt}
+	open := models.Incident{ID: 2, Status: models.IncidentStatusInvestigating, StartedAt: now.Add(-30 * time.Minute)}
+
+	data := &publicStatusPageData{Monitors: []models.StatusPageMonitor{
+		{MonitorID: 100, Name: "API", SortOrder: 1, NameTranslations: models.Translations{"ja": "API サーバー"}},
+		{MonitorID: 101, Name: "Website", SortOrder: 2},
+	}}
+	rows := []models.IncidentWithMonitorID{
+		{Incident: open, MonitorID: 101},
+		{Incident: resolved, MonitorID: 100},
+		{Incident: resolved, MonitorID: 101},
+	}
+
+	incidents := buildPublicHistoryIncidents(rows, data, publicLocale{locale: "ja", defaultLocale: "en"}, now)
+	require.Len(t, incidents, 2)
+	require.Equal(t, int64(2), incidents[0].ID)
+	require.Equal(t, int64(30*60), incidents[0].DurationSeconds)
+	require.Equal(t, []publicIncidentComponent{{ID: "101", Name: "Website"}}, incidents[0].Components)
+	require.Equal(t, int64(2*60*60), incidents[1].DurationSeconds)
+	require.Equal(t, []publicIncidentComponent{{ID: "100", Name: "API サーバー"}, {ID: "101", Name: "Website"}}, incidents[1].Components)
+}
+
+func TestGetStatusPageHistory(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	updated := time.Now().UTC()
+	mockRepo := publicStatusPageRepository(updated)
+	title := "Database outage"
+	incident := models.Incident{ID: 9, Title: &title, Status: models.IncidentStatusInvestigating, IsPublic: true, StartedAt: updated.Add(-time.Hour)}
+	mockRepo.On("ListPublicIncidentsByMonitorIDsBetween", mock.Anything, mock.Anything, []int64{100, 101}, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)).
+		Return([]models.IncidentWithMonitorID{{Incident: incident, MonitorID: 100}, {Incident: incident, MonitorID: 101}}, nil)
+	earliest := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+	mockRepo.On("GetEarliestPublicIncidentStartByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}).Return(&earliest, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/history?month=2026-02", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatusPageHistory(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data struct {
+			Month         string  `json:"month"`
+			PreviousMonth *string `json:"previous_month"`
+			NextMonth     *string `json:"next_month"`
+			Incidents     []struct {
+				ID         string                    `json:"id"`
+				Components []publicIncidentComponent `json:"components"`
+			} `json:"incidents"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "2026-02", resp.Data.Month)
+	require.Equal(t, "2026-01", *resp.Data.PreviousMonth)
+	require.Equal(t, "2026-03", *resp.Data.NextMonth)
+	require.Len(t, resp.Data.Incidents, 1)
+	require.Equal(t, "9", resp.Data.Incidents[0].ID)
+	require.Len(t, resp.Data.Incidents[0].Components, 2)
+}
+
+func TestGetPublicIncident(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	updated := time.Now().UTC()
+	mockRepo := publicStatusPageRepository(updated)
+	incident := models.Incident{ID: 9, Status: models.IncidentStatusInvestigating, IsPublic: true, StartedAt: updated.Add(-time.Hour)}
+	mockRepo.On("GetPublicIncidentByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}, int64(9)).
+		Return([]models.IncidentWithMonitorID{{Incident: incident, MonitorID: 100}}, nil)
+	mockRepo.On("GetPublicIncidentByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}, int64(10)).
+		Return([]models.IncidentWithMonitorID{}, nil)
+	mockRepo.On("ListPublicEventTimelinesByIncidentIDs", mock.Anything, mock.Anything, []int64{9}).
+		Return([]models.EventTimeline{{ID: 50, IncidentID: 9, Message: "Looking into it", EventType: models.IncidentEventTypeInvestigating}}, nil)
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/incidents/9", nil)
+	c.SetParamNames("slug", "id")
+	c.SetParamValues("acme", "9")
+
+	require.NoError(t, h.GetPublicIncident(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Data struct {
+			ID              string `json:"id"`
+			DurationSeconds int64  `json:"duration_seconds"`
+			Timeline        []struct {
+				Message string `json:"message"`
+			} `json:"timeline"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "9", resp.Data.ID)
+	require.GreaterOrEqual(t, resp.Data.DurationSeconds, int64(3600))
+	require.Equal(t, "Looking into it", resp.Data.Timeline[0].Message)
+
+	c, _ = testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/incidents/10", nil)
+	c.SetParamNames("slug", "id")
+	c.SetParamValues("acme", "10")
+
+	err := h.GetPublicIncident(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusNotFound, httpErr.Code)
+}
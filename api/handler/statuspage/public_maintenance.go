@@ -0,0 +1,109 @@
+package statuspage
+
+import (
+	"time"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+)
+
+type publicMaintenanceResponse struct {
+	ID          string                     `json:"id"`
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	Status      models.MaintenanceStatus   `json:"status"`
+	MonitorIDs  []string                   `json:"monitor_ids"`
+	StartsAt    time.Time                  `json:"starts_at"`
+	EndsAt      time.Time                  `json:"ends_at"`
+	Updates     []models.MaintenanceUpdate `json:"updates"`
+}
+
+// splitPublicMaintenances separates the open maintenances of a page into active and upcoming ones.
+// The status follows the window rather than the stored status, which the scheduler only advances periodically.
+// Monitors that are no longer on the page are left out.
+func splitPublicMaintenances(maintenances []models.Maintenance, updates []models.MaintenanceUpdate, pageMonitorIDs []int64, now time.Time) ([]publicMaintenanceResponse, []publicMaintenanceResponse) {
+	updatesByMaintenance := make(map[int64][]models.MaintenanceUpdate, len(maintenances))
+	for _, update := range updates {
+		updatesByMaintenance[update.MaintenanceID] = append(updatesByMaintenance[update.MaintenanceID], update)
+	}
+	onPage := idSet(pageMonitorIDs)
+
+	active := make([]publicMaintenanceResponse, 0)
+	upcoming := make([]publicMaintenanceResponse, 0)
+	for _, maintenance := range maintenances {
+		status := statuspagecore.MaintenanceStatusAt(maintenance, now)
+		if status == models.MaintenanceStatusCompleted {
+			continue
+		}
+
+		monitorIDs := make([]string, 0, len(maintenance.MonitorIDs))
+		for _, monitorID := range maintenance.MonitorIDs {
+			if _, ok := onPage[monitorID]; ok {
+				monitorIDs = append(monitorIDs, formatID(monitorID))
+			}
+		}
+
+		maintenanceUpdates := updatesByMaintenance[maintenance.ID]
+		if maintenanceUpdates == nil {
+			maintenanceUpdates = []models.MaintenanceUpdate{}
+		}
+
+		response := publicMaintenanceResponse{
+			ID:          formatID(maintenance.ID),
+			Title:       maintenance.Title,
+			Description: maintenance.Description,
+			Status:      status,
+			MonitorIDs:  monitorIDs,
+			StartsAt:    maintenance.StartsAt,
+			EndsAt:      maintenance.EndsAt,
+			Updates:     maintenanceUpdates,
+		}
+
+		if status == models.MaintenanceStatusInProgress {
+			active = append(active, response)
+		} else {
+			upcoming = append(upcoming, response)
+		}
+	}
+
+	return active, upcoming
+}
+
+// activeMaintenanceMonitors returns the monitors whose maintenance window includes now.
+func activeMaintenanceMonitors(maintenances []models.Maintenance, now time.Time) map[int64]bool {
+	monitors := make(map[int64]bool)
+	for _, maintenance := range maintenances {
+		if statuspagecore.MaintenanceStatusAt(maintenance, now) != models.MaintenanceStatusInProgress {
+			continue
+		}
+		for _, monitorID := range maintenance.MonitorIDs {
+			monitors[monitorID] = true
+		}
+	}
+	return monitors
+}
+
+// applyMaintenanceFailures moves failed checks inside maintenance windows out of the daily totals so they
+// count as maintenance instead of downtime.
+func applyMaintenanceFailures(index map[int64]map[time.Time]dailyCounts, failures []models.MonitorMaintenanceFailures) {
+	for _, failure := range failures {
+		byDay, ok := index[failure.MonitorID]
+		if !ok {
+			continue
+		}
+		day := failure.Day.UTC()
+		byDay[day] = byDay[day].excuse(failure.FailCount)
+	}
+}
+
+// excuse moves up to n failed checks to maintenance. The rollups may lag behind the raw pings, so it
+// never moves more checks than the counts hold as failed.
+func (c dailyCounts) excuse(n int64) dailyCounts {
+	n = min(n, c.total-c.good)
+	if n <= 0 {
+		return c
+	}
+	c.total -= n
+	c.maintenance += n
+	return c
+}
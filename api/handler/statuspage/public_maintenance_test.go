@@ -0,0 +1,78 @@
+package statuspage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestSplitPublicMaintenances(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	maintenances := []models.Maintenance{
+		{ID: 1, Title: "Running", Status: models.MaintenanceStatusInProgress, MonitorIDs: []int64{100, 999}, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		// Stored as scheduled, but the window already started.
+		{ID: 2, Title: "Started", Status: models.MaintenanceStatusScheduled, MonitorIDs: []int64{}, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+		{ID: 3, Title: "Tomorrow", Status: models.MaintenanceStatusScheduled, MonitorIDs: []int64{101}, StartsAt: now.Add(24 * time.Hour), EndsAt: now.Add(25 * time.Hour)},
+		// Ended, but the scheduler has not completed it yet.
+		{ID: 4, Title: "Over", Status: models.MaintenanceStatusInProgress, MonitorIDs: []int64{100}, StartsAt: now.Add(-2 * time.Hour), EndsAt: now},
+	}
+	updates := []models.MaintenanceUpdate{
+		{ID: 10, MaintenanceID: 1, Status: models.MaintenanceStatusScheduled, Message: "Scheduled"},
+		{ID: 11, MaintenanceID: 1, Status: models.MaintenanceStatusInProgress, Message: "Started"},
+	}
+
+	active, upcoming := splitPublicMaintenances(maintenances, updates, []int64{100, 101}, now)
+	require.Len(t, active, 2)
+	require.Equal(t, "1", active[0].ID)
+	require.Equal(t, []string{"100"}, active[0].MonitorIDs)
+	require.Len(t, active[0].Updates, 2)
+	require.Equal(t, "2", active[1].ID)
+	require.Equal(t, models.MaintenanceStatusInProgress, active[1].Status)
+	require.NotNil(t, active[1].Updates)
+
+	require.Len(t, upcoming, 1)
+	require.Equal(t, "3", upcoming[0].ID)
+	require.Equal(t, models.MaintenanceStatusScheduled, upcoming[0].Status)
+
+	underMaintenance := activeMaintenanceMonitors(maintenances, now)
+	require.Equal(t, map[int64]bool{100: true, 999: true}, underMaintenance)
+}
+
+func TestApplyMaintenanceFailures(t *testing.T) {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	index := map[int64]map[time.Time]dailyCounts{
+		100: {day: {total: 10, good: 6}},
+	}
+
+	applyMaintenanceFailures(index, []models.MonitorMaintenanceFailures{
+		{MonitorID: 100, RegionID: 1, Day: day, FailCount: 3},
+		// Never more than the rollup holds as failed.
+		{MonitorID: 100, RegionID: 2, Day: day, FailCount: 5},
+		{MonitorID: 200, RegionID: 1, Day: day, FailCount: 5},
+	})
+
+	require.Equal(t, dailyCounts{total: 6, good: 6, maintenance: 4}, index[100][day])
+	require.NotContains(t, index, int64(200))
+
+	points, sli30, _, _ := buildTimelineSummary([]int64{100}, []time.Time{day}, index)
+	require.Equal(t, int64(0), points[0].Fail)
+	require.Equal(t, int64(4), points[0].Maintenance)
+	require.InDelta(t, 100, sli30, 0.01)
+}
+
+func TestComputeStatusUnderMaintenance(t *testing.T) {
+	monitorByID := map[int64]models.Monitor{
+		100: {ID: 100, Status: models.MonitorStatusDown},
+		101: {ID: 101, Status: models.MonitorStatusUp},
+		102: {ID: 102, Status: models.MonitorStatusDown},
+	}
+	underMaintenance := map[int64]bool{100: true, 101: true}
+
+	require.Equal(t, "maintenance", computeMonitorStatus(100, monitorByID, nil, underMaintenance))
+	require.Equal(t, "down", computeMonitorStatus(100, monitorByID, map[int64]bool{100: true}, underMaintenance))
+	require.Equal(t, "maintenance", computeGroupStatus([]int64{100, 101}, monitorByID, nil, underMaintenance))
+	require.Equal(t, "down", computeGroupStatus([]int64{100, 102}, monitorByID, nil, underMaintenance))
+	require.Equal(t, "up", computeGroupStatus([]int64{101}, monitorByID, nil, nil))
+}
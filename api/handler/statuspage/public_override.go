@@ -0,0 +1,67 @@
+package statuspage
+
+import (
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// publicComponentOverride is a status set by hand, shown next to the status computed from monitors.
+type publicComponentOverride struct {
+	Status    models.ComponentStatus `json:"status"`
+	Message   string                 `json:"message,omitempty"`
+	ExpiresAt *time.Time             `json:"expires_at,omitempty"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// componentOverrides indexes the overrides of a page in effect by group and by monitor.
+type componentOverrides struct {
+	groups   map[int64]models.ComponentOverride
+	monitors map[int64]models.ComponentOverride
+}
+
+// indexComponentOverrides keeps the newest override of each component that is in effect at now.
+func indexComponentOverrides(overrides []models.ComponentOverride, now time.Time) componentOverrides {
+	index := componentOverrides{
+		groups:   make(map[int64]models.ComponentOverride),
+		monitors: make(map[int64]models.ComponentOverride),
+	}
+	for _, override := range overrides {
+		if !override.ActiveAt(now) {
+			continue
+		}
+
+		target, id := index.monitors, override.MonitorID
+		if override.GroupID != nil {
+			target, id = index.groups, override.GroupID
+		}
+		if id == nil {
+			continue
+		}
+		if current, ok := target[*id]; !ok || override.CreatedAt.After(current.CreatedAt) {
+			target[*id] = override
+		}
+	}
+	return index
+}
+
+func (o componentOverrides) group(groupID int64) *publicComponentOverride {
+	return newPublicComponentOverride(o.groups, groupID)
+}
+
+func (o componentOverrides) monitor(monitorID int64) *publicComponentOverride {
+	return newPublicComponentOverride(o.monitors, monitorID)
+}
+
+func newPublicComponentOverride(overrides map[int64]models.ComponentOverride, id int64) *publicComponentOverride {
+	override, ok := overrides[id]
+	if !ok {
+		return nil
+	}
+	return &publicComponentOverride{
+		Status:    override.Status,
+		Message:   override.Message,
+		ExpiresAt: override.ExpiresAt,
+		UpdatedAt: override.CreatedAt,
+	}
+}
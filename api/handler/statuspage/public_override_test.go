@@ -0,0 +1,56 @@
+package statuspage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestIndexComponentOverrides(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+	groupID, monitorID := int64(20), int64(100)
+	expired := now.Add(-time.Minute)
+
+	index := indexComponentOverrides([]models.ComponentOverride{
+		{ID: 1, MonitorID: &monitorID, Status: models.ComponentStatusMajorOutage, CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: 2, MonitorID: &monitorID, Status: models.ComponentStatusDegradedPerformance, Message: "Slow", CreatedAt: now.Add(-time.Hour)},
+		{ID: 3, GroupID: &groupID, Status: models.ComponentStatusPartialOutage, ExpiresAt: &expired, CreatedAt: now.Add(-time.Hour)},
+	}, now)
+
+	override := index.monitor(monitorID)
+	require.NotNil(t, override)
+	require.Equal(t, models.ComponentStatusDegradedPerformance, override.Status)
+	require.Equal(t, "Slow", override.Message)
+	require.Equal(t, now.Add(-time.Hour), override.UpdatedAt)
+	require.Nil(t, index.group(groupID))
+	require.Nil(t, index.monitor(101))
+}
+
+func TestBuildV2ViewOverrides(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+	groupID, monitorID := int64(20), int64(101)
+
+	view := buildV2View(&publicStatusPageData{
+		Page:   &models.StatusPage{ID: 1, Title: "Acme", Slug: "acme"},
+		Groups: []models.StatusPageGroup{{ID: groupID, StatusPageID: 1, Name: "Core", SortOrder: 1}},
+		Monitors: []models.StatusPageMonitor{
+			{ID: 31, MonitorID: 100, GroupID: &groupID, Name: "API", SortOrder: 1},
+			{ID: 32, MonitorID: 101, Name: "Website", SortOrder: 2},
+		},
+		MonitorByID: map[int64]models.Monitor{100: {ID: 100, Status: models.MonitorStatusDown}, 101: {ID: 101, Status: models.MonitorStatusUp}},
+		Overrides: []models.ComponentOverride{
+			{ID: 1, StatusPageID: 1, GroupID: &groupID, Status: models.ComponentStatusOperational, CreatedAt: now.Add(-time.Minute)},
+			{ID: 2, StatusPageID: 1, MonitorID: &monitorID, Status: models.ComponentStatusUnderMaintenance, CreatedAt: now.Add(-time.Minute)},
+		},
+	}, now)
+
+	require.Len(t, view.Components, 3)
+	require.Equal(t, v2ComponentOperational, view.Components[0].Status)
+	require.Equal(t, v2ComponentMajorOutage, view.Components[1].Status)
+	require.Equal(t, v2ComponentUnderMaintenance, view.Components[2].Status)
+}
@@ -0,0 +1,75 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"go.uber.org/zap"
+)
+
+// snapshotRefreshTimeout bounds a background rebuild of a stale snapshot.
+const snapshotRefreshTimeout = 30 * time.Second
+
+// publicStatusPageCacheControl lets browsers and proxies follow the lifetime of the snapshots.
+var publicStatusPageCacheControl = fmt.Sprintf("public, max-age=%d, stale-while-revalidate=%d",
+	int(statuspagecore.SnapshotFreshFor.Seconds()), int(statuspagecore.SnapshotStaleFor.Seconds()))
+
+// publicSnapshotKey identifies the snapshot of a page lookup in a locale preference.
+func publicSnapshotKey(lookup publicStatusPageLookup, preference string) string {
+	if lookup.Slug != "" {
+		return "slug:" + lookup.Slug + "|" + preference
+	}
+	return "domain:" + lookup.Domain + "|" + preference
+}
+
+// writePublicStatusPageSnapshot sends snapshot, or 304 when the client already has it. Only public
+// pages may be stored by shared caches.
+func writePublicStatusPageSnapshot(c echo.Context, snapshot statuspagecore.Snapshot, public bool) error {
+	c.Response().Header().Set("ETag", snapshot.ETag)
+	if public {
+		c.Response().Header().Set("Cache-Control", publicStatusPageCacheControl)
+	}
+
+	if statuspagecore.ETagMatches(c.Request().Header.Get("If-None-Match"), snapshot.ETag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(http.StatusOK, snapshot.Body)
+}
+
+// refreshPublicStatusPageSnapshot rebuilds a stale snapshot outside of the request that found it.
+func (h *Handler) refreshPublicStatusPageSnapshot(key string, lookup publicStatusPageLookup, preference string) {
+	defer h.Snapshots.Release(key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotRefreshTimeout)
+	defer cancel()
+
+	generation := h.Snapshots.Begin()
+
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	// Pages that were deleted or stopped being public had their snapshots invalidated already.
+	page, err := h.findStatusPage(ctx, tx, lookup)
+	if err != nil || page == nil || !isPublicStatusPage(page) {
+		return
+	}
+
+	snapshot, err := h.renderPublicStatusPage(ctx, tx, page, preference)
+	if err != nil {
+		return
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return
+	}
+
+	h.Snapshots.Put(key, page.ID, snapshot, generation)
+}
@@ -0,0 +1,57 @@
+package statuspage
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestGetPublicStatusPage_Snapshot(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := publicStatusPageRepository(time.Now().UTC())
+	mockRepo.On("ListMonitorDailySummaryByMonitorIDs", mock.Anything, mock.Anything, []int64{100, 101}, mock.Anything, mock.Anything).Return([]models.MonitorDailySummary{}, nil)
+	mockRepo.On("ListMonitorMaintenanceFailures", mock.Anything, mock.Anything, []int64{100, 101}, mock.Anything, mock.Anything).Return([]models.MonitorMaintenanceFailures{}, nil)
+
+	h := &Handler{Repo: mockRepo, Snapshots: statuspagecore.NewSnapshotCache(time.Minute, time.Minute)}
+	get := func(ifNoneMatch string) (int, http.Header, string) {
+		c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme", nil)
+		if ifNoneMatch != "" {
+			c.Request().Header.Set("If-None-Match", ifNoneMatch)
+		}
+		c.SetParamNames("slug")
+		c.SetParamValues("acme")
+
+		require.NoError(t, h.GetPublicStatusPage(c))
+		return rec.Code, rec.Header(), rec.Body.String()
+	}
+
+	code, header, body := get("")
+	require.Equal(t, http.StatusOK, code)
+	etag := header.Get("ETag")
+	require.NotEmpty(t, etag)
+	require.Equal(t, "public, max-age=30, stale-while-revalidate=60", header.Get("Cache-Control"))
+	require.Contains(t, header.Values("Vary"), "Accept-Language")
+
+	// Served from the snapshot, without touching the database.
+	code, header, cached := get("")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, etag, header.Get("ETag"))
+	require.Equal(t, body, cached)
+	mockRepo.AssertNumberOfCalls(t, "GetStatusPageBySlug", 1)
+
+	code, _, cached = get(etag)
+	require.Equal(t, http.StatusNotModified, code)
+	require.Empty(t, cached)
+
+	h.Snapshots.Invalidate(1)
+	code, _, _ = get(etag)
+	require.Equal(t, http.StatusNotModified, code)
+	mockRepo.AssertNumberOfCalls(t, "GetStatusPageBySlug", 2)
+}
@@ -0,0 +1,493 @@
+package statuspage
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+// Atlassian Statuspage v2 component statuses.
+const (
+	v2ComponentOperational         = "operational"
+	v2ComponentUnderMaintenance    = "under_maintenance"
+	v2ComponentDegradedPerformance = "degraded_performance"
+	v2ComponentPartialOutage       = "partial_outage"
+	v2ComponentMajorOutage         = "major_outage"
+)
+
+// v2IncidentLimit matches the number of incidents Statuspage returns from incidents.json.
+const v2IncidentLimit = 50
+
+type v2Page struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	TimeZone  string    `json:"time_zone"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type v2Status struct {
+	Indicator   string `json:"indicator"`
+	Description string `json:"description"`
+}
+
+type v2Component struct {
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Status             string    `json:"status"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	Position           int       `json:"position"`
+	Description        *string   `json:"description"`
+	Showcase           bool      `json:"showcase"`
+	StartDate          *string   `json:"start_date"`
+	GroupID            *string   `json:"group_id"`
+	PageID             string    `json:"page_id"`
+	Group              bool      `json:"group"`
+	OnlyShowIfDegraded bool      `json:"only_show_if_degraded"`
+	Components         []string  `json:"components,omitempty"`
+}
+
+type v2IncidentUpdate struct {
+	ID                   string        `json:"id"`
+	Status               string        `json:"status"`
+	Body                 string        `json:"body"`
+	IncidentID           string        `json:"incident_id"`
+	CreatedAt            time.Time     `json:"created_at"`
+	UpdatedAt            time.Time     `json:"updated_at"`
+	DisplayAt            time.Time     `json:"display_at"`
+	AffectedComponents   []interface{} `json:"affected_components"`
+	DeliverNotifications bool          `json:"deliver_notifications"`
+}
+
+type v2Incident struct {
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	Status          string             `json:"status"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	MonitoringAt    *time.Time         `json:"monitoring_at"`
+	ResolvedAt      *time.Time         `json:"resolved_at"`
+	Impact          string             `json:"impact"`
+	Shortlink       string             `json:"shortlink"`
+	StartedAt       time.Time          `json:"started_at"`
+	PageID          string             `json:"page_id"`
+	IncidentUpdates []v2IncidentUpdate `json:"incident_updates"`
+	Components      []v2Component      `json:"components"`
+}
+
+// v2ScheduledMaintenance is a maintenance in the shape Statuspage uses for scheduled maintenances:
+// an incident with impact "maintenance" and a window.
+type v2ScheduledMaintenance struct {
+	v2Incident
+	ScheduledFor   time.Time `json:"scheduled_for"`
+	ScheduledUntil time.Time `json:"scheduled_until"`
+}
+
+// v2View is a public status page translated to the Atlassian Statuspage v2 schema.
+type v2View struct {
+	Page       v2Page
+	Status     v2Status
+	Components []v2Component
+	// Incidents are newest first.
+	Incidents []v2Incident
+	// ScheduledMaintenances are the active and upcoming maintenances, soonest first.
+	ScheduledMaintenances []v2ScheduledMaintenance
+}
+
+// buildV2View maps groups to group components and page monitors to components keyed by monitor ID,
+// which unlike the page's own element IDs survive page edits.
+func buildV2View(data *publicStatusPageData, now time.Time) v2View {
+	page := data.Page
+	pageID := formatID(page.ID)
+
+	incidents, affected := uniquePublicIncidents(data.Incidents)
+
+	// The worst open incident on a monitor decides its component status.
+	openImpact := make(map[int64]string)
+	for _, incident := range incidents {
+		if incident.Status == models.IncidentStatusResolved {
+			continue
+		}
+		impact := v2Impact(incident.Severity)
+		for _, monitorID := range affected[incident.ID] {
+			if impactRank(impact) >= impactRank(openImpact[monitorID]) {
+				openImpact[monitorID] = impact
+			}
+		}
+	}
+
+	updatedAt := page.UpdatedAt
+	for _, incident := range incidents {
+		if incident.UpdatedAt.After(updatedAt) {
+			updatedAt = incident.UpdatedAt
+		}
+	}
+	for _, maintenance := range data.Maintenances {
+		if maintenance.UpdatedAt.After(updatedAt) {
+			updatedAt = maintenance.UpdatedAt
+		}
+	}
+	underMaintenance := activeMaintenanceMonitors(data.Maintenances, now)
+	overrides := indexComponentOverrides(data.Overrides, now)
+
+	view := v2View{
+		Page: v2Page{
+			ID:        pageID,
+			Name:      page.Title,
+			URL:       statuspagecore.PageURL(config.Env().BackendURL, page.Slug),
+			TimeZone:  "Etc/UTC",
+			UpdatedAt: updatedAt,
+		},
+	}
+
+	component := func(id, name string, position int, status string) v2Component {
+		return v2Component{
+			ID:        id,
+			Name:      name,
+			Status:    status,
+			CreatedAt: page.CreatedAt,
+			UpdatedAt: updatedAt,
+			Position:  position,
+			PageID:    pageID,
+		}
+	}
+
+	// A status set by hand wins over monitor results, incidents and maintenance.
+	monitorStatus := func(monitorID int64) string {
+		if override := overrides.monitor(monitorID); override != nil {
+			return string(override.Status)
+		}
+		if impact, ok := openImpact[monitorID]; ok {
+			return v2ComponentStatus(impact)
+		}
+		if underMaintenance[monitorID] {
+			return v2ComponentUnderMaintenance
+		}
+		monitor, ok := data.MonitorByID[monitorID]
+		if !ok || monitor.Status == models.MonitorStatusDown {
+			return v2ComponentMajorOutage
+		}
+		return v2ComponentOperational
+	}
+
+	componentByMonitor := make(map[int64]v2Component, len(data.Monitors))
+	children := make(map[int64][]v2Component, len(data.Groups))
+	for _, monitor := range sortedStatusPageMonitors(data.Monitors) {
+		entry := component(formatID(monitor.MonitorID), monitor.Name, monitor.SortOrder, monitorStatus(monitor.MonitorID))
+		if monitor.GroupID != nil {
+			groupID := formatID(*monitor.GroupID)
+			entry.GroupID = &groupID
+			children[*monitor.GroupID] = append(children[*monitor.GroupID], entry)
+		}
+		componentByMonitor[monitor.MonitorID] = entry
+	}
+
+	groups := append([]models.StatusPageGroup(nil), data.Groups...)
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].SortOrder < groups[j].SortOrder })
+
+	for _, group := range groups {
+		entry := component(formatID(group.ID), group.Name, group.SortOrder, v2ComponentOperational)
+		entry.Group = true
+		entry.Components = []string{}
+		for _, child := range children[group.ID] {
+			entry.Components = append(entry.Components, child.ID)
+			if componentRank(child.Status) > componentRank(entry.Status) {
+				entry.Status = child.Status
+			}
+		}
+		if override := overrides.group(group.ID); override != nil {
+			entry.Status = string(override.Status)
+		}
+		view.Components = append(view.Components, entry)
+		view.Components = append(view.Components, children[group.ID]...)
+	}
+	for _, monitor := range sortedStatusPageMonitors(data.Monitors) {
+		if monitor.GroupID == nil {
+			view.Components = append(view.Components, componentByMonitor[monitor.MonitorID])
+		}
+	}
+	if view.Components == nil {
+		view.Components = []v2Component{}
+	}
+
+	eventsByIncident := make(map[int64][]models.EventTimeline, len(incidents))
+	for _, event := range data.Events {
+		eventsByIncident[event.IncidentID] = append(eventsByIncident[event.IncidentID], event)
+	}
+
+	indicator := "none"
+	for _, incident := range incidents {
+		v2 := buildV2Incident(view.Page, incident, eventsByIncident[incident.ID], affected[incident.ID], componentByMonitor)
+		view.Incidents = append(view.Incidents, v2)
+		if incident.Status != models.IncidentStatusResolved && impactRank(v2.Impact) > impactRank(indicator) {
+			indicator = v2.Impact
+		}
+	}
+	if view.Incidents == nil {
+		view.Incidents = []v2Incident{}
+	}
+
+	// Monitors that are down without a public incident still count against the page.
+	for _, entry := range view.Components {
+		if impact := componentIndicator(entry.Status); impactRank(impact) > impactRank(indicator) {
+			indicator = impact
+		}
+	}
+	view.Status = v2Status{Indicator: indicator, Description: indicatorDescription(indicator)}
+	if indicator == "none" && len(underMaintenance) > 0 {
+		view.Status.Description = "Service Under Maintenance"
+	}
+
+	updatesByMaintenance := make(map[int64][]models.MaintenanceUpdate, len(data.Maintenances))
+	for _, update := range data.MaintenanceUpdates {
+		updatesByMaintenance[update.MaintenanceID] = append(updatesByMaintenance[update.MaintenanceID], update)
+	}
+	view.ScheduledMaintenances = []v2ScheduledMaintenance{}
+	for _, maintenance := range data.Maintenances {
+		if statuspagecore.MaintenanceStatusAt(maintenance, now) == models.MaintenanceStatusCompleted {
+			continue
+		}
+		view.ScheduledMaintenances = append(view.ScheduledMaintenances, buildV2ScheduledMaintenance(view.Page, maintenance, updatesByMaintenance[maintenance.ID], componentByMonitor, now))
+	}
+
+	return view
+}
+
+func buildV2ScheduledMaintenance(page v2Page, maintenance models.Maintenance, updates []models.MaintenanceUpdate, componentByMonitor map[int64]v2Component, now time.Time) v2ScheduledMaintenance {
+	maintenanceID := formatID(maintenance.ID)
+	result := v2ScheduledMaintenance{
+		v2Incident: v2Incident{
+			ID:              maintenanceID,
+			Name:            maintenance.Title,
+			Status:          string(statuspagecore.MaintenanceStatusAt(maintenance, now)),
+			CreatedAt:       maintenance.CreatedAt,
+			UpdatedAt:       maintenance.UpdatedAt,
+			Impact:          "maintenance",
+			Shortlink:       page.URL + "#maintenance-" + maintenanceID,
+			StartedAt:       maintenance.StartsAt,
+			PageID:          page.ID,
+			IncidentUpdates: []v2IncidentUpdate{},
+			Components:      []v2Component{},
+		},
+		ScheduledFor:   maintenance.StartsAt,
+		ScheduledUntil: maintenance.EndsAt,
+	}
+
+	for _, monitorID := range maintenance.MonitorIDs {
+		if entry, ok := componentByMonitor[monitorID]; ok {
+			result.Components = append(result.Components, entry)
+		}
+	}
+
+	// Statuspage lists the newest update first.
+	for i := len(updates) - 1; i >= 0; i-- {
+		update := updates[i]
+		result.IncidentUpdates = append(result.IncidentUpdates, v2IncidentUpdate{
+			ID:                 formatID(update.ID),
+			Status:             string(update.Status),
+			Body:               update.Message,
+			IncidentID:         maintenanceID,
+			CreatedAt:          update.CreatedAt,
+			UpdatedAt:          update.CreatedAt,
+			DisplayAt:          update.CreatedAt,
+			AffectedComponents: []interface{}{},
+		})
+	}
+
+	return result
+}
+
+func buildV2Incident(page v2Page, incident models.Incident, events []models.EventTimeline, monitorIDs []int64, componentByMonitor map[int64]v2Component) v2Incident {
+	incidentID := formatID(incident.ID)
+	result := v2Incident{
+		ID:              incidentID,
+		Name:            incidentName(incident),
+		Status:          v2IncidentStatus(incident.Status),
+		CreatedAt:       incident.CreatedAt,
+		UpdatedAt:       incident.UpdatedAt,
+		ResolvedAt:      incident.ResolvedAt,
+		Impact:          v2Impact(incident.Severity),
+		Shortlink:       page.URL + "#incident-" + incidentID,
+		StartedAt:       incident.StartedAt,
+		PageID:          page.ID,
+		IncidentUpdates: []v2IncidentUpdate{},
+		Components:      []v2Component{},
+	}
+
+	for _, monitorID := range monitorIDs {
+		if entry, ok := componentByMonitor[monitorID]; ok {
+			result.Components = append(result.Components, entry)
+		}
+	}
+
+	// Updates without a status of their own (e.g. "update") carry the status reached before them.
+	status := "investigating"
+	for _, event := range events {
+		if eventStatus, ok := v2EventStatus(event.EventType); ok {
+			status = eventStatus
+			if status == "monitoring" && result.MonitoringAt == nil {
+				at := event.CreatedAt
+				result.MonitoringAt = &at
+			}
+		}
+		if event.UpdatedAt.After(result.UpdatedAt) {
+			result.UpdatedAt = event.UpdatedAt
+		}
+
+		result.IncidentUpdates = append(result.IncidentUpdates, v2IncidentUpdate{
+			ID:                 formatID(event.ID),
+			Status:             status,
+			Body:               event.Message,
+			IncidentID:         incidentID,
+			CreatedAt:          event.CreatedAt,
+			UpdatedAt:          event.UpdatedAt,
+			DisplayAt:          event.CreatedAt,
+			AffectedComponents: []interface{}{},
+		})
+	}
+
+	// Statuspage lists the newest update first.
+	for i, j := 0, len(result.IncidentUpdates)-1; i < j; i, j = i+1, j-1 {
+		result.IncidentUpdates[i], result.IncidentUpdates[j] = result.IncidentUpdates[j], result.IncidentUpdates[i]
+	}
+
+	return result
+}
+
+// uniquePublicIncidents collapses the per-monitor incident rows, keeping their order, and returns the monitors of each.
+func uniquePublicIncidents(rows []models.IncidentWithMonitorID) ([]models.Incident, map[int64][]int64) {
+	incidents := make([]models.Incident, 0, len(rows))
+	monitors := make(map[int64][]int64, len(rows))
+	for _, row := range rows {
+		if _, ok := monitors[row.ID]; !ok {
+			incidents = append(incidents, row.Incident)
+		}
+		monitors[row.ID] = append(monitors[row.ID], row.MonitorID)
+	}
+	return incidents, monitors
+}
+
+func sortedStatusPageMonitors(monitors []models.StatusPageMonitor) []models.StatusPageMonitor {
+	sorted := append([]models.StatusPageMonitor(nil), monitors...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+	return sorted
+}
+
+func incidentName(incident models.Incident) string {
+	if incident.Title != nil && strings.TrimSpace(*incident.Title) != "" {
+		return strings.TrimSpace(*incident.Title)
+	}
+	return "Incident " + formatID(incident.ID)
+}
+
+// v2Impact maps incident severity to a Statuspage impact.
+func v2Impact(severity models.IncidentSeverity) string {
+	switch severity {
+	case models.IncidentSeverityEmergency, models.IncidentSeverityCritical:
+		return "critical"
+	case models.IncidentSeverityMajor:
+		return "major"
+	case models.IncidentSeverityMinor:
+		return "minor"
+	default:
+		return "none"
+	}
+}
+
+func v2IncidentStatus(status models.IncidentStatus) string {
+	switch status {
+	case models.IncidentStatusIdentified:
+		return "identified"
+	case models.IncidentStatusMonitoring:
+		return "monitoring"
+	case models.IncidentStatusResolved:
+		return "resolved"
+	default:
+		return "investigating"
+	}
+}
+
+// v2EventStatus returns the Statuspage status a timeline event moves the incident to, if any.
+func v2EventStatus(eventType models.EventType) (string, bool) {
+	switch eventType {
+	case models.IncidentEventTypeDetected, models.IncidentEventTypeInvestigating:
+		return "investigating", true
+	case models.IncidentEventTypeIdentified:
+		return "identified", true
+	case models.IncidentEventTypeMonitoring:
+		return "monitoring", true
+	case models.IncidentEventTypeManuallyResolved, models.IncidentEventTypeAutoResolved:
+		return "resolved", true
+	default:
+		return "", false
+	}
+}
+
+// v2ComponentStatus is the status of a component affected by an open incident with the given impact.
+func v2ComponentStatus(impact string) string {
+	switch impact {
+	case "critical":
+		return v2ComponentMajorOutage
+	case "major":
+		return v2ComponentPartialOutage
+	default:
+		return v2ComponentDegradedPerformance
+	}
+}
+
+func componentIndicator(status string) string {
+	switch status {
+	case v2ComponentMajorOutage:
+		return "major"
+	case v2ComponentPartialOutage, v2ComponentDegradedPerformance:
+		return "minor"
+	default:
+		return "none"
+	}
+}
+
+func componentRank(status string) int {
+	switch status {
+	case v2ComponentMajorOutage:
+		return 4
+	case v2ComponentPartialOutage:
+		return 3
+	case v2ComponentDegradedPerformance:
+		return 2
+	case v2ComponentUnderMaintenance:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func impactRank(impact string) int {
+	switch impact {
+	case "critical":
+		return 3
+	case "major":
+		return 2
+	case "minor":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func indicatorDescription(indicator string) string {
+	switch indicator {
+	case "critical":
+		return "Major System Outage"
+	case "major":
+		return "Partial System Outage"
+	case "minor":
+		return "Minor Service Outage"
+	default:
+		return "All Systems Operational"
+	}
+}
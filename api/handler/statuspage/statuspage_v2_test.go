@@ -0,0 +1,130 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestBuildV2View(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+	groupID := int64(20)
+	userID := int64(5)
+	open := models.Incident{ID: 9, Status: models.IncidentStatusIdentified, Severity: models.IncidentSeverityMajor, IsPublic: true, StartedAt: now, CreatedAt: now, UpdatedAt: now}
+	resolvedAt := now.Add(-time.Hour)
+	resolved := models.Incident{ID: 8, Status: models.IncidentStatusResolved, Severity: models.IncidentSeverityCritical, IsPublic: true, StartedAt: now.Add(-2 * time.Hour), ResolvedAt: &resolvedAt, CreatedAt: now.Add(-2 * time.Hour), UpdatedAt: resolvedAt}
+
+	view := buildV2View(&publicStatusPageData{
+		Page:   &models.StatusPage{ID: 1, Title: "Acme", Slug: "acme", CreatedAt: now.Add(-24 * time.Hour), UpdatedAt: now.Add(-24 * time.Hour)},
+		Groups: []models.StatusPageGroup{{ID: groupID, StatusPageID: 1, Name: "Core", SortOrder: 1}},
+		Monitors: []models.StatusPageMonitor{
+			{ID: 31, MonitorID: 100, GroupID: &groupID, Name: "API", SortOrder: 1},
+			{ID: 32, MonitorID: 101, Name: "Website", SortOrder: 2},
+		},
+		MonitorByID: map[int64]models.Monitor{100: {ID: 100, Status: models.MonitorStatusUp}, 101: {ID: 101, Status: models.MonitorStatusUp}},
+		Incidents: []models.IncidentWithMonitorID{
+			{Incident: open, MonitorID: 100},
+			{Incident: resolved, MonitorID: 101},
+		},
+		Events: []models.EventTimeline{
+			{ID: 50, IncidentID: 9, CreatedBy: &userID, Message: "Looking", EventType: models.IncidentEventTypeInvestigating, CreatedAt: now, UpdatedAt: now},
+			{ID: 51, IncidentID: 9, CreatedBy: &userID, Message: "Found it", EventType: models.IncidentEventTypeIdentified, CreatedAt: now.Add(time.Minute), UpdatedAt: now.Add(time.Minute)},
+			{ID: 52, IncidentID: 9, CreatedBy: &userID, Message: "Still on it", EventType: models.IncidentEventTypeUpdate, CreatedAt: now.Add(2 * time.Minute), UpdatedAt: now.Add(2 * time.Minute)},
+		},
+	}, now)
+
+	require.Equal(t, "major", view.Status.Indicator)
+	require.Equal(t, "Partial System Outage", view.Status.Description)
+	require.Equal(t, now, view.Page.UpdatedAt)
+
+	require.Len(t, view.Components, 3)
+	require.Equal(t, "20", view.Components[0].ID)
+	require.True(t, view.Components[0].Group)
+	require.Equal(t, []string{"100"}, view.Components[0].Components)
+	require.Equal(t, v2ComponentPartialOutage, view.Components[0].Status)
+	require.Equal(t, "100", view.Components[1].ID)
+	require.Equal(t, "20", *view.Components[1].GroupID)
+	require.Equal(t, v2ComponentPartialOutage, view.Components[1].Status)
+	require.Equal(t, v2ComponentOperational, view.Components[2].Status)
+
+	require.Len(t, view.Incidents, 2)
+	incident := view.Incidents[0]
+	require.Equal(t, "identified", incident.Status)
+	require.Equal(t, "major", incident.Impact)
+	require.Len(t, incident.IncidentUpdates, 3)
+	require.Equal(t, "Still on it", incident.IncidentUpdates[0].Body)
+	require.Equal(t, "identified", incident.IncidentUpdates[0].Status)
+	require.Equal(t, "investigating", incident.IncidentUpdates[2].Status)
+	require.Equal(t, "100", incident.Components[0].ID)
+	require.Equal(t, "resolved", view.Incidents[1].Status)
+	require.Equal(t, "critical", view.Incidents[1].Impact)
+}
+
+func TestGetStatuspageV2UnresolvedIncidents(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	h := &Handler{Repo: publicStatusPageRepository(time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC))}
+	c, rec := testutil.NewEchoContext(http.MethodGet, "/status-pages/acme/api/v2/incidents/unresolved.json", nil)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.GetStatuspageV2UnresolvedIncidents(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Page      v2Page       `json:"page"`
+		Incidents []v2Incident `json:"incidents"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "Acme", resp.Page.Name)
+	require.Len(t, resp.Incidents, 1)
+	require.Equal(t, "9", resp.Incidents[0].ID)
+	require.Len(t, resp.Incidents[0].Components, 2)
+}
+
+func TestBuildV2View_ScheduledMaintenance(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+	groupID := int64(20)
+	view := buildV2View(&publicStatusPageData{
+		Page:   &models.StatusPage{ID: 1, Title: "Acme", Slug: "acme", CreatedAt: now.Add(-24 * time.Hour), UpdatedAt: now.Add(-24 * time.Hour)},
+		Groups: []models.StatusPageGroup{{ID: groupID, StatusPageID: 1, Name: "Core", SortOrder: 1}},
+		Monitors: []models.StatusPageMonitor{
+			{ID: 31, MonitorID: 100, GroupID: &groupID, Name: "API", SortOrder: 1},
+			{ID: 32, MonitorID: 101, GroupID: &groupID, Name: "Website", SortOrder: 2},
+		},
+		MonitorByID: map[int64]models.Monitor{100: {ID: 100, Status: models.MonitorStatusDown}, 101: {ID: 101, Status: models.MonitorStatusUp}},
+		Maintenances: []models.Maintenance{
+			{ID: 40, Title: "Database upgrade", Status: models.MaintenanceStatusInProgress, MonitorIDs: []int64{100}, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), UpdatedAt: now.Add(-time.Hour)},
+			{ID: 41, Title: "Network work", Status: models.MaintenanceStatusScheduled, MonitorIDs: []int64{101}, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), UpdatedAt: now.Add(-2 * time.Hour)},
+		},
+		MaintenanceUpdates: []models.MaintenanceUpdate{
+			{ID: 60, MaintenanceID: 40, Status: models.MaintenanceStatusScheduled, Message: "Scheduled", CreatedAt: now.Add(-2 * time.Hour)},
+			{ID: 61, MaintenanceID: 40, Status: models.MaintenanceStatusInProgress, Message: "Started", CreatedAt: now.Add(-time.Hour)},
+		},
+	}, now)
+
+	require.Equal(t, "none", view.Status.Indicator)
+	require.Equal(t, "Service Under Maintenance", view.Status.Description)
+	require.Equal(t, v2ComponentUnderMaintenance, view.Components[0].Status)
+	require.Equal(t, v2ComponentUnderMaintenance, view.Components[1].Status)
+	require.Equal(t, v2ComponentOperational, view.Components[2].Status)
+
+	require.Len(t, view.ScheduledMaintenances, 2)
+	active := view.ScheduledMaintenances[0]
+	require.Equal(t, "40", active.ID)
+	require.Equal(t, "in_progress", active.Status)
+	require.Equal(t, "maintenance", active.Impact)
+	require.Equal(t, now.Add(time.Hour), active.ScheduledUntil)
+	require.Equal(t, "Started", active.IncidentUpdates[0].Body)
+	require.Equal(t, "100", active.Components[0].ID)
+	require.Equal(t, "scheduled", view.ScheduledMaintenances[1].Status)
+}
@@ -0,0 +1,218 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type subscribeStatusPageRequest struct {
+	Type       models.StatusPageSubscriberType `json:"type" validate:"required,oneof=email webhook"`
+	Email      string                          `json:"email" validate:"required_if=Type email,omitempty,email,max=255"`
+	URL        string                          `json:"url" validate:"required_if=Type webhook,omitempty,url,max=2048"`
+	MonitorIDs utils.IDList                    `json:"monitor_ids"`
+}
+
+// SubscribeStatusPage godoc
+// @Summary Subscribe to a public status page
+// @Description Subscribes an email address (after double opt-in) or a webhook URL (after it echoes a verification challenge) to incident updates of a public status page, optionally limited to some of its monitors
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Param request body subscribeStatusPageRequest true "Subscription request"
+// @Success 200 {object} response.SuccessResponse "Subscription received"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 429 {object} response.ErrorResponse "Webhook verification requested too recently"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/subscribers [post]
+func (h *Handler) SubscribeStatusPage(c echo.Context) error {
+	var req subscribeStatusPageRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	target := strings.TrimSpace(req.URL)
+	if req.Type == models.StatusPageSubscriberTypeEmail {
+		target = strings.ToLower(strings.TrimSpace(req.Email))
+		if !config.Env().SMTPEnabled {
+			return echo.NewHTTPError(http.StatusBadRequest, "Email subscriptions are not available")
+		}
+	} else if err := statuspagecore.CheckWebhookURL(target); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Webhook URL must be a public http or https URL")
+	}
+
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, err := h.findPublicStatusPage(c, tx)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.authorizePublicStatusPage(c, tx, page); err != nil {
+		return err
+	}
+
+	monitorIDs := utils.UniqueInt64s(req.MonitorIDs.Int64s())
+	if len(monitorIDs) > 0 {
+		pageMonitors, err := h.Repo.ListStatusPageMonitorsByStatusPageID(ctx, tx, page.ID)
+		if err != nil {
+			zap.L().Error("Failed to list status page monitors", zap.Error(err), zap.Int64("status_page_id", page.ID))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page monitors")
+		}
+
+		shown := make(map[int64]struct{}, len(pageMonitors))
+		for _, monitor := range pageMonitors {
+			shown[monitor.MonitorID] = struct{}{}
+		}
+		for _, monitorID := range monitorIDs {
+			if _, ok := shown[monitorID]; !ok {
+				return echo.NewHTTPError(http.StatusBadRequest, "Monitor IDs must belong to this status page")
+			}
+		}
+	}
+	if monitorIDs == nil {
+		monitorIDs = []int64{}
+	}
+
+	subscriberID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate subscriber ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create subscription")
+	}
+
+	token, err := statuspagecore.NewToken()
+	if err != nil {
+		zap.L().Error("Failed to generate subscriber token", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create subscription")
+	}
+
+	now := time.Now().UTC()
+	subscriber := models.StatusPageSubscriber{
+		ID:           subscriberID,
+		StatusPageID: page.ID,
+		Type:         req.Type,
+		Target:       target,
+		MonitorIDs:   monitorIDs,
+		Token:        token,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	stored, err := h.Repo.UpsertStatusPageSubscriber(ctx, tx, subscriber)
+	if err != nil {
+		zap.L().Error("Failed to save status page subscriber", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create subscription")
+	}
+
+	// Repeated subscriptions must not turn into a mail or request cannon, so a confirmation only goes out
+	// again after an interval.
+	send := false
+	if stored.ConfirmedAt == nil {
+		interval := statuspagecore.ConfirmationResendInterval
+		if req.Type == models.StatusPageSubscriberTypeWebhook {
+			interval = statuspagecore.WebhookVerificationInterval
+		}
+
+		send, err = h.Repo.MarkStatusPageSubscriberConfirmationSent(ctx, tx, stored.ID, now, now.Add(-interval))
+		if err != nil {
+			zap.L().Error("Failed to record subscription confirmation", zap.Int64("subscriber_id", stored.ID), zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create subscription")
+		}
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if req.Type == models.StatusPageSubscriberTypeWebhook {
+		if stored.ConfirmedAt == nil {
+			if !send {
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Webhook verification was requested recently, try again later")
+			}
+			if err := h.verifyWebhookSubscriber(c, page, stored); err != nil {
+				return err
+			}
+		}
+		return c.JSON(http.StatusOK, response.SuccessMessage("Webhook subscribed successfully"))
+	}
+
+	// The response is the same whether or not the address was already subscribed or recently sent a
+	// confirmation, so it cannot be used to probe subscribers.
+	if send {
+		confirmURL := statuspagecore.SubscriptionURL(config.Env().BackendURL, page.Slug, "confirm", stored.Token)
+		email, err := statuspagecore.BuildConfirmationEmail("", stored.Target, page.Title, confirmURL)
+		if err != nil {
+			zap.L().Error("Failed to build subscription confirmation email", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to send confirmation email")
+		}
+
+		if err := notificationcore.SendMail(ctx, email); err != nil {
+			zap.L().Error("Failed to send subscription confirmation email", zap.Int64("subscriber_id", stored.ID), zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to send confirmation email")
+		}
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Check your inbox to confirm the subscription"))
+}
+
+// verifyWebhookSubscriber sends the verification challenge to a new webhook subscriber and confirms it once
+// the endpoint echoes the challenge back. Anyone can subscribe a URL, so it only receives updates after that.
+func (h *Handler) verifyWebhookSubscriber(c echo.Context, page *models.StatusPage, subscriber *models.StatusPageSubscriber) error {
+	ctx := c.Request().Context()
+
+	challenge, err := statuspagecore.NewToken()
+	if err != nil {
+		zap.L().Error("Failed to generate webhook challenge", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to verify webhook")
+	}
+
+	if err := statuspagecore.VerifyWebhook(ctx, h.WebhookClient, subscriber.Target, page.Title, page.Slug, challenge); err != nil {
+		zap.L().Info("Webhook subscriber verification failed", zap.Int64("subscriber_id", subscriber.ID), zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Webhook endpoint did not answer the verification challenge")
+	}
+
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	if err := h.Repo.ConfirmStatusPageSubscriber(ctx, tx, subscriber.ID, time.Now().UTC()); err != nil {
+		zap.L().Error("Failed to confirm webhook subscriber", zap.Int64("subscriber_id", subscriber.ID), zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to verify webhook")
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+func echoChallenge(valid bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Challenge string `json:"challenge"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if !valid {
+			body.Challenge = "wrong"
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+}
+
+func TestSubscribeStatusPage_WebhookThrottled(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server := echoChallenge(true)
+	defer server.Close()
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("UpsertStatusPageSubscriber", mock.Anything, mock.Anything, mock.MatchedBy(func(s models.StatusPageSubscriber) bool {
+		return s.Type == models.StatusPageSubscriberTypeWebhook && s.ConfirmedAt == nil
+	})).Return(&models.StatusPageSubscriber{ID: 7, StatusPageID: 1, Type: models.StatusPageSubscriberTypeWebhook, Target: server.URL}, nil)
+	mockRepo.On("MarkStatusPageSubscriberConfirmationSent", mock.Anything, mock.Anything, int64(7), mock.Anything, mock.Anything).Return(false, nil)
+
+	h := &Handler{Repo: mockRepo, WebhookClient: server.Client()}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/status-pages/acme/subscribers", strings.NewReader(`{"type":"webhook","url":"https://hooks.example.com/status"}`))
+	testutil.SetJSONHeader(c)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	err := h.SubscribeStatusPage(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusTooManyRequests, httpErr.Code)
+	mockRepo.AssertNotCalled(t, "ConfirmStatusPageSubscriber", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSubscribeStatusPage_EmailConfirmationThrottled(t *testing.T) {
+	testutil.InitTestEnv(t)
+	config.Env().SMTPEnabled = true
+	t.Cleanup(func() { config.Env().SMTPEnabled = false })
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("UpsertStatusPageSubscriber", mock.Anything, mock.Anything, mock.Anything).
+		Return(&models.StatusPageSubscriber{ID: 8, StatusPageID: 1, Type: models.StatusPageSubscriberTypeEmail, Target: "visitor@example.com", Token: "token"}, nil)
+	mockRepo.On("MarkStatusPageSubscriberConfirmationSent", mock.Anything, mock.Anything, int64(8), mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			now, since := args.Get(3).(time.Time), args.Get(4).(time.Time)
+			require.Equal(t, statuspagecore.ConfirmationResendInterval, now.Sub(since))
+		})
+
+	h := &Handler{Repo: mockRepo}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/status-pages/acme/subscribers", strings.NewReader(`{"type":"email","email":"Visitor@example.com"}`))
+	testutil.SetJSONHeader(c)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	// No SMTP server is configured, so reaching SendMail would fail the request.
+	require.NoError(t, h.SubscribeStatusPage(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "Check your inbox to confirm the subscription")
+}
+
+func TestSubscribeStatusPage_WebhookVerified(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server := echoChallenge(true)
+	defer server.Close()
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("UpsertStatusPageSubscriber", mock.Anything, mock.Anything, mock.MatchedBy(func(s models.StatusPageSubscriber) bool {
+		return s.Type == models.StatusPageSubscriberTypeWebhook && s.ConfirmedAt == nil
+	})).Return(&models.StatusPageSubscriber{ID: 7, StatusPageID: 1, Type: models.StatusPageSubscriberTypeWebhook, Target: server.URL}, nil)
+	mockRepo.On("MarkStatusPageSubscriberConfirmationSent", mock.Anything, mock.Anything, int64(7), mock.Anything, mock.Anything).Return(true, nil)
+	mockRepo.On("ConfirmStatusPageSubscriber", mock.Anything, mock.Anything, int64(7), mock.Anything).Return(nil)
+
+	h := &Handler{Repo: mockRepo, WebhookClient: server.Client()}
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/status-pages/acme/subscribers", strings.NewReader(`{"type":"webhook","url":"https://hooks.example.com/status"}`))
+	testutil.SetJSONHeader(c)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	require.NoError(t, h.SubscribeStatusPage(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	mockRepo.AssertCalled(t, "ConfirmStatusPageSubscriber", mock.Anything, mock.Anything, int64(7), mock.Anything)
+}
+
+func TestSubscribeStatusPage_WebhookChallengeMismatch(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server := echoChallenge(false)
+	defer server.Close()
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	mockRepo.On("UpsertStatusPageSubscriber", mock.Anything, mock.Anything, mock.MatchedBy(func(s models.StatusPageSubscriber) bool {
+		return s.Type == models.StatusPageSubscriberTypeWebhook && s.ConfirmedAt == nil
+	})).Return(&models.StatusPageSubscriber{ID: 7, StatusPageID: 1, Type: models.StatusPageSubscriberTypeWebhook, Target: server.URL}, nil)
+	mockRepo.On("MarkStatusPageSubscriberConfirmationSent", mock.Anything, mock.Anything, int64(7), mock.Anything, mock.Anything).Return(true, nil)
+
+	h := &Handler{Repo: mockRepo, WebhookClient: server.Client()}
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/status-pages/acme/subscribers", strings.NewReader(`{"type":"webhook","url":"https://hooks.example.com/status"}`))
+	testutil.SetJSONHeader(c)
+	c.SetParamNames("slug")
+	c.SetParamValues("acme")
+
+	err := h.SubscribeStatusPage(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.Code)
+	mockRepo.AssertNotCalled(t, "ConfirmStatusPageSubscriber", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSubscribeStatusPage_WebhookNonPublicURL(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 1, Title: "Acme", Slug: "acme"}, nil)
+	h := &Handler{Repo: mockRepo}
+
+	for _, url := range []string{"http://127.0.0.1:8080/hook", "http://169.254.169.254/latest", "http://localhost/hook", "http://[fd00::1]/hook"} {
+		c, _ := testutil.NewEchoContext(http.MethodPost, "/status-pages/acme/subscribers", strings.NewReader(`{"type":"webhook","url":"`+url+`"}`))
+		testutil.SetJSONHeader(c)
+		c.SetParamNames("slug")
+		c.SetParamValues("acme")
+		err := h.SubscribeStatusPage(c)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr, url)
+		require.Equal(t, http.StatusBadRequest, httpErr.Code, url)
+	}
+	mockRepo.AssertNotCalled(t, "UpsertStatusPageSubscriber", mock.Anything, mock.Anything, mock.Anything)
+}
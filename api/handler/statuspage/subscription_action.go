@@ -0,0 +1,250 @@
+package statuspage
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// subscriptionActionPage renders the confirm and unsubscribe pages linked from subscriber emails.
+// Both actions need a POST so mail scanners prefetching the link cannot confirm or unsubscribe anyone.
+var subscriptionActionPage = template.Must(template.New("subscription_action").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>{{.Title}}</title></head>
+<body style="font-family: sans-serif; max-width: 32rem; margin: 4rem auto; text-align: center;">
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+{{if .Confirm}}<form method="post"><input type="hidden" name="token" value="{{.Token}}"><button type="submit">{{.Confirm}}</button></form>{{end}}
+</body>
+</html>`))
+
+type subscriptionActionView struct {
+	Title   string
+	Message string
+	Confirm string
+	Token   string
+}
+
+// ShowConfirmSubscription godoc
+// @Summary Show the subscription confirmation page
+// @Description Renders the double opt-in confirmation page linked from the confirmation email
+// @Tags status-pages
+// @Produce html
+// @Param slug path string true "Status Page Slug"
+// @Param token query string true "Subscriber token"
+// @Success 200 {string} string "Confirmation page"
+// @Failure 400 {string} string "Invalid or expired link"
+// @Router /status-pages/{slug}/subscribers/confirm [get]
+func (h *Handler) ShowConfirmSubscription(c echo.Context) error {
+	page, subscriber, err := h.subscriberFromToken(c)
+	if err != nil {
+		return err
+	}
+	if subscriber == nil {
+		return renderInvalidSubscriptionLink(c)
+	}
+
+	if subscriber.ConfirmedAt != nil {
+		return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+			Title:   "Subscription confirmed",
+			Message: "You will receive incident updates from " + page.Title + ".",
+		})
+	}
+
+	return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+		Title:   "Confirm subscription",
+		Message: "Confirm that you want to receive incident updates from " + page.Title + ".",
+		Confirm: "Confirm subscription",
+		Token:   subscriber.Token,
+	})
+}
+
+// ConfirmSubscription godoc
+// @Summary Confirm an email subscription
+// @Description Confirms an email subscriber of a public status page
+// @Tags status-pages
+// @Accept x-www-form-urlencoded
+// @Produce html
+// @Param slug path string true "Status Page Slug"
+// @Param token formData string true "Subscriber token"
+// @Success 200 {string} string "Subscription confirmed"
+// @Failure 400 {string} string "Invalid or expired link"
+// @Failure 500 {string} string "Internal server error"
+// @Router /status-pages/{slug}/subscribers/confirm [post]
+func (h *Handler) ConfirmSubscription(c echo.Context) error {
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, subscriber, err := h.lookupSubscriber(c, tx)
+	if err != nil {
+		return err
+	}
+	if subscriber == nil {
+		return renderInvalidSubscriptionLink(c)
+	}
+
+	if subscriber.ConfirmedAt == nil {
+		if err := h.Repo.ConfirmStatusPageSubscriber(ctx, tx, subscriber.ID, time.Now().UTC()); err != nil {
+			zap.L().Error("Failed to confirm status page subscriber", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to confirm subscription")
+		}
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+		Title:   "Subscription confirmed",
+		Message: "You will receive incident updates from " + page.Title + ".",
+	})
+}
+
+// ShowUnsubscribe godoc
+// @Summary Show the unsubscribe page
+// @Description Renders the unsubscribe page linked from status page update emails and webhooks
+// @Tags status-pages
+// @Produce html
+// @Param slug path string true "Status Page Slug"
+// @Param token query string true "Subscriber token"
+// @Success 200 {string} string "Unsubscribe page"
+// @Failure 400 {string} string "Invalid or expired link"
+// @Router /status-pages/{slug}/subscribers/unsubscribe [get]
+func (h *Handler) ShowUnsubscribe(c echo.Context) error {
+	page, subscriber, err := h.subscriberFromToken(c)
+	if err != nil {
+		return err
+	}
+	if subscriber == nil {
+		return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+			Title:   "Unsubscribed",
+			Message: "This subscription no longer exists.",
+		})
+	}
+
+	return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+		Title:   "Unsubscribe",
+		Message: "Stop receiving incident updates from " + page.Title + ".",
+		Confirm: "Unsubscribe",
+		Token:   subscriber.Token,
+	})
+}
+
+// Unsubscribe godoc
+// @Summary Unsubscribe from a status page
+// @Description Removes a subscriber of a public status page. Also serves RFC 8058 one-click unsubscribe requests, which carry the token in the query string.
+// @Tags status-pages
+// @Accept x-www-form-urlencoded
+// @Produce html
+// @Param slug path string true "Status Page Slug"
+// @Param token formData string true "Subscriber token"
+// @Success 200 {string} string "Unsubscribed"
+// @Failure 500 {string} string "Internal server error"
+// @Router /status-pages/{slug}/subscribers/unsubscribe [post]
+func (h *Handler) Unsubscribe(c echo.Context) error {
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, subscriber, err := h.lookupSubscriber(c, tx)
+	if err != nil {
+		return err
+	}
+
+	// Unsubscribing twice is not an error; the subscriber is gone either way.
+	if subscriber != nil {
+		if err := h.Repo.DeleteStatusPageSubscriber(ctx, tx, page.ID, subscriber.ID); err != nil && err != pgx.ErrNoRows {
+			zap.L().Error("Failed to delete status page subscriber", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unsubscribe")
+		}
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return renderSubscriptionAction(c, http.StatusOK, subscriptionActionView{
+		Title:   "Unsubscribed",
+		Message: "You will no longer receive incident updates from this status page.",
+	})
+}
+
+// subscriberFromToken looks up the subscriber of a link in its own read-only transaction.
+func (h *Handler) subscriberFromToken(c echo.Context) (*models.StatusPage, *models.StatusPageSubscriber, error) {
+	ctx := c.Request().Context()
+	tx, err := h.Repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(ctx, tx)
+
+	page, subscriber, err := h.lookupSubscriber(c, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := h.Repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return page, subscriber, nil
+}
+
+// lookupSubscriber resolves the page and token of a request. The subscriber is nil when either is unknown.
+func (h *Handler) lookupSubscriber(c echo.Context, tx pgx.Tx) (*models.StatusPage, *models.StatusPageSubscriber, error) {
+	token := strings.TrimSpace(c.FormValue("token"))
+	if token == "" {
+		return nil, nil, nil
+	}
+
+	ctx := c.Request().Context()
+	page, err := h.findPublicStatusPage(c, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if page == nil {
+		return nil, nil, nil
+	}
+
+	subscriber, err := h.Repo.GetStatusPageSubscriberByToken(ctx, tx, page.ID, token)
+	if err != nil {
+		zap.L().Error("Failed to get status page subscriber", zap.Error(err))
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get subscription")
+	}
+
+	return page, subscriber, nil
+}
+
+func renderSubscriptionAction(c echo.Context, status int, view subscriptionActionView) error {
+	var builder strings.Builder
+	if err := subscriptionActionPage.Execute(&builder, view); err != nil {
+		zap.L().Error("Failed to render subscription page", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to render page")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.HTML(status, builder.String())
+}
+
+func renderInvalidSubscriptionLink(c echo.Context) error {
+	return renderSubscriptionAction(c, http.StatusBadRequest, subscriptionActionView{
+		Title:   "Link expired",
+		Message: "This link is invalid or has expired.",
+	})
+}
@@ -0,0 +1,109 @@
+package statuspage
+
+import (
+	"fmt"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+)
+
+// maxNameLength matches the limit of titles and element names.
+const maxNameLength = 255
+
+// validateStatusPageTranslations checks the default locale and the title and element name overrides of a request.
+func validateStatusPageTranslations(req statusPageUpsertRequest) error {
+	if req.DefaultLocale != "" {
+		if locale, ok := statuspagecore.MatchLocale(req.DefaultLocale); !ok || locale != req.DefaultLocale {
+			return fmt.Errorf("default_locale %q is not supported", req.DefaultLocale)
+		}
+	}
+
+	if err := statuspagecore.ValidateTranslations("title_translations", req.TitleTranslations, req.DefaultLocale, maxNameLength); err != nil {
+		return err
+	}
+	for _, g := range req.Groups {
+		if err := statuspagecore.ValidateTranslations(fmt.Sprintf("group %q name_translations", g.Name), g.NameTranslations, req.DefaultLocale, maxNameLength); err != nil {
+			return err
+		}
+	}
+	for _, m := range req.Monitors {
+		if err := statuspagecore.ValidateTranslations(fmt.Sprintf("monitor %q name_translations", m.Name), m.NameTranslations, req.DefaultLocale, maxNameLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyStatusPageLocale sets the default locale and title translations of page from the request. An
+// omitted default locale keeps the existing one; new pages default to English.
+func applyStatusPageLocale(page *models.StatusPage, req statusPageUpsertRequest, existing *models.StatusPage) {
+	switch {
+	case req.DefaultLocale != "":
+		page.DefaultLocale = req.DefaultLocale
+	case existing != nil && existing.DefaultLocale != "":
+		page.DefaultLocale = existing.DefaultLocale
+	default:
+		page.DefaultLocale = statuspagecore.DefaultLocale
+	}
+
+	page.TitleTranslations = req.TitleTranslations
+	if page.TitleTranslations == nil {
+		page.TitleTranslations = models.Translations{}
+	}
+}
+
+// publicLocale is the language a public view of a page is rendered in.
+type publicLocale struct {
+	locale        string
+	defaultLocale string
+}
+
+func newPublicLocale(page *models.StatusPage, lang, acceptLanguage string) publicLocale {
+	defaultLocale := page.DefaultLocale
+	if defaultLocale == "" {
+		defaultLocale = statuspagecore.DefaultLocale
+	}
+	return publicLocale{
+		locale:        statuspagecore.NegotiateLocale(lang, acceptLanguage, defaultLocale),
+		defaultLocale: defaultLocale,
+	}
+}
+
+// translate returns the override of text for the request locale. Text written in the default locale
+// is used as-is, so overrides keyed by it have no effect.
+func (l publicLocale) translate(text string, translations models.Translations) string {
+	if l.locale == l.defaultLocale {
+		return text
+	}
+	return statuspagecore.Translate(text, translations, l.locale)
+}
+
+// availableLocales lists the default locale of a page and every locale it has overrides for, in the
+// order of statuspagecore.Locales.
+func availableLocales(data *publicStatusPageData) []string {
+	present := map[string]bool{data.Page.DefaultLocale: true}
+	for locale := range data.Page.TitleTranslations {
+		present[locale] = true
+	}
+	for _, group := range data.Groups {
+		for locale := range group.NameTranslations {
+			present[locale] = true
+		}
+	}
+	for _, monitor := range data.Monitors {
+		for locale := range monitor.NameTranslations {
+			present[locale] = true
+		}
+	}
+
+	locales := make([]string, 0, len(present))
+	for _, locale := range statuspagecore.Locales {
+		if present[locale] {
+			locales = append(locales, locale)
+		}
+	}
+	if len(locales) == 0 {
+		locales = append(locales, statuspagecore.DefaultLocale)
+	}
+	return locales
+}
@@ -0,0 +1,142 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestValidateStatusPageTranslations(t *testing.T) {
+	req := statusPageUpsertRequest{
+		DefaultLocale:     "en",
+		TitleTranslations: models.Translations{"ja": "ステータス"},
+		Groups:            []statusPageGroupInput{{Name: "Core", NameTranslations: models.Translations{"zh-TW": "核心服務"}}},
+		Monitors:          []statusPageMonitorInput{{Name: "API", NameTranslations: models.Translations{"de": "API"}}},
+	}
+	require.NoError(t, validateStatusPageTranslations(req))
+
+	invalid := req
+	invalid.DefaultLocale = "ko"
+	require.Error(t, validateStatusPageTranslations(invalid))
+
+	invalid = req
+	invalid.TitleTranslations = models.Translations{"en": "Status"}
+	require.Error(t, validateStatusPageTranslations(invalid))
+
+	invalid = req
+	invalid.Monitors = []statusPageMonitorInput{{Name: "API", NameTranslations: models.Translations{"jp": "API"}}}
+	require.Error(t, validateStatusPageTranslations(invalid))
+}
+
+func TestApplyStatusPageLocale(t *testing.T) {
+	var page models.StatusPage
+	applyStatusPageLocale(&page, statusPageUpsertRequest{}, nil)
+	require.Equal(t, "en", page.DefaultLocale)
+	require.NotNil(t, page.TitleTranslations)
+
+	existing := &models.StatusPage{DefaultLocale: "ja"}
+	applyStatusPageLocale(&page, statusPageUpsertRequest{TitleTranslations: models.Translations{"en": "Status"}}, existing)
+	require.Equal(t, "ja", page.DefaultLocale)
+	require.Equal(t, "Status", page.TitleTranslations["en"])
+
+	applyStatusPageLocale(&page, statusPageUpsertRequest{DefaultLocale: "de"}, existing)
+	require.Equal(t, "de", page.DefaultLocale)
+}
+
+func TestGetPublicStatusPage_Localized(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	now := time.Now().UTC()
+	userID := int64(5)
+	groupID := int64(20)
+	incident := models.Incident{ID: 9, Status: models.IncidentStatusInvestigating, IsPublic: true, StartedAt: now, UpdatedAt: now}
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "acme").
+		Return(&models.StatusPage{ID: 1, TeamID: 2, Title: "Acme Status", Slug: "acme", DefaultLocale: "en", TitleTranslations: models.Translations{"ja": "Acme ステータス"}}, nil)
+	mockRepo.On("ListStatusPageGroupsByStatusPageID", mock.Anything, mock.Anything, int64(1)).
+		Return([]models.StatusPageGroup{{ID: groupID, StatusPageID: 1, Name: "Core", Type: models.StatusPageElementTypeCurrentStatusIndicator, SortOrder: 1, NameTranslations: models.Translations{"ja": "コア", "zh-TW": "核心"}}}, nil)
+	mockRepo.On("ListStatusPageMonitorsByStatusPageID", mock.Anything, mock.Anything, int64(1)).
+		Return([]models.StatusPageMonitor{{ID: 31, StatusPageID: 1, MonitorID: 100, GroupID: &groupID, Name: "API", Type: models.StatusPageElementTypeCurrentStatusIndicator, SortOrder: 1}}, nil)
+	mockRepo.On("ListMonitorsByIDs", mock.Anything, mock.Anything, int64(2), []int64{100}).
+		Return([]models.Monitor{{ID: 100, Status: models.MonitorStatusUp}}, nil)
+	mockRepo.On("ListPublicIncidentsByMonitorIDs", mock.Anything, mock.Anything, []int64{100}).
+		Return([]models.IncidentWithMonitorID{{Incident: incident, MonitorID: 100}}, nil)
+	mockRepo.On("ListPublicEventTimelinesByIncidentIDs", mock.Anything, mock.Anything, []int64{9}).
+		Return([]models.EventTimeline{{ID: 50, IncidentID: 9, CreatedBy: &userID, Message: "Looking into it", MessageTranslations: models.Translations{"ja": "調査しています"}, EventType: models.IncidentEventTypeInvestigating, CreatedAt: now, UpdatedAt: now}}, nil)
+	mockRepo.On("ListOpenMaintenancesByStatusPageID", mock.Anything, mock.Anything, int64(1)).Return([]models.Maintenance{}, nil)
+	mockRepo.On("ListMaintenanceUpdatesByMaintenanceIDs", mock.Anything, mock.Anything, []int64{}).Return([]models.MaintenanceUpdate{}, nil)
+	mockRepo.On("ListActiveComponentOverridesByStatusPageID", mock.Anything, mock.Anything, int64(1), mock.Anything).Return([]models.ComponentOverride{}, nil)
+	mockRepo.On("ListMonitorDailySummaryByMonitorIDs", mock.Anything, mock.Anything, []int64{100}, mock.Anything, mock.Anything).Return([]models.MonitorDailySummary{}, nil)
+	mockRepo.On("ListMonitorMaintenanceFailures", mock.Anything, mock.Anything, []int64{100}, mock.Anything, mock.Anything).Return([]models.MonitorMaintenanceFailures{}, nil)
+
+	h := &Handler{Repo: mockRepo}
+
+	type localizedResponse struct {
+		Data struct {
+			StatusPage struct {
+				Title string `json:"title"`
+			} `json:"status_page"`
+			Elements []struct {
+				Name     string `json:"name"`
+				Monitors []struct {
+					Name string `json:"name"`
+				} `json:"monitors"`
+			} `json:"elements"`
+			Incidents []struct {
+				Timeline []struct {
+					Message string `json:"message"`
+				} `json:"timeline"`
+			} `json:"incidents"`
+			Locale  string            `json:"locale"`
+			Locales []string          `json:"locales"`
+			Labels  map[string]string `json:"labels"`
+		} `json:"data"`
+	}
+	get := func(target, acceptLanguage string) localizedResponse {
+		c, rec := testutil.NewEchoContext(http.MethodGet, target, nil)
+		if acceptLanguage != "" {
+			c.Request().Header.Set("Accept-Language", acceptLanguage)
+		}
+		c.SetParamNames("slug")
+		c.SetParamValues("acme")
+
+		require.NoError(t, h.GetPublicStatusPage(c))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Header().Values("Vary"), "Accept-Language")
+
+		var resp localizedResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := get("/status-pages/acme", "ja-JP,ja;q=0.9,en;q=0.8")
+	require.Equal(t, "ja", resp.Data.Locale)
+	require.Equal(t, []string{"en", "zh-TW", "ja"}, resp.Data.Locales)
+	require.Equal(t, "Acme ステータス", resp.Data.StatusPage.Title)
+	require.Equal(t, "コア", resp.Data.Elements[0].Name)
+	require.Equal(t, "API", resp.Data.Elements[0].Monitors[0].Name)
+	require.Equal(t, "調査しています", resp.Data.Incidents[0].Timeline[0].Message)
+	require.Equal(t, "正常", resp.Data.Labels["status.up"])
+
+	resp = get("/status-pages/acme?lang=zh-TW", "ja")
+	require.Equal(t, "zh-TW", resp.Data.Locale)
+	require.Equal(t, "Acme Status", resp.Data.StatusPage.Title)
+	require.Equal(t, "核心", resp.Data.Elements[0].Name)
+	require.Equal(t, "Looking into it", resp.Data.Incidents[0].Timeline[0].Message)
+
+	resp = get("/status-pages/acme", "")
+	require.Equal(t, "en", resp.Data.Locale)
+	require.Equal(t, "Core", resp.Data.Elements[0].Name)
+	require.Equal(t, "Operational", resp.Data.Labels["status.up"])
+}
@@ -0,0 +1,98 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/encrypt"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type unlockStatusPageRequest struct {
+	Password string `json:"password" validate:"required,max=128"`
+}
+
+// UnlockStatusPage godoc
+// @Summary Unlock a password-protected status page
+// @Description Checks the password of a password-protected status page and sets a signed access cookie for it
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Param slug path string true "Status Page Slug"
+// @Param request body unlockStatusPageRequest true "Status page password"
+// @Success 200 {object} response.SuccessResponse "Status page unlocked"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or page is not password protected"
+// @Failure 401 {object} response.ErrorResponse "Invalid password"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 429 {object} response.ErrorResponse "Too many attempts"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /status-pages/{slug}/access [post]
+func (h *Handler) UnlockStatusPage(c echo.Context) error {
+	var req unlockStatusPageRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	page, err := h.findPublicStatusPage(c, tx)
+	if err != nil {
+		return err
+	}
+	if page == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	if page.Visibility != models.StatusPageVisibilityPassword || page.PasswordHash == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Status page is not password protected")
+	}
+
+	// Every attempt costs an argon2id hash, so attempts are limited per page and client IP.
+	limiter := h.UnlockLimiter
+	if limiter == nil {
+		limiter = defaultUnlockLimiter
+	}
+	if ok, retryAfter := limiter.Allow(fmt.Sprintf("%d|%s", page.ID, c.RealIP()), time.Now()); !ok {
+		c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		return echo.NewHTTPError(http.StatusTooManyRequests, "Too many attempts, try again later")
+	}
+
+	match, err := encrypt.ComparePasswordAndHash(req.Password, *page.PasswordHash)
+	if err != nil {
+		zap.L().Error("Failed to compare status page password", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unlock status page")
+	}
+	if !match {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Invalid password")
+	}
+
+	cookie, err := generateStatusPageAccessCookie(page)
+	if err != nil {
+		zap.L().Error("Failed to generate status page access token", zap.Error(err), zap.Int64("status_page_id", page.ID))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unlock status page")
+	}
+	c.SetCookie(cookie)
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Status page unlocked"))
+}
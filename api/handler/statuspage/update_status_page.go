@@ -1,6 +1,7 @@
 package statuspage
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -16,7 +17,7 @@ import (
 
 // UpdateStatusPage godoc
 // @Summary Update a status page
-// @Description Updates slug/icon/groups/monitors for the given status page (owner/admin only)
+// @Description Updates slug/icon/visibility/groups/monitors for the given status page (owner/admin only)
 // @Tags status_pages
 // @Accept json
 // @Produce json
@@ -119,6 +120,17 @@ func (h *Handler) UpdateStatusPage(c echo.Context) error {
 		UpdatedAt: now,
 	}
 
+	applyStatusPageBranding(&updatedPage, normalizedReq, existing)
+	applyStatusPageLocale(&updatedPage, normalizedReq, existing)
+
+	if err := applyStatusPageVisibility(&updatedPage, normalizedReq, existing); err != nil {
+		if errors.Is(err, errStatusPagePasswordRequired) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		zap.L().Error("Failed to hash status page password", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update status page")
+	}
+
 	page, err := h.Repo.UpdateStatusPage(c.Request().Context(), tx, updatedPage)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -128,7 +140,18 @@ func (h *Handler) UpdateStatusPage(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update status page")
 	}
 
-	groups, monitors, err := buildStatusPageElements(normalizedReq, page.ID)
+	existingGroups, err := h.Repo.ListStatusPageGroupsByStatusPageID(c.Request().Context(), tx, page.ID)
+	if err != nil {
+		zap.L().Error("Failed to list status page groups", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list status page groups")
+	}
+
+	existingGroupIDs := make(map[int64]bool, len(existingGroups))
+	for _, group := range existingGroups {
+		existingGroupIDs[group.ID] = true
+	}
+
+	groups, monitors, err := buildStatusPageElements(normalizedReq, page.ID, existingGroupIDs)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
@@ -0,0 +1,143 @@
+package statuspage
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateStatusPageDomain godoc
+// @Summary Set status page custom domain
+// @Description Claims a custom domain for a status page (owner/admin only). Changing the domain issues a new verification token and the page is only served on it once verified. Unverified claims do not block other pages and expire after 7 days.
+// @Tags status-pages
+// @Accept json
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Param request body statusPageDomainRequest true "Custom domain"
+// @Success 200 {object} response.SuccessResponse "Status page domain updated successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or domain"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 409 {object} response.ErrorResponse "Domain already verified by another status page"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/domain [put]
+func (h *Handler) UpdateStatusPageDomain(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	var req statusPageDomainRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := validator.New().Struct(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	domain, err := statuspagecore.NormalizeDomain(req.Domain)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid domain: "+err.Error())
+	}
+
+	if reservedDomain(domain) {
+		return echo.NewHTTPError(http.StatusBadRequest, "This domain cannot be used for a status page")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, *userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "You do not have permission to update status pages for this team")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(c.Request().Context(), tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	// Saving the same domain again keeps its token and verification.
+	if page.CustomDomain != nil && *page.CustomDomain == domain {
+		if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+		}
+		return c.JSON(http.StatusOK, response.Success("Status page domain updated successfully", newStatusPageDomainResponse(*page)))
+	}
+
+	claimed, err := h.Repo.GetStatusPageByCustomDomain(c.Request().Context(), tx, domain)
+	if err != nil {
+		zap.L().Error("Failed to check custom domain uniqueness", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check custom domain uniqueness")
+	}
+	if claimed != nil {
+		return echo.NewHTTPError(http.StatusConflict, "Domain is already verified by another status page")
+	}
+
+	token, err := statuspagecore.NewToken()
+	if err != nil {
+		zap.L().Error("Failed to generate domain verification token", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update status page domain")
+	}
+
+	updated, err := h.Repo.UpdateStatusPageDomain(c.Request().Context(), tx, teamID, page.ID, &domain, &token, time.Now().UTC())
+	if err != nil {
+		zap.L().Error("Failed to update status page domain", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update status page domain")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Status page domain updated successfully", newStatusPageDomainResponse(*updated)))
+}
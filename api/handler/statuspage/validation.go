@@ -2,15 +2,28 @@ package statuspage
 
 import (
 	"fmt"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// Windows of the chart element types, in days.
+const (
+	defaultUptimeBarsDays        = 30
+	maxUptimeBarsDays            = 365
+	defaultResponseTimeChartDays = 1
+	maxResponseTimeChartDays     = 30
 )
 
-// validateStatusPagePayload enforces sort order uniqueness and group references.
+// validateStatusPagePayload enforces sort order uniqueness, group references, branding limits and translations.
 func validateStatusPagePayload(req statusPageUpsertRequest) error {
 	groupOrders := make(map[int]struct{})
 	groupIDs := make(map[int64]struct{})
 	fatherOrders := make(map[int]struct{})
 
 	for _, g := range req.Groups {
+		if err := validateElementSettings(fmt.Sprintf("group %q", g.Name), g.Type, g.Days, g.PerRegion); err != nil {
+			return err
+		}
 		if _, exists := groupOrders[g.SortOrder]; exists {
 			return fmt.Errorf("duplicate group sort_order %d", g.SortOrder)
 		}
@@ -26,6 +39,9 @@ func validateStatusPagePayload(req statusPageUpsertRequest) error {
 
 	monitorOrders := make(map[string]map[int]struct{}) // key: groupID|nil
 	for _, m := range req.Monitors {
+		if err := validateElementSettings(fmt.Sprintf("monitor %q", m.Name), m.Type, m.Days, m.PerRegion); err != nil {
+			return err
+		}
 		groupKey := "nil"
 		groupLabel := "ungrouped monitors"
 		if m.GroupID != nil {
@@ -62,6 +78,30 @@ func validateStatusPagePayload(req statusPageUpsertRequest) error {
 		}
 	}
 
+	if err := validateStatusPageBranding(req); err != nil {
+		return err
+	}
+	return validateStatusPageTranslations(req)
+}
+
+// validateElementSettings checks days and per_region, which only chart element types support.
+func validateElementSettings(label string, elementType models.StatusPageElementType, days *int, perRegion bool) error {
+	maxDays := 0
+	switch elementType {
+	case models.StatusPageElementTypeUptimeBars:
+		maxDays = maxUptimeBarsDays
+	case models.StatusPageElementTypeResponseTimeChart:
+		maxDays = maxResponseTimeChartDays
+	default:
+		if days != nil || perRegion {
+			return fmt.Errorf("%s: days and per_region are only supported by uptime_bars and response_time_chart elements", label)
+		}
+		return nil
+	}
+
+	if days != nil && (*days < 1 || *days > maxDays) {
+		return fmt.Errorf("%s: days must be between 1 and %d for %s elements", label, maxDays, elementType)
+	}
 	return nil
 }
 
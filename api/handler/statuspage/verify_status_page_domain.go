@@ -0,0 +1,159 @@
+package statuspage
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// domainVerificationClient fetches HTTP verification tokens. The domain is chosen by a team admin, so it
+// only connects to public addresses and does not follow redirects.
+var domainVerificationClient = statuspagecore.NewWebhookClient()
+
+// VerifyStatusPageDomain godoc
+// @Summary Verify status page custom domain
+// @Description Checks the TXT record _kymarium-challenge.<domain>, then the HTTP token at http://<domain>/.well-known/kymarium-verification.txt, and starts serving the page on the domain once either matches (owner/admin only). Verifying takes the domain over from other pages that claimed it without verifying.
+// @Tags status-pages
+// @Produce json
+// @Param teamID path string true "Team ID"
+// @Param id path string true "Status Page ID"
+// @Success 200 {object} response.SuccessResponse "Status page domain verified successfully"
+// @Failure 400 {object} response.ErrorResponse "No custom domain or verification failed"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Forbidden"
+// @Failure 404 {object} response.ErrorResponse "Status page not found"
+// @Failure 409 {object} response.ErrorResponse "Domain already verified by another status page"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /teams/{teamID}/status-pages/{id}/domain/verify [post]
+func (h *Handler) VerifyStatusPageDomain(c echo.Context) error {
+	teamID, err := strconv.ParseInt(c.Param("teamID"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid team ID")
+	}
+
+	statusPageID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid status page ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	page, err := h.domainPageForAdmin(c, teamID, statusPageID, *userID)
+	if err != nil {
+		return err
+	}
+
+	if page.CustomDomain == nil || page.DomainVerificationToken == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Status page has no custom domain")
+	}
+
+	if page.DomainVerifiedAt != nil {
+		return c.JSON(http.StatusOK, response.Success("Status page domain verified successfully", newStatusPageDomainResponse(*page)))
+	}
+
+	// DNS and HTTP checks run outside the transaction so a slow lookup does not hold it open.
+	method, err := statuspagecore.VerifyDomain(c.Request().Context(), domainVerificationClient, *page.CustomDomain, *page.DomainVerificationToken)
+	if err != nil {
+		if errors.Is(err, statuspagecore.ErrDomainNotVerified) {
+			return echo.NewHTTPError(http.StatusBadRequest, "Domain verification failed: neither the TXT record nor the HTTP token was found")
+		}
+		zap.L().Error("Failed to verify status page domain", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to verify status page domain")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	now := time.Now().UTC()
+	verified, err := h.Repo.MarkStatusPageDomainVerified(c.Request().Context(), tx, page.ID, *page.DomainVerificationToken, now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return echo.NewHTTPError(http.StatusConflict, "Domain is already verified by another status page")
+		}
+		zap.L().Error("Failed to mark status page domain verified", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to verify status page domain")
+	}
+
+	if verified == nil {
+		return echo.NewHTTPError(http.StatusConflict, "The custom domain changed during verification, try again")
+	}
+
+	// Proving control of the domain takes it over from pages that only claimed it.
+	if err := h.Repo.ReleaseStatusPageDomainClaims(c.Request().Context(), tx, *page.CustomDomain, page.ID, now); err != nil {
+		zap.L().Error("Failed to release other claims of the status page domain", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to verify status page domain")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	zap.L().Info("Status page domain verified",
+		zap.Int64("status_page_id", page.ID),
+		zap.String("domain", *page.CustomDomain),
+		zap.String("method", method))
+
+	return c.JSON(http.StatusOK, response.Success("Status page domain verified successfully", newStatusPageDomainResponse(*verified)))
+}
+
+// domainPageForAdmin loads a team's status page for an owner or admin in a short read transaction.
+func (h *Handler) domainPageForAdmin(c echo.Context, teamID, statusPageID, userID int64) (*models.StatusPage, error) {
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, teamID, userID)
+	if err != nil {
+		zap.L().Error("Failed to get team membership", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+	}
+
+	if member == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if member.Role != models.MemberRoleOwner && member.Role != models.MemberRoleAdmin {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "You do not have permission to update status pages for this team")
+	}
+
+	page, err := h.Repo.GetStatusPageByID(c.Request().Context(), tx, teamID, statusPageID)
+	if err != nil {
+		zap.L().Error("Failed to get status page", zap.Error(err))
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get status page")
+	}
+
+	if page == nil {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return page, nil
+}
@@ -0,0 +1,149 @@
+package statuspage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/encrypt"
+	"go.uber.org/zap"
+)
+
+// statusPageAccessTTL is how long a password-protected page stays unlocked in a browser.
+const statusPageAccessTTL = 7 * 24 * time.Hour
+
+var errStatusPagePasswordRequired = errors.New("password is required for password-protected status pages")
+
+// applyStatusPageVisibility sets the visibility and password hash of page from the request.
+// An empty visibility keeps the existing one (public for new pages) and an omitted password keeps the
+// existing hash, so clients that don't know about visibility never open up a private page.
+func applyStatusPageVisibility(page *models.StatusPage, req statusPageUpsertRequest, existing *models.StatusPage) error {
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = models.StatusPageVisibilityPublic
+		if existing != nil && existing.Visibility != "" {
+			visibility = existing.Visibility
+		}
+	}
+
+	page.Visibility = visibility
+	page.PasswordHash = nil
+	if visibility != models.StatusPageVisibilityPassword {
+		return nil
+	}
+
+	if req.Password == nil {
+		if existing != nil && existing.PasswordHash != nil {
+			page.PasswordHash = existing.PasswordHash
+			return nil
+		}
+		return errStatusPagePasswordRequired
+	}
+
+	hash, err := encrypt.CreateArgon2idHash(*req.Password)
+	if err != nil {
+		return err
+	}
+	page.PasswordHash = &hash
+
+	return nil
+}
+
+// isPublicStatusPage reports whether anyone may view the page. Pages stored before visibility existed are public.
+func isPublicStatusPage(page *models.StatusPage) bool {
+	return page.Visibility == "" || page.Visibility == models.StatusPageVisibilityPublic
+}
+
+// authorizePublicStatusPage enforces the visibility of page on a public request. Password pages need a
+// valid access cookie; team pages need a session of a team member and are hidden from everyone else.
+func (h *Handler) authorizePublicStatusPage(c echo.Context, tx pgx.Tx, page *models.StatusPage) error {
+	if isPublicStatusPage(page) {
+		return nil
+	}
+
+	// Private content must not end up in shared caches.
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+
+	switch page.Visibility {
+	case models.StatusPageVisibilityPassword:
+		if !hasStatusPageAccess(c, page) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Status page password required")
+		}
+		return nil
+	case models.StatusPageVisibilityTeam:
+		userID, err := authutil.GetUserIDFromContext(c)
+		if err != nil || userID == nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Sign in to view this status page")
+		}
+
+		member, err := h.Repo.GetTeamMemberByUserID(c.Request().Context(), tx, page.TeamID, *userID)
+		if err != nil {
+			zap.L().Error("Failed to get team membership", zap.Error(err))
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get team membership")
+		}
+		if member == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+		}
+		return nil
+	default:
+		zap.L().Error("Unknown status page visibility", zap.String("visibility", string(page.Visibility)), zap.Int64("status_page_id", page.ID))
+		return echo.NewHTTPError(http.StatusNotFound, "Status page not found")
+	}
+}
+
+// statusPageAccessCookieName is per page so unlocking one page does not lock another.
+func statusPageAccessCookieName(statusPageID int64) string {
+	return models.CookieNameStatusPageAccess + "_" + strconv.FormatInt(statusPageID, 10)
+}
+
+// passwordFingerprint identifies the current password of a page without exposing its hash.
+func passwordFingerprint(hash string) string {
+	sum := sha256.Sum256([]byte(hash))
+	return hex.EncodeToString(sum[:8])
+}
+
+func hasStatusPageAccess(c echo.Context, page *models.StatusPage) bool {
+	if page.PasswordHash == nil {
+		return false
+	}
+
+	cookie, err := c.Cookie(statusPageAccessCookieName(page.ID))
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+
+	secret := encrypt.JWTSecret{Secret: config.Env().JWTSecretKey}
+	valid, claims, err := secret.ValidateStatusPageAccessToken(cookie.Value)
+	if err != nil || !valid {
+		return false
+	}
+
+	return claims.Subject == strconv.FormatInt(page.ID, 10) && claims.Password == passwordFingerprint(*page.PasswordHash)
+}
+
+func generateStatusPageAccessCookie(page *models.StatusPage) (*http.Cookie, error) {
+	expiresAt := time.Now().Add(statusPageAccessTTL)
+	secret := encrypt.JWTSecret{Secret: config.Env().JWTSecretKey}
+	token, err := secret.GenerateStatusPageAccessToken(page.ID, passwordFingerprint(*page.PasswordHash), expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Cookie{
+		Name:     statusPageAccessCookieName(page.ID),
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   config.Env().AppEnv == config.AppEnvProd,
+		Expires:  expiresAt,
+		SameSite: http.SameSiteLaxMode,
+	}, nil
+}
@@ -0,0 +1,150 @@
+package statuspage
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/encrypt"
+)
+
+func requireHTTPError(t *testing.T, err error, code int) {
+	t.Helper()
+	httpErr, ok := err.(*echo.HTTPError)
+	require.True(t, ok, "expected *echo.HTTPError, got %v", err)
+	require.Equal(t, code, httpErr.Code)
+}
+
+func TestApplyStatusPageVisibility(t *testing.T) {
+	hash := "$argon2id$existing"
+	existing := &models.StatusPage{Visibility: models.StatusPageVisibilityPassword, PasswordHash: &hash}
+
+	var page models.StatusPage
+	require.NoError(t, applyStatusPageVisibility(&page, statusPageUpsertRequest{}, nil))
+	require.Equal(t, models.StatusPageVisibilityPublic, page.Visibility)
+
+	page = models.StatusPage{}
+	err := applyStatusPageVisibility(&page, statusPageUpsertRequest{Visibility: models.StatusPageVisibilityPassword}, nil)
+	require.ErrorIs(t, err, errStatusPagePasswordRequired)
+
+	page = models.StatusPage{}
+	require.NoError(t, applyStatusPageVisibility(&page, statusPageUpsertRequest{}, existing))
+	require.Equal(t, models.StatusPageVisibilityPassword, page.Visibility)
+	require.Equal(t, &hash, page.PasswordHash)
+
+	page = models.StatusPage{}
+	require.NoError(t, applyStatusPageVisibility(&page, statusPageUpsertRequest{Visibility: models.StatusPageVisibilityTeam}, existing))
+	require.Equal(t, models.StatusPageVisibilityTeam, page.Visibility)
+	require.Nil(t, page.PasswordHash)
+}
+
+func TestUnlockStatusPage(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	hash, err := encrypt.CreateArgon2idHash("correct-horse")
+	require.NoError(t, err)
+	page := &models.StatusPage{ID: 1, TeamID: 2, Slug: "internal", Visibility: models.StatusPageVisibilityPassword, PasswordHash: &hash}
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "internal").Return(page, nil)
+	h := &Handler{Repo: mockRepo}
+
+	c, _ := testutil.NewEchoContext(http.MethodPost, "/status-pages/internal/access", strings.NewReader(`{"password":"wrong-horse"}`))
+	c.SetParamNames("slug")
+	c.SetParamValues("internal")
+	requireHTTPError(t, h.UnlockStatusPage(c), http.StatusUnauthorized)
+
+	c, rec := testutil.NewEchoContext(http.MethodPost, "/status-pages/internal/access", strings.NewReader(`{"password":"correct-horse"}`))
+	c.SetParamNames("slug")
+	c.SetParamValues("internal")
+	require.NoError(t, h.UnlockStatusPage(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, "_kymarium_status_page_1", cookies[0].Name)
+	require.True(t, cookies[0].HttpOnly)
+
+	locked, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/internal", nil)
+	requireHTTPError(t, h.authorizePublicStatusPage(locked, nil, page), http.StatusUnauthorized)
+	require.Equal(t, "private, no-store", locked.Response().Header().Get("Cache-Control"))
+
+	unlocked, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/internal", nil)
+	unlocked.Request().AddCookie(cookies[0])
+	require.NoError(t, h.authorizePublicStatusPage(unlocked, nil, page))
+
+	// A new password revokes cookies issued for the old one.
+	newHash := hash + "x"
+	rotated := *page
+	rotated.PasswordHash = &newHash
+	requireHTTPError(t, h.authorizePublicStatusPage(unlocked, nil, &rotated), http.StatusUnauthorized)
+}
+
+func TestUnlockStatusPage_RateLimited(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	hash, err := encrypt.CreateArgon2idHash("correct-horse")
+	require.NoError(t, err)
+	page := &models.StatusPage{ID: 1, TeamID: 2, Slug: "internal", Visibility: models.StatusPageVisibilityPassword, PasswordHash: &hash}
+
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetStatusPageBySlug", mock.Anything, mock.Anything, "internal").Return(page, nil)
+	h := &Handler{Repo: mockRepo, UnlockLimiter: statuspagecore.NewAttemptLimiter(1, time.Minute)}
+
+	unlock := func(ip, password string) (echo.Context, error) {
+		c, _ := testutil.NewEchoContext(http.MethodPost, "/status-pages/internal/access", strings.NewReader(`{"password":"`+password+`"}`))
+		c.Request().RemoteAddr = ip + ":1234"
+		c.SetParamNames("slug")
+		c.SetParamValues("internal")
+		return c, h.UnlockStatusPage(c)
+	}
+
+	_, err = unlock("203.0.113.1", "wrong-horse")
+	requireHTTPError(t, err, http.StatusUnauthorized)
+
+	// The limit applies before the password is checked, so the right password is refused too.
+	c, err := unlock("203.0.113.1", "correct-horse")
+	requireHTTPError(t, err, http.StatusTooManyRequests)
+	require.NotEmpty(t, c.Response().Header().Get(echo.HeaderRetryAfter))
+
+	// Other clients keep their own budget.
+	_, err = unlock("203.0.113.2", "correct-horse")
+	require.NoError(t, err)
+}
+
+func TestAuthorizePublicStatusPage_Team(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	page := &models.StatusPage{ID: 1, TeamID: 2, Slug: "internal", Visibility: models.StatusPageVisibilityTeam}
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(2), int64(7)).
+		Return(&models.TeamMember{TeamID: 2, UserID: 7, Role: models.MemberRoleMember}, nil)
+	mockRepo.On("GetTeamMemberByUserID", mock.Anything, mock.Anything, int64(2), int64(8)).
+		Return(nil, nil)
+	h := &Handler{Repo: mockRepo}
+
+	anonymous, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/internal", nil)
+	requireHTTPError(t, h.authorizePublicStatusPage(anonymous, nil, page), http.StatusUnauthorized)
+
+	outsider, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/internal", nil)
+	testutil.Authenticate(outsider, 8)
+	requireHTTPError(t, h.authorizePublicStatusPage(outsider, nil, page), http.StatusNotFound)
+
+	member, _ := testutil.NewEchoContext(http.MethodGet, "/status-pages/internal", nil)
+	testutil.Authenticate(member, 7)
+	require.NoError(t, h.authorizePublicStatusPage(member, nil, page))
+}
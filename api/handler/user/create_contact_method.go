@@ -0,0 +1,106 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+type contactMethodRequest struct {
+	Type   models.NotificationType `json:"type" validate:"required,oneof=discord telegram slack email"`
+	Name   string                  `json:"name" validate:"required,min=1,max=255"`
+	Config json.RawMessage         `json:"config" validate:"required"`
+}
+
+// decodeContactMethodRequest decodes and validates a contact method body, including its channel config.
+func decodeContactMethodRequest(c echo.Context) (*contactMethodRequest, error) {
+	var req contactMethodRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validator.New().Struct(req); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	if err := notificationcore.ValidateConfig(req.Type, req.Config); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid contact method config")
+	}
+
+	return &req, nil
+}
+
+// CreateContactMethod godoc
+// @Summary Create a contact method
+// @Description Adds a personal contact method (email, Slack, Discord or Telegram) for the authenticated user
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body contactMethodRequest true "Contact method create request"
+// @Success 200 {object} response.SuccessResponse "Contact method created successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /users/me/contact-methods [post]
+func (h *Handler) CreateContactMethod(c echo.Context) error {
+	req, err := decodeContactMethodRequest(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	methodID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("Failed to generate contact method ID", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate contact method ID")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	now := time.Now()
+	method := models.UserContactMethod{
+		ID:        methodID,
+		UserID:    *userID,
+		Type:      req.Type,
+		Name:      req.Name,
+		Config:    req.Config,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := h.Repo.CreateUserContactMethod(c.Request().Context(), tx, method); err != nil {
+		zap.L().Error("Failed to create contact method", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create contact method")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Contact method created successfully", method))
+}
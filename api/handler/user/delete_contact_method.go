@@ -0,0 +1,63 @@
+package user
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// DeleteContactMethod godoc
+// @Summary Delete a contact method
+// @Description Removes a personal contact method of the authenticated user
+// @Tags users
+// @Produce json
+// @Param id path string true "Contact method ID"
+// @Success 200 {object} response.SuccessResponse "Contact method deleted successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid contact method ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Contact method not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /users/me/contact-methods/{id} [delete]
+func (h *Handler) DeleteContactMethod(c echo.Context) error {
+	methodID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid contact method ID")
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	if err := h.Repo.DeleteUserContactMethod(c.Request().Context(), tx, *userID, methodID); err != nil {
+		if err == pgx.ErrNoRows {
+			return echo.NewHTTPError(http.StatusNotFound, "Contact method not found")
+		}
+
+		zap.L().Error("Failed to delete contact method", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete contact method")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.SuccessMessage("Contact method deleted successfully"))
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/models"
+	authutil "github.com/yorukot/kymarium/utils/auth"
+	"github.com/yorukot/kymarium/utils/response"
+	"go.uber.org/zap"
+)
+
+// UpdateContactMethod godoc
+// @Summary Update a contact method
+// @Description Replaces a personal contact method of the authenticated user
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "Contact method ID"
+// @Param request body contactMethodRequest true "Contact method update request"
+// @Success 200 {object} response.SuccessResponse "Contact method updated successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request body or contact method ID"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Contact method not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /users/me/contact-methods/{id} [put]
+func (h *Handler) UpdateContactMethod(c echo.Context) error {
+	methodID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid contact method ID")
+	}
+
+	req, err := decodeContactMethodRequest(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := authutil.GetUserIDFromContext(c)
+	if err != nil {
+		zap.L().Error("Failed to parse user ID from context", zap.Error(err))
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid user ID")
+	}
+
+	if userID == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	tx, err := h.Repo.StartTransaction(c.Request().Context())
+	if err != nil {
+		zap.L().Error("Failed to begin transaction", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to begin transaction")
+	}
+	defer h.Repo.DeferRollback(c.Request().Context(), tx)
+
+	updated, err := h.Repo.UpdateUserContactMethod(c.Request().Context(), tx, models.UserContactMethod{
+		ID:        methodID,
+		UserID:    *userID,
+		Type:      req.Type,
+		Name:      req.Name,
+		Config:    req.Config,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		zap.L().Error("Failed to update contact method", zap.Error(err))
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update contact method")
+	}
+
+	if updated == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Contact method not found")
+	}
+
+	if err := h.Repo.CommitTransaction(c.Request().Context(), tx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to commit transaction")
+	}
+
+	return c.JSON(http.StatusOK, response.Success("Contact method updated successfully", updated))
+}
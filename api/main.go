@@ -1,16 +1,21 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	scalar "github.com/MarceloPetrucio/go-scalar-api-reference"
+	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 	echoMiddleware "github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"github.com/yorukot/kymarium/api/middleware"
 	"github.com/yorukot/kymarium/api/router"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
 	swaggerDocs "github.com/yorukot/kymarium/docs"
 	"github.com/yorukot/kymarium/repository"
 	"github.com/yorukot/kymarium/utils/config"
@@ -34,15 +39,27 @@ func Run(db *pgxpool.Pool) {
 		AllowCredentials: true,
 	}))
 
+	// Notifier enqueues background tasks (e.g., notification resends) for the worker.
+	notifier := asynq.NewClient(asynq.RedisClientOpt{
+		Addr:     fmt.Sprintf("%s:%s", env.RedisHost, env.RedisPort),
+		Password: env.RedisPassword,
+	})
+	defer notifier.Close()
+
 	// Setup routes
 	repo := repository.New(db)
-	routes(e, repo)
+
+	// Public status pages are served from snapshots dropped whenever Postgres reports a change.
+	snapshots := statuspagecore.NewSnapshotCache(statuspagecore.SnapshotFreshFor, statuspagecore.SnapshotStaleFor)
+	go watchStatusPageChanges(context.Background(), repo, snapshots)
+
+	routes(e, repo, notifier, snapshots)
 	e.Logger.Infof("Starting server on port %s in %s mode", env.AppPort, env.AppEnv)
 	e.Logger.Fatal(e.Start(":8000"))
 }
 
 // routes sets up the API routes
-func routes(e *echo.Echo, repo repository.Repository) {
+func routes(e *echo.Echo, repo repository.Repository, notifier *asynq.Client, snapshots *statuspagecore.SnapshotCache) {
 	// Development-only routes
 	if config.Env().AppEnv == config.AppEnvDev {
 		// Swagger documentation route
@@ -60,13 +77,39 @@ func routes(e *echo.Echo, repo repository.Repository) {
 	router.TeamInviteRouter(api, repo)
 	router.InviteTokenRouter(api, repo)
 	router.RegionRouter(api, repo)
-	router.NotificationRouter(api, repo)
+	router.NotificationRouter(api, repo, notifier)
+	router.EscalationPolicyRouter(api, repo)
+	router.ScheduleRouter(api, repo)
 	router.MonitorRouter(api, repo)
-	router.IncidentRouter(api, repo)
+	router.IncidentRouter(api, repo, notifier)
+	router.IncidentActionRouter(api, repo)
+	router.IntegrationRouter(api, repo)
 	router.StatusPageRouter(api, repo)
-	router.PublicStatusPageRouter(api, repo)
+	router.MaintenanceRouter(api, repo, notifier)
+	router.ComponentOverrideRouter(api, repo)
+	router.SLORouter(api, repo)
+	router.PublicStatusPageRouter(api, repo, snapshots)
+	router.PublicMonitorBadgeRouter(api, repo)
 }
 
+// watchStatusPageChanges invalidates status page snapshots as changes are committed, reconnecting
+// until ctx is done. Changes missed while disconnected are covered by clearing every snapshot.
+func watchStatusPageChanges(ctx context.Context, repo repository.Repository, snapshots *statuspagecore.SnapshotCache) {
+	for {
+		err := repo.ListenStatusPageChanges(ctx, snapshots.Invalidate)
+		snapshots.Clear()
+		if ctx.Err() != nil {
+			return
+		}
+
+		zap.L().Warn("Status page change listener stopped, reconnecting", zap.Error(err))
+		time.Sleep(statusPageListenerRetryDelay)
+	}
+}
+
+// statusPageListenerRetryDelay spaces reconnects of the status page change listener.
+const statusPageListenerRetryDelay = 5 * time.Second
+
 func scalarDocsHandler() echo.HandlerFunc {
 	return func(c echo.Context) error {
 		html, err := scalar.ApiReferenceHTML(&scalar.Options{
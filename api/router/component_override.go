@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/componentoverride"
+	"github.com/yorukot/kymarium/api/middleware"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// ComponentOverrideRouter handles manual component status overrides of status pages.
+func ComponentOverrideRouter(api *echo.Group, repo repository.Repository) {
+	handler := &componentoverride.Handler{Repo: repo}
+
+	r := api.Group("/teams/:teamID/status-pages/:id/overrides", middleware.AuthRequiredMiddleware(repo))
+	r.POST("", handler.CreateComponentOverride)
+	r.GET("", handler.ListComponentOverrides)
+	r.DELETE("/:overrideID", handler.ClearComponentOverride)
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/escalationpolicy"
+	"github.com/yorukot/kymarium/api/middleware"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// EscalationPolicyRouter registers escalation policy routes.
+func EscalationPolicyRouter(api *echo.Group, repo repository.Repository) {
+	handler := &escalationpolicy.Handler{Repo: repo}
+
+	r := api.Group("/teams/:teamID/escalation-policies", middleware.AuthRequiredMiddleware(repo))
+	r.POST("", handler.CreateEscalationPolicy)
+	r.GET("", handler.ListEscalationPolicies)
+	r.GET("/:id", handler.GetEscalationPolicy)
+	r.PUT("/:id", handler.UpdateEscalationPolicy)
+	r.DELETE("/:id", handler.DeleteEscalationPolicy)
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/hibiken/asynq"
 	"github.com/labstack/echo/v4"
 	"github.com/yorukot/kymarium/api/handler/incident"
 	"github.com/yorukot/kymarium/api/middleware"
@@ -8,9 +9,10 @@ import (
 )
 
 // IncidentRouter handles incident-related routes.
-func IncidentRouter(api *echo.Group, repo repository.Repository) {
+func IncidentRouter(api *echo.Group, repo repository.Repository, notifier *asynq.Client) {
 	incidentHandler := &incident.Handler{
-		Repo: repo,
+		Repo:     repo,
+		Notifier: notifier,
 	}
 
 	// Monitor-scoped read/update for backwards compatibility
@@ -21,5 +23,16 @@ func IncidentRouter(api *echo.Group, repo repository.Repository) {
 	r.GET("/:incidentID/events", incidentHandler.ListIncidentEvents)
 	r.POST("/:incidentID/events", incidentHandler.CreateIncidentEvent)
 	r.POST("/:incidentID/status", incidentHandler.UpdateIncidentStatus)
+	r.POST("/:incidentID/acknowledge", incidentHandler.AcknowledgeIncident)
 	r.PATCH("/:incidentID", incidentHandler.UpdateIncident)
 }
+
+// IncidentActionRouter registers the public one-click incident action routes used by notification links.
+func IncidentActionRouter(api *echo.Group, repo repository.Repository) {
+	incidentHandler := &incident.Handler{
+		Repo: repo,
+	}
+
+	api.GET("/incident-actions", incidentHandler.ShowIncidentAction)
+	api.POST("/incident-actions", incidentHandler.PerformIncidentAction)
+}
@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/incident"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// IntegrationRouter registers callbacks from third-party apps. They authenticate with provider signatures instead of sessions.
+func IntegrationRouter(api *echo.Group, repo repository.Repository) {
+	incidentHandler := &incident.Handler{
+		Repo: repo,
+	}
+
+	r := api.Group("/integrations")
+	r.POST("/slack/interactions", incidentHandler.HandleSlackInteraction)
+	r.POST("/telegram/webhook", incidentHandler.HandleTelegramWebhook)
+}
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"github.com/hibiken/asynq"
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/maintenance"
+	"github.com/yorukot/kymarium/api/middleware"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// MaintenanceRouter handles scheduled maintenance routes of status pages.
+func MaintenanceRouter(api *echo.Group, repo repository.Repository, notifier *asynq.Client) {
+	maintenanceHandler := &maintenance.Handler{
+		Repo:     repo,
+		Notifier: notifier,
+	}
+
+	r := api.Group("/teams/:teamID/status-pages/:id/maintenances", middleware.AuthRequiredMiddleware(repo))
+	r.POST("", maintenanceHandler.CreateMaintenance)
+	r.GET("", maintenanceHandler.ListMaintenances)
+	r.GET("/:maintenanceID", maintenanceHandler.GetMaintenance)
+	r.PUT("/:maintenanceID", maintenanceHandler.UpdateMaintenance)
+	r.DELETE("/:maintenanceID", maintenanceHandler.DeleteMaintenance)
+	r.POST("/:maintenanceID/updates", maintenanceHandler.CreateMaintenanceUpdate)
+}
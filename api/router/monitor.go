@@ -20,4 +20,16 @@ func MonitorRouter(api *echo.Group, repo repository.Repository) {
 	r.PUT("/:id", monitorHandler.UpdateMonitor)
 	r.DELETE("/:id", monitorHandler.DeleteMonitor)
 	r.GET("/:id/analytics", monitorHandler.GetAnalytics)
+	r.GET("/:id/badge", monitorHandler.GetMonitorBadge)
+	r.POST("/:id/badge", monitorHandler.EnableMonitorBadge)
+	r.DELETE("/:id/badge", monitorHandler.DisableMonitorBadge)
+}
+
+// PublicMonitorBadgeRouter serves monitor badges to anyone holding the badge token.
+func PublicMonitorBadgeRouter(api *echo.Group, repo repository.Repository) {
+	monitorHandler := &monitor.Handler{
+		Repo: repo,
+	}
+
+	api.GET("/badges/monitors/:token/badge.svg", monitorHandler.GetMonitorBadgeSVG)
 }
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"github.com/hibiken/asynq"
 	"github.com/labstack/echo/v4"
 	"github.com/yorukot/kymarium/api/handler/notification"
 	"github.com/yorukot/kymarium/api/middleware"
@@ -8,9 +9,10 @@ import (
 )
 
 // NotificationRouter registers notification routes.
-func NotificationRouter(api *echo.Group, repo repository.Repository) {
+func NotificationRouter(api *echo.Group, repo repository.Repository, notifier *asynq.Client) {
 	notificationHandler := &notification.Handler{
-		Repo: repo,
+		Repo:     repo,
+		Notifier: notifier,
 	}
 	r := api.Group("/teams/:teamID/notifications", middleware.AuthRequiredMiddleware(repo))
 
@@ -20,4 +22,6 @@ func NotificationRouter(api *echo.Group, repo repository.Repository) {
 	r.PATCH("/:id", notificationHandler.UpdateNotification)
 	r.DELETE("/:id", notificationHandler.DeleteNotification)
 	r.POST("/:id/test", notificationHandler.TestNotification)
+	r.GET("/:id/deliveries", notificationHandler.ListDeliveries)
+	r.POST("/:id/deliveries/:deliveryID/resend", notificationHandler.ResendDelivery)
 }
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/schedule"
+	"github.com/yorukot/kymarium/api/middleware"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// ScheduleRouter registers on-call schedule routes.
+func ScheduleRouter(api *echo.Group, repo repository.Repository) {
+	handler := &schedule.Handler{Repo: repo}
+
+	r := api.Group("/teams/:teamID/schedules", middleware.AuthRequiredMiddleware(repo))
+	r.POST("", handler.CreateSchedule)
+	r.GET("", handler.ListSchedules)
+	r.GET("/:id", handler.GetSchedule)
+	r.PUT("/:id", handler.UpdateSchedule)
+	r.DELETE("/:id", handler.DeleteSchedule)
+	r.GET("/:id/oncall", handler.GetOnCall)
+	r.POST("/:id/overrides", handler.CreateOverride)
+	r.DELETE("/:id/overrides/:overrideID", handler.DeleteOverride)
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/yorukot/kymarium/api/handler/slo"
+	"github.com/yorukot/kymarium/api/middleware"
+	"github.com/yorukot/kymarium/repository"
+)
+
+// SLORouter registers SLO routes.
+func SLORouter(api *echo.Group, repo repository.Repository) {
+	handler := &slo.Handler{Repo: repo}
+
+	r := api.Group("/teams/:teamID/slos", middleware.AuthRequiredMiddleware(repo))
+	r.POST("", handler.CreateSLO)
+	r.GET("", handler.ListSLOs)
+	r.GET("/:id", handler.GetSLO)
+	r.PUT("/:id", handler.UpdateSLO)
+	r.DELETE("/:id", handler.DeleteSLO)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/yorukot/kymarium/api/handler/statuspage"
 	"github.com/yorukot/kymarium/api/middleware"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
 	"github.com/yorukot/kymarium/repository"
 )
 
@@ -17,10 +18,41 @@ func StatusPageRouter(api *echo.Group, repo repository.Repository) {
 	r.GET("/:id", handler.GetStatusPage)
 	r.PUT("/:id", handler.UpdateStatusPage)
 	r.DELETE("/:id", handler.DeleteStatusPage)
+	r.GET("/:id/subscribers", handler.ListStatusPageSubscribers)
+	r.DELETE("/:id/subscribers/:subscriberID", handler.DeleteStatusPageSubscriber)
+	r.GET("/:id/domain", handler.GetStatusPageDomain)
+	r.PUT("/:id/domain", handler.UpdateStatusPageDomain)
+	r.DELETE("/:id/domain", handler.DeleteStatusPageDomain)
+	r.POST("/:id/domain/verify", handler.VerifyStatusPageDomain)
 }
 
-// PublicStatusPageRouter handles public status page routes.
-func PublicStatusPageRouter(api *echo.Group, repo repository.Repository) {
-	handler := &statuspage.Handler{Repo: repo}
-	api.GET("/status-pages/:slug", handler.GetPublicStatusPage)
+// PublicStatusPageRouter handles public status page routes. Every route is served both by slug and,
+// under /status-page, by the request host for pages with a verified custom domain. Sessions are
+// optional here so team-only pages can recognize signed-in members. snapshots caches the public page view.
+func PublicStatusPageRouter(api *echo.Group, repo repository.Repository, snapshots *statuspagecore.SnapshotCache) {
+	handler := &statuspage.Handler{Repo: repo, Snapshots: snapshots}
+	publicStatusPageRoutes(api.Group("/status-pages/:slug", middleware.AuthOptionalMiddleware(repo)), handler)
+	publicStatusPageRoutes(api.Group("/status-page", middleware.AuthOptionalMiddleware(repo)), handler)
+}
+
+func publicStatusPageRoutes(r *echo.Group, handler *statuspage.Handler) {
+	r.GET("", handler.GetPublicStatusPage)
+	r.POST("/access", handler.UnlockStatusPage)
+	r.GET("/feed.rss", handler.GetStatusPageRSSFeed)
+	r.GET("/feed.atom", handler.GetStatusPageAtomFeed)
+	r.GET("/badge.svg", handler.GetStatusPageBadge)
+	r.GET("/history", handler.GetStatusPageHistory)
+	r.GET("/incidents/:id", handler.GetPublicIncident)
+
+	// Atlassian Statuspage v2 compatible endpoints for aggregators and dashboards.
+	r.GET("/api/v2/summary.json", handler.GetStatuspageV2Summary)
+	r.GET("/api/v2/status.json", handler.GetStatuspageV2Status)
+	r.GET("/api/v2/components.json", handler.GetStatuspageV2Components)
+	r.GET("/api/v2/incidents.json", handler.GetStatuspageV2Incidents)
+	r.GET("/api/v2/incidents/unresolved.json", handler.GetStatuspageV2UnresolvedIncidents)
+	r.POST("/subscribers", handler.SubscribeStatusPage)
+	r.GET("/subscribers/confirm", handler.ShowConfirmSubscription)
+	r.POST("/subscribers/confirm", handler.ConfirmSubscription)
+	r.GET("/subscribers/unsubscribe", handler.ShowUnsubscribe)
+	r.POST("/subscribers/unsubscribe", handler.Unsubscribe)
 }
@@ -22,4 +22,8 @@ func UserRouter(api *echo.Group, repo repository.Repository) {
 	r.GET("/me/sessions", userHandler.ListSessions)
 	r.POST("/me/sessions/:sessionID/revoke", userHandler.RevokeSession)
 	r.POST("/me/sessions/revoke-others", userHandler.RevokeOtherSessions)
+	r.GET("/me/contact-methods", userHandler.ListContactMethods)
+	r.POST("/me/contact-methods", userHandler.CreateContactMethod)
+	r.PUT("/me/contact-methods/:id", userHandler.UpdateContactMethod)
+	r.DELETE("/me/contact-methods/:id", userHandler.DeleteContactMethod)
 }
@@ -0,0 +1,301 @@
+// Package badge renders shields-style SVG status, uptime and response time badges.
+package badge
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// Metric selects what a badge shows.
+type Metric string
+
+// Metric values.
+const (
+	MetricStatus       Metric = "status"
+	MetricUptime       Metric = "uptime"
+	MetricResponseTime Metric = "response_time"
+)
+
+// Style selects the badge look, matching the shields.io style names.
+type Style string
+
+// Style values.
+const (
+	StyleFlat        Style = "flat"
+	StyleFlatSquare  Style = "flat-square"
+	StyleForTheBadge Style = "for-the-badge"
+)
+
+// Status values a status badge can show.
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Badge colors.
+const (
+	ColorBrightGreen = "#4c1"
+	ColorGreen       = "#97ca00"
+	ColorYellowGreen = "#a4a61d"
+	ColorYellow      = "#dfb317"
+	ColorOrange      = "#fe7d37"
+	ColorRed         = "#e05d44"
+	ColorGrey        = "#9f9f9f"
+	colorLabel       = "#555"
+)
+
+// ContentType is the content type of a rendered badge.
+const ContentType = "image/svg+xml; charset=utf-8"
+
+// MaxAge (seconds) keeps badges fresh while letting image proxies such as GitHub's camo cache them briefly.
+const MaxAge = "60"
+
+// maxLabelLength bounds a custom label so a badge stays a badge.
+const maxLabelLength = 64
+
+// Options are the query options of a badge request.
+type Options struct {
+	Metric Metric
+	Style  Style
+	// Period is the uptime or response time window in days: 30, 60 or 90.
+	Period int
+	Label  string
+}
+
+// ParseOptions reads metric, style, period and label from query values, applying defaults.
+func ParseOptions(values url.Values) (Options, error) {
+	opts := Options{Metric: MetricStatus, Style: StyleFlat, Period: 30}
+
+	switch metric := Metric(strings.TrimSpace(values.Get("metric"))); metric {
+	case "":
+	case MetricStatus, MetricUptime, MetricResponseTime:
+		opts.Metric = metric
+	default:
+		return Options{}, fmt.Errorf("metric must be one of status, uptime, response_time")
+	}
+
+	switch style := Style(strings.TrimSpace(values.Get("style"))); style {
+	case "":
+	case StyleFlat, StyleFlatSquare, StyleForTheBadge:
+		opts.Style = style
+	default:
+		return Options{}, fmt.Errorf("style must be one of flat, flat-square, for-the-badge")
+	}
+
+	if raw := strings.TrimSpace(values.Get("period")); raw != "" {
+		period, err := strconv.Atoi(strings.TrimSuffix(raw, "d"))
+		if err != nil || (period != 30 && period != 60 && period != 90) {
+			return Options{}, fmt.Errorf("period must be 30, 60 or 90")
+		}
+		opts.Period = period
+	}
+
+	opts.Label = strings.TrimSpace(values.Get("label"))
+	if utf8.RuneCountInString(opts.Label) > maxLabelLength {
+		return Options{}, fmt.Errorf("label must be at most %d characters", maxLabelLength)
+	}
+
+	return opts, nil
+}
+
+// Window returns the UTC day-aligned [start, end) range covering the last period days including today.
+func Window(period int, now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	return end.AddDate(0, 0, -period), end
+}
+
+// MonitorBadgeURL is the public badge URL of a monitor badge token on the API at base.
+func MonitorBadgeURL(base, token string) string {
+	return strings.TrimRight(base, "/") + "/api/badges/monitors/" + url.PathEscape(token) + "/badge.svg"
+}
+
+// Badge is a label and a colored message.
+type Badge struct {
+	Label   string
+	Message string
+	Color   string
+}
+
+// Status builds a status badge from StatusUp, StatusDegraded or StatusDown.
+func Status(opts Options, status string) Badge {
+	color := ColorGrey
+	switch status {
+	case StatusUp:
+		color = ColorBrightGreen
+	case StatusDegraded:
+		color = ColorYellow
+	case StatusDown:
+		color = ColorRed
+	}
+	return Badge{Label: labelOr(opts, "status"), Message: status, Color: color}
+}
+
+// Uptime builds an uptime badge from good and total check counts.
+func Uptime(opts Options, good, total int64) Badge {
+	b := Badge{Label: labelOr(opts, fmt.Sprintf("uptime %dd", opts.Period)), Message: "no data", Color: ColorGrey}
+	if total <= 0 {
+		return b
+	}
+
+	pct := float64(good) / float64(total) * 100
+	b.Message = formatPercentage(pct)
+	switch {
+	case pct >= 99.9:
+		b.Color = ColorBrightGreen
+	case pct >= 99:
+		b.Color = ColorGreen
+	case pct >= 97:
+		b.Color = ColorYellowGreen
+	case pct >= 95:
+		b.Color = ColorYellow
+	case pct >= 90:
+		b.Color = ColorOrange
+	default:
+		b.Color = ColorRed
+	}
+	return b
+}
+
+// CountChecks sums the good and total checks of daily summaries.
+func CountChecks(summaries []models.MonitorDailySummary) (good, total int64) {
+	for _, summary := range summaries {
+		good += summary.GoodCount
+		total += summary.TotalCount
+	}
+	return good, total
+}
+
+// ResponseTime builds an average response time badge; a nil latency means no data.
+func ResponseTime(opts Options, latencyMs *float64) Badge {
+	b := Badge{Label: labelOr(opts, fmt.Sprintf("response time %dd", opts.Period)), Message: "no data", Color: ColorGrey}
+	if latencyMs == nil {
+		return b
+	}
+
+	ms := *latencyMs
+	if ms < 1000 {
+		b.Message = fmt.Sprintf("%dms", int64(math.Round(ms)))
+	} else {
+		b.Message = strconv.FormatFloat(ms/1000, 'f', 2, 64) + "s"
+	}
+	switch {
+	case ms < 300:
+		b.Color = ColorBrightGreen
+	case ms < 800:
+		b.Color = ColorGreen
+	case ms < 1500:
+		b.Color = ColorYellow
+	case ms < 3000:
+		b.Color = ColorOrange
+	default:
+		b.Color = ColorRed
+	}
+	return b
+}
+
+func labelOr(opts Options, fallback string) string {
+	if opts.Label != "" {
+		return opts.Label
+	}
+	return fallback
+}
+
+func formatPercentage(pct float64) string {
+	if pct >= 100 {
+		return "100%"
+	}
+	// Truncate rather than round so 99.999% never shows as 100%.
+	return strconv.FormatFloat(math.Floor(pct*100)/100, 'f', 2, 64) + "%"
+}
+
+// Render returns the SVG of a badge in the given style.
+func Render(b Badge, style Style) []byte {
+	label, message := b.Label, b.Message
+	height, fontSize, padding, textY := 20, 11.0, 6.0, 14.0
+	letterSpacing := 0.0
+	if style == StyleForTheBadge {
+		label, message = strings.ToUpper(label), strings.ToUpper(message)
+		height, fontSize, padding, textY = 28, 10, 9, 18
+		letterSpacing = 1
+	}
+
+	labelWidth := math.Round(textWidth(label, fontSize, letterSpacing) + 2*padding)
+	messageWidth := math.Round(textWidth(message, fontSize, letterSpacing) + 2*padding)
+	width := labelWidth + messageWidth
+	title := html.EscapeString(b.Label + ": " + b.Message)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%g" height="%d" role="img" aria-label="%s">`, width, height, title)
+	fmt.Fprintf(&buf, `<title>%s</title>`, title)
+
+	radius := 3
+	if style != StyleFlat {
+		radius = 0
+	}
+	if style == StyleFlat {
+		buf.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	}
+	fmt.Fprintf(&buf, `<clipPath id="r"><rect width="%g" height="%d" rx="%d" fill="#fff"/></clipPath>`, width, height, radius)
+	fmt.Fprintf(&buf, `<g clip-path="url(#r)"><rect width="%g" height="%d" fill="%s"/><rect x="%g" width="%g" height="%d" fill="%s"/>`,
+		labelWidth, height, colorLabel, labelWidth, messageWidth, height, html.EscapeString(b.Color))
+	if style == StyleFlat {
+		fmt.Fprintf(&buf, `<rect width="%g" height="%d" fill="url(#s)"/>`, width, height)
+	}
+	buf.WriteString(`</g>`)
+
+	fmt.Fprintf(&buf, `<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="%g"`, fontSize)
+	if letterSpacing > 0 {
+		fmt.Fprintf(&buf, ` letter-spacing="%g" font-weight="bold"`, letterSpacing)
+	}
+	buf.WriteString(`>`)
+	writeText(&buf, label, labelWidth/2, textY, style)
+	writeText(&buf, message, labelWidth+messageWidth/2, textY, style)
+	buf.WriteString(`</g></svg>`)
+
+	return buf.Bytes()
+}
+
+func writeText(buf *bytes.Buffer, text string, x, y float64, style Style) {
+	escaped := html.EscapeString(text)
+	if style != StyleForTheBadge {
+		fmt.Fprintf(buf, `<text x="%g" y="%g" fill="#010101" fill-opacity=".3">%s</text>`, x, y+1, escaped)
+	}
+	fmt.Fprintf(buf, `<text x="%g" y="%g">%s</text>`, x, y, escaped)
+}
+
+// textWidth approximates the rendered width of text in Verdana, which badges are designed around.
+func textWidth(text string, fontSize, letterSpacing float64) float64 {
+	var em float64
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune("iIjl.,:;'!|", r):
+			em += 0.33
+		case strings.ContainsRune("frt()[] -", r):
+			em += 0.45
+		case strings.ContainsRune("mwMW%", r):
+			em += 0.95
+		case r >= 'A' && r <= 'Z':
+			em += 0.72
+		case r >= '0' && r <= '9':
+			em += 0.64
+		case r < utf8.RuneSelf:
+			em += 0.6
+		default:
+			// CJK and other wide scripts.
+			em += 1
+		}
+		em += letterSpacing / fontSize
+	}
+	return em * fontSize
+}
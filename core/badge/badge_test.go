@@ -0,0 +1,75 @@
+package badge
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptions(t *testing.T) {
+	opts, err := ParseOptions(url.Values{})
+	require.NoError(t, err)
+	assert.Equal(t, Options{Metric: MetricStatus, Style: StyleFlat, Period: 30}, opts)
+
+	opts, err = ParseOptions(url.Values{"metric": {"uptime"}, "period": {"90d"}, "style": {"for-the-badge"}, "label": {" api "}})
+	require.NoError(t, err)
+	assert.Equal(t, Options{Metric: MetricUptime, Style: StyleForTheBadge, Period: 90, Label: "api"}, opts)
+
+	for _, values := range []url.Values{
+		{"metric": {"latency"}},
+		{"period": {"7"}},
+		{"style": {"plastic"}},
+		{"label": {strings.Repeat("a", 65)}},
+	} {
+		_, err := ParseOptions(values)
+		assert.Error(t, err, values)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	start, end := Window(30, time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestUptime(t *testing.T) {
+	opts := Options{Period: 60}
+
+	b := Uptime(opts, 99999, 100000)
+	assert.Equal(t, "uptime 60d", b.Label)
+	assert.Equal(t, "99.99%", b.Message)
+	assert.Equal(t, ColorBrightGreen, b.Color)
+
+	assert.Equal(t, "100%", Uptime(opts, 10, 10).Message)
+	assert.Equal(t, ColorRed, Uptime(opts, 5, 10).Color)
+	assert.Equal(t, Badge{Label: "uptime 60d", Message: "no data", Color: ColorGrey}, Uptime(opts, 0, 0))
+	assert.Equal(t, "api", Uptime(Options{Period: 30, Label: "api"}, 1, 1).Label)
+}
+
+func TestResponseTime(t *testing.T) {
+	fast, slow := 123.4, 2345.0
+	assert.Equal(t, Badge{Label: "response time 30d", Message: "123ms", Color: ColorBrightGreen}, ResponseTime(Options{Period: 30}, &fast))
+	assert.Equal(t, "2.35s", ResponseTime(Options{Period: 30}, &slow).Message)
+	assert.Equal(t, "no data", ResponseTime(Options{Period: 30}, nil).Message)
+}
+
+func TestRender(t *testing.T) {
+	svg := string(Render(Badge{Label: "status", Message: "<up>", Color: ColorBrightGreen}, StyleFlat))
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+	assert.Contains(t, svg, `<title>status: &lt;up&gt;</title>`)
+	assert.Contains(t, svg, `fill="#4c1"`)
+	assert.Contains(t, svg, `rx="3"`)
+	assert.NotContains(t, svg, "<up>")
+
+	square := string(Render(Badge{Label: "status", Message: "up", Color: ColorBrightGreen}, StyleFlatSquare))
+	assert.Contains(t, square, `rx="0"`)
+	assert.NotContains(t, square, "linearGradient")
+
+	loud := string(Render(Badge{Label: "status", Message: "up", Color: ColorBrightGreen}, StyleForTheBadge))
+	assert.Contains(t, loud, `height="28"`)
+	assert.Contains(t, loud, ">STATUS</text>")
+}
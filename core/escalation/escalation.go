@@ -0,0 +1,57 @@
+// Package escalation decides how an open incident walks the levels of its escalation policy.
+package escalation
+
+import (
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// StopReason explains why an escalation chain ends without notifying a level.
+type StopReason string
+
+// StopReason values.
+const (
+	StopIncidentMissing StopReason = "incident_missing"
+	StopResolved        StopReason = "resolved"
+	StopAcknowledged    StopReason = "acknowledged"
+	StopPolicyDetached  StopReason = "policy_detached"
+	StopLevelMissing    StopReason = "level_missing"
+)
+
+// Step is the outcome of one escalation task. When Stop is empty, Level is notified now and, if Next is
+// non-zero, level Next is scheduled after NextDelay.
+type Step struct {
+	Stop      StopReason
+	Level     models.EscalationPolicyLevel
+	Total     int
+	Next      int
+	NextDelay time.Duration
+}
+
+// Decide returns what an escalation task for level (1-based) of policyID does, given the current incident,
+// its monitor and the current levels of the policy. Everything is re-read on each step, so acknowledging or
+// resolving the incident, detaching or swapping the policy, or removing levels stops the chain.
+func Decide(incident *models.Incident, monitor *models.Monitor, policyID int64, level int, levels []models.EscalationPolicyLevel) Step {
+	switch {
+	case incident == nil:
+		return Step{Stop: StopIncidentMissing}
+	case incident.Status == models.IncidentStatusResolved:
+		return Step{Stop: StopResolved}
+	case incident.AcknowledgedAt != nil:
+		return Step{Stop: StopAcknowledged}
+	case monitor == nil || monitor.EscalationPolicyID == nil || *monitor.EscalationPolicyID != policyID:
+		return Step{Stop: StopPolicyDetached}
+	case level < 1 || level > len(levels):
+		return Step{Stop: StopLevelMissing}
+	}
+
+	current := levels[level-1]
+	step := Step{Level: current, Total: len(levels)}
+	if level < len(levels) {
+		step.Next = level + 1
+		step.NextDelay = time.Duration(current.DelayMinutes) * time.Minute
+	}
+
+	return step
+}
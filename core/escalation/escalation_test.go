@@ -0,0 +1,140 @@
+package escalation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestDecide(t *testing.T) {
+	policyID := int64(5)
+	otherPolicyID := int64(6)
+	acknowledgedAt := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+
+	open := &models.Incident{ID: 1, Status: models.IncidentStatusDetected}
+	monitor := &models.Monitor{ID: 10, EscalationPolicyID: &policyID}
+	levels := []models.EscalationPolicyLevel{
+		{ID: 101, PolicyID: policyID, Position: 1, DelayMinutes: 5, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeNotification, ID: 1}}},
+		{ID: 102, PolicyID: policyID, Position: 2, DelayMinutes: 15, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeUser, ID: 2}}},
+		{ID: 103, PolicyID: policyID, Position: 3, DelayMinutes: 30, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeSchedule, ID: 3}}},
+	}
+
+	tests := []struct {
+		name     string
+		incident *models.Incident
+		monitor  *models.Monitor
+		level    int
+		levels   []models.EscalationPolicyLevel
+		want     Step
+	}{
+		{
+			name:     "first level schedules the next after its delay",
+			incident: open,
+			monitor:  monitor,
+			level:    1,
+			levels:   levels,
+			want:     Step{Level: levels[0], Total: 3, Next: 2, NextDelay: 5 * time.Minute},
+		},
+		{
+			name:     "middle level advances with its own delay",
+			incident: open,
+			monitor:  monitor,
+			level:    2,
+			levels:   levels,
+			want:     Step{Level: levels[1], Total: 3, Next: 3, NextDelay: 15 * time.Minute},
+		},
+		{
+			name:     "last level notifies without scheduling more",
+			incident: open,
+			monitor:  monitor,
+			level:    3,
+			levels:   levels,
+			want:     Step{Level: levels[2], Total: 3},
+		},
+		{
+			name:     "acknowledged incident stops",
+			incident: &models.Incident{ID: 1, Status: models.IncidentStatusInvestigating, AcknowledgedAt: &acknowledgedAt},
+			monitor:  monitor,
+			level:    2,
+			levels:   levels,
+			want:     Step{Stop: StopAcknowledged},
+		},
+		{
+			name:     "resolved incident stops",
+			incident: &models.Incident{ID: 1, Status: models.IncidentStatusResolved},
+			monitor:  monitor,
+			level:    2,
+			levels:   levels,
+			want:     Step{Stop: StopResolved},
+		},
+		{
+			name:    "deleted incident stops",
+			monitor: monitor,
+			level:   1,
+			levels:  levels,
+			want:    Step{Stop: StopIncidentMissing},
+		},
+		{
+			name:     "policy detached from the monitor stops",
+			incident: open,
+			monitor:  &models.Monitor{ID: 10},
+			level:    2,
+			levels:   levels,
+			want:     Step{Stop: StopPolicyDetached},
+		},
+		{
+			name:     "policy swapped for another stops",
+			incident: open,
+			monitor:  &models.Monitor{ID: 10, EscalationPolicyID: &otherPolicyID},
+			level:    2,
+			levels:   levels,
+			want:     Step{Stop: StopPolicyDetached},
+		},
+		{
+			name:     "deleted monitor stops",
+			incident: open,
+			level:    2,
+			levels:   levels,
+			want:     Step{Stop: StopPolicyDetached},
+		},
+		{
+			name:     "deleted policy has no levels left",
+			incident: open,
+			monitor:  monitor,
+			level:    1,
+			want:     Step{Stop: StopLevelMissing},
+		},
+		{
+			name:     "levels removed from the policy mid-escalation",
+			incident: open,
+			monitor:  monitor,
+			level:    3,
+			levels:   levels[:2],
+			want:     Step{Stop: StopLevelMissing},
+		},
+		{
+			name:     "edited policy uses the current delay",
+			incident: open,
+			monitor:  monitor,
+			level:    1,
+			levels:   []models.EscalationPolicyLevel{{ID: 101, PolicyID: policyID, Position: 1, DelayMinutes: 1}, levels[1]},
+			want:     Step{Level: models.EscalationPolicyLevel{ID: 101, PolicyID: policyID, Position: 1, DelayMinutes: 1}, Total: 2, Next: 2, NextDelay: time.Minute},
+		},
+		{
+			name:     "invalid level stops",
+			incident: open,
+			monitor:  monitor,
+			level:    0,
+			levels:   levels,
+			want:     Step{Stop: StopLevelMissing},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Decide(tt.incident, tt.monitor, policyID, tt.level, tt.levels))
+		})
+	}
+}
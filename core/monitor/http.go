@@ -76,13 +76,30 @@ func RunHTTP(ctx context.Context, baseClient *http.Client, monitor models.Monito
 	}
 
 	return &Result{
-		Success:  success,
-		Duration: duration,
-		Status:   status,
-		Message:  message,
+		Success:       success,
+		Duration:      duration,
+		Status:        status,
+		Message:       message,
+		CertExpiresAt: certExpiresAt(resp),
 	}, nil
 }
 
+// CertExpiryWarning is how long before expiry a monitor with certificate expiry notifications warns.
+const CertExpiryWarning = 14 * 24 * time.Hour
+
+// CertExpiresSoon reports whether a certificate expiring at notAfter is within CertExpiryWarning of now.
+func CertExpiresSoon(notAfter, now time.Time) bool {
+	return !notAfter.IsZero() && notAfter.Sub(now) <= CertExpiryWarning
+}
+
+// certExpiresAt returns the expiry of the leaf certificate the server presented, or zero without TLS.
+func certExpiresAt(resp *http.Response) time.Time {
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return time.Time{}
+	}
+	return resp.TLS.PeerCertificates[0].NotAfter.UTC()
+}
+
 func prepareHTTPClient(base *http.Client, cfg *monitorm.HTTPMonitorConfig) *http.Client {
 	client := *base
 
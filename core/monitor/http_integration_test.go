@@ -198,4 +198,25 @@ func TestRunHTTP_HTTPS(t *testing.T) {
 	if !res.Success {
 		t.Fatalf("expected success=true, got %v", res.Success)
 	}
+
+	if want := server.Certificate().NotAfter.UTC(); !res.CertExpiresAt.Equal(want) {
+		t.Fatalf("expected certificate expiry %s, got %s", want, res.CertExpiresAt)
+	}
+}
+
+func TestCertExpiresSoon(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if CertExpiresSoon(time.Time{}, now) {
+		t.Fatalf("expected no warning without a certificate")
+	}
+	if CertExpiresSoon(now.Add(CertExpiryWarning+time.Hour), now) {
+		t.Fatalf("expected no warning outside the warning window")
+	}
+	if !CertExpiresSoon(now.Add(CertExpiryWarning), now) {
+		t.Fatalf("expected a warning at the edge of the warning window")
+	}
+	if !CertExpiresSoon(now.Add(-time.Hour), now) {
+		t.Fatalf("expected a warning for an expired certificate")
+	}
 }
@@ -15,6 +15,8 @@ type Result struct {
 	Duration time.Duration
 	Status   models.PingStatus
 	Message  string
+	// CertExpiresAt is when the server's TLS certificate expires; zero for plain HTTP and non-HTTP monitors.
+	CertExpiresAt time.Time
 }
 
 // Run executes a monitor using the default HTTP client.
@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/encrypt"
+)
+
+// incidentActionTTL bounds how long one-click links embedded in notifications stay valid.
+const incidentActionTTL = 7 * 24 * time.Hour
+
+// IncidentActionURL builds a signed one-click link that performs an action on an incident.
+func IncidentActionURL(teamID, incidentID int64, action models.IncidentAction) (string, error) {
+	base := strings.TrimSpace(config.Env().BackendURL)
+	if base == "" {
+		return "", fmt.Errorf("missing backend url")
+	}
+
+	secret := encrypt.JWTSecret{
+		Secret: config.Env().JWTSecretKey,
+	}
+
+	token, err := secret.GenerateIncidentActionToken(teamID, incidentID, string(action), time.Now().Add(incidentActionTTL))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(base, "/") + "/api/incident-actions?token=" + url.QueryEscape(token), nil
+}
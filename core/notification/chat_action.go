@@ -0,0 +1,42 @@
+package notification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// chatActionMACSize is the number of HMAC bytes kept in button data. Telegram limits callback data
+// to 64 bytes, so the tag is truncated; 96 bits still cannot be guessed.
+const chatActionMACSize = 12
+
+// signChatAction appends a MAC of data to it. Chat buttons carry the IDs of the incident they act on,
+// and anyone able to post a message can make a button, so the IDs are only trusted when signed.
+func signChatAction(secret, data string) string {
+	return data + ":" + chatActionMAC(secret, data)
+}
+
+// verifyChatAction checks a value produced by signChatAction and returns the signed data.
+func verifyChatAction(secret, value string) (string, bool) {
+	data, tag, ok := cutLast(value, ":")
+	if !ok || secret == "" {
+		return "", false
+	}
+
+	return data, hmac.Equal([]byte(tag), []byte(chatActionMAC(secret, data)))
+}
+
+func chatActionMAC(secret, data string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("chat-action:" + data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:chatActionMACSize])
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
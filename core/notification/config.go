@@ -8,8 +8,8 @@ import (
 	"github.com/yorukot/kymarium/models"
 )
 
-// ValidateNotificationConfig validates the config JSON for a notification type.
-func validateNotificationConfig(notificationType models.NotificationType, raw json.RawMessage) error {
+// ValidateConfig validates the config JSON for a notification type.
+func ValidateConfig(notificationType models.NotificationType, raw json.RawMessage) error {
 	if len(raw) == 0 {
 		return fmt.Errorf("notification config is required")
 	}
@@ -41,6 +41,12 @@ func validateNotificationConfig(notificationType models.NotificationType, raw js
 			return fmt.Errorf("decode email notification config: %w", err)
 		}
 		return v.Struct(cfg)
+	case models.NotificationTypeOnCall:
+		var cfg models.OnCallNotificationConfig
+		if err := json.Unmarshal(raw, &cfg); err != nil {
+			return fmt.Errorf("decode oncall notification config: %w", err)
+		}
+		return v.Struct(cfg)
 	default:
 		return fmt.Errorf("unsupported notification type %q", notificationType)
 	}
@@ -0,0 +1,89 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// maxDigestMonitorNames caps how many monitor names are listed per event type in a digest.
+const maxDigestMonitorNames = 10
+
+// digestEventLabels lists event types in the order they appear in a digest, with their wording.
+var digestEventLabels = []struct {
+	eventType models.NotificationEventType
+	label     string
+}{
+	{models.NotificationEventTypeDown, "down"},
+	{models.NotificationEventTypeRecovered, "recovered"},
+	{models.NotificationEventTypeCertExpiring, "with expiring certificates"},
+	{models.NotificationEventTypeManualStatusUpdate, "manually updated"},
+}
+
+// FormatDigest summarises held events into a single title and description, e.g. "23 monitors down, 2 recovered".
+// Monitors are counted once per event type; the returned status is failed when anything is down.
+// quietHours selects the explanation appended to the description.
+func FormatDigest(events []models.NotificationEvent, quietHours bool) (string, string, models.PingStatus) {
+	names := make(map[models.NotificationEventType][]string)
+	seen := make(map[models.NotificationEventType]map[int64]bool)
+	for _, event := range events {
+		if seen[event.EventType] == nil {
+			seen[event.EventType] = make(map[int64]bool)
+		}
+		if seen[event.EventType][event.MonitorID] {
+			continue
+		}
+		seen[event.EventType][event.MonitorID] = true
+		names[event.EventType] = append(names[event.EventType], event.MonitorName)
+	}
+
+	var summary []string
+	var builder strings.Builder
+	for _, entry := range digestEventLabels {
+		monitors := names[entry.eventType]
+		if len(monitors) == 0 {
+			continue
+		}
+
+		noun := "monitors"
+		if len(monitors) == 1 {
+			noun = "monitor"
+		}
+		if len(summary) == 0 {
+			summary = append(summary, fmt.Sprintf("%d %s %s", len(monitors), noun, entry.label))
+		} else {
+			summary = append(summary, fmt.Sprintf("%d %s", len(monitors), entry.label))
+		}
+
+		listed := monitors
+		if len(listed) > maxDigestMonitorNames {
+			listed = listed[:maxDigestMonitorNames]
+		}
+		builder.WriteString(fmt.Sprintf("%s (%d): %s", capitalize(entry.label), len(monitors), strings.Join(listed, ", ")))
+		if hidden := len(monitors) - len(listed); hidden > 0 {
+			builder.WriteString(fmt.Sprintf(" and %d more", hidden))
+		}
+		builder.WriteString("\n")
+	}
+
+	if quietHours {
+		builder.WriteString(fmt.Sprintf("\n%d events were held during quiet hours.", len(events)))
+	} else {
+		builder.WriteString(fmt.Sprintf("\nThis channel is receiving too many events, so %d events were batched into this digest.", len(events)))
+	}
+
+	status := models.PingStatusSuccessful
+	if len(names[models.NotificationEventTypeDown]) > 0 {
+		status = models.PingStatusFailed
+	}
+
+	return strings.Join(summary, ", "), strings.TrimSpace(builder.String()), status
+}
+
+func capitalize(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToUpper(value[:1]) + value[1:]
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestFormatDigest_GroupsByEventType(t *testing.T) {
+	var events []models.NotificationEvent
+	for i := 1; i <= 12; i++ {
+		events = append(events, models.NotificationEvent{
+			MonitorID:   int64(i),
+			MonitorName: fmt.Sprintf("api-%d", i),
+			EventType:   models.NotificationEventTypeDown,
+		})
+	}
+	// A repeated event for the same monitor is only counted once.
+	events = append(events,
+		models.NotificationEvent{MonitorID: 1, MonitorName: "api-1", EventType: models.NotificationEventTypeDown},
+		models.NotificationEvent{MonitorID: 20, MonitorName: "web", EventType: models.NotificationEventTypeRecovered},
+	)
+
+	title, description, status := FormatDigest(events, false)
+	require.Equal(t, "12 monitors down, 1 recovered", title)
+	require.Contains(t, description, "Down (12): api-1, api-2, api-3, api-4, api-5, api-6, api-7, api-8, api-9, api-10 and 2 more")
+	require.Contains(t, description, "Recovered (1): web")
+	require.Contains(t, description, "14 events were batched")
+	require.Equal(t, models.PingStatusFailed, status)
+}
+
+func TestFormatDigest_RecoveredOnly(t *testing.T) {
+	title, description, status := FormatDigest([]models.NotificationEvent{
+		{MonitorID: 1, MonitorName: "web", EventType: models.NotificationEventTypeRecovered},
+	}, true)
+	require.Equal(t, "1 monitor recovered", title)
+	require.Contains(t, description, "held during quiet hours")
+	require.Equal(t, models.PingStatusSuccessful, status)
+}
@@ -12,14 +12,14 @@ import (
 	"github.com/yorukot/kymarium/models"
 )
 
-func sendDiscord(ctx context.Context, client *http.Client, notification models.Notification, title, description string, status models.PingStatus) error {
+func sendDiscord(ctx context.Context, client *http.Client, notification models.Notification, title, description string, status models.PingStatus) (Result, error) {
 	var cfg models.DiscordNotificationConfig
 	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
-		return fmt.Errorf("decode discord config: %w", err)
+		return Result{}, fmt.Errorf("decode discord config: %w", err)
 	}
 
 	if cfg.WebhookURL == "" {
-		return errors.New("discord webhook_url is required")
+		return Result{}, errors.New("discord webhook_url is required")
 	}
 
 	// Discord doesn't allow usernames containing "discord" (case-insensitive)
@@ -1,39 +1,215 @@
 package notification
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"html/template"
+	"net/mail"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/yorukot/kymarium/models"
 	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/utils/mailer"
 )
 
-func sendEmail(ctx context.Context, _ *http.Client, notification models.Notification, title, description string, _ models.PingStatus) error {
-	_ = ctx
+var (
+	emailPool     *mailer.Pool
+	emailPoolOnce sync.Once
+	emailPoolErr  error
+)
+
+// defaultEmailPool lazily creates the shared SMTP pool from the environment.
+func defaultEmailPool() (*mailer.Pool, error) {
+	emailPoolOnce.Do(func() {
+		env := config.Env()
+		if !env.SMTPEnabled {
+			emailPoolErr = fmt.Errorf("smtp is disabled")
+			return
+		}
+
+		host := strings.TrimSpace(env.SMTPHost)
+		port := strings.TrimSpace(env.SMTPPort)
+		if host == "" || port == "" {
+			emailPoolErr = fmt.Errorf("missing SMTP host or port")
+			return
+		}
+
+		emailPool = mailer.NewPool(mailer.Config{
+			Host:     host,
+			Port:     port,
+			Username: strings.TrimSpace(env.SMTPUsername),
+			Password: env.SMTPPassword,
+			TLSMode:  mailer.TLSMode(env.SMTPTLSMode),
+			PoolSize: env.SMTPPoolSize,
+		})
+	})
+	return emailPool, emailPoolErr
+}
+
+// SendMail delivers a prepared email through the shared SMTP pool, for mail that is not tied to a notification channel.
+func SendMail(ctx context.Context, email mailer.Message) error {
+	pool, err := defaultEmailPool()
+	if err != nil {
+		return err
+	}
+
+	if email.From == "" {
+		email.From = strings.TrimSpace(config.Env().SMTPFrom)
+	}
+	if email.From == "" {
+		return fmt.Errorf("missing SMTPFrom")
+	}
+
+	return pool.SendMessage(ctx, email)
+}
+
+func sendEmail(ctx context.Context, notification models.Notification, msg Message) (Result, error) {
+	pool, err := defaultEmailPool()
+	if err != nil {
+		return Result{}, err
+	}
 
+	return sendEmailWithPool(ctx, pool, strings.TrimSpace(config.Env().SMTPFrom), notification, msg)
+}
+
+func sendEmailWithPool(ctx context.Context, pool *mailer.Pool, from string, notification models.Notification, msg Message) (Result, error) {
 	var cfg models.EmailNotificationConfig
 	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
-		return fmt.Errorf("decode email config: %w", err)
+		return Result{}, fmt.Errorf("decode email config: %w", err)
+	}
+
+	if len(cfg.EmailAddress) == 0 {
+		return Result{}, fmt.Errorf("email_address is required")
+	}
+
+	if from == "" {
+		return Result{}, fmt.Errorf("missing SMTPFrom")
+	}
+
+	email, err := buildEmail(from, cfg.EmailAddress, msg)
+	if err != nil {
+		return Result{}, err
 	}
 
-	to := cfg.EmailAddress[0]
-	var bcc []string
-	if len(cfg.EmailAddress) > 1 {
-		bcc = cfg.EmailAddress[1:]
+	if err := pool.SendMessage(ctx, email); err != nil {
+		return Result{}, err
 	}
 
-	subject := strings.TrimSpace(title)
+	return Result{Body: "accepted for " + strings.Join(email.Recipients(), ", ")}, nil
+}
+
+// buildEmail renders the branded HTML and plain text bodies. Messages about an incident thread together:
+// the opening email carries the incident's root Message-ID and every later one replies to it.
+func buildEmail(from string, addresses []string, msg Message) (mailer.Message, error) {
+	subject := strings.TrimSpace(msg.Title)
 	if subject == "" {
 		subject = "Kymarium notification"
 	}
 
-	body := strings.TrimSpace(description)
-	if body == "" {
-		body = "No additional details provided."
+	description := strings.TrimSpace(msg.Description)
+	if description == "" {
+		description = "No additional details provided."
+	}
+
+	var html bytes.Buffer
+	if err := emailTemplate.Execute(&html, emailTemplateData{
+		Title:       subject,
+		Lines:       strings.Split(description, "\n"),
+		Color:       statusColorHex(msg.Status),
+		MonitorURL:  msg.MonitorURL,
+		IncidentURL: msg.IncidentURL,
+	}); err != nil {
+		return mailer.Message{}, fmt.Errorf("render email: %w", err)
+	}
+
+	text := description
+	if msg.IncidentURL != "" {
+		text += "\n\nView incident: " + msg.IncidentURL
+	}
+	if msg.MonitorURL != "" {
+		text += "\nView monitor: " + msg.MonitorURL
+	}
+
+	email := mailer.Message{
+		From:    from,
+		To:      addresses[:1],
+		Bcc:     addresses[1:],
+		Subject: subject,
+		Text:    text,
+		HTML:    html.String(),
+		Date:    time.Now(),
+	}
+
+	domain := messageIDDomain(from)
+	if msg.IncidentID != 0 {
+		root := fmt.Sprintf("<incident-%d@%s>", msg.IncidentID, domain)
+		if msg.EventType == models.NotificationEventTypeDown && !msg.FollowUp {
+			email.MessageID = root
+			return email, nil
+		}
+		email.InReplyTo = root
+		email.References = []string{root}
 	}
 
-	return config.SendEmail(to, nil, bcc, subject, body)
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return mailer.Message{}, err
+	}
+	email.MessageID = fmt.Sprintf("<notification-%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(nonce), domain)
+
+	return email, nil
+}
+
+func messageIDDomain(from string) string {
+	addr, err := mail.ParseAddress(from)
+	if err == nil {
+		if at := strings.LastIndex(addr.Address, "@"); at >= 0 && at < len(addr.Address)-1 {
+			return addr.Address[at+1:]
+		}
+	}
+	return "kymarium.local"
 }
+
+func statusColorHex(status models.PingStatus) string {
+	switch status {
+	case models.PingStatusSuccessful:
+		return "#16a34a"
+	case models.PingStatusFailed, models.PingStatusTimeout:
+		return "#dc2626"
+	default:
+		return "#6b7280"
+	}
+}
+
+type emailTemplateData struct {
+	Title       string
+	Lines       []string
+	Color       string
+	MonitorURL  string
+	IncidentURL string
+}
+
+var emailTemplate = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f4f4f5;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;color:#18181b;">
+  <table role="presentation" width="100%" cellpadding="0" cellspacing="0" style="max-width:600px;margin:0 auto;background:#ffffff;border-radius:8px;overflow:hidden;">
+    <tr><td style="background:{{.Color}};height:6px;"></td></tr>
+    <tr><td style="padding:24px 24px 0;font-size:13px;font-weight:600;letter-spacing:0.04em;text-transform:uppercase;color:#71717a;">Kymarium</td></tr>
+    <tr><td style="padding:8px 24px 16px;font-size:20px;font-weight:600;color:{{.Color}};">{{.Title}}</td></tr>
+    <tr><td style="padding:0 24px 16px;font-size:14px;line-height:1.6;">
+      {{range .Lines}}{{if .}}{{.}}<br>{{else}}<br>{{end}}{{end}}
+    </td></tr>
+    {{if or .IncidentURL .MonitorURL}}<tr><td style="padding:8px 24px 24px;">
+      {{if .IncidentURL}}<a href="{{.IncidentURL}}" style="display:inline-block;padding:10px 16px;margin-right:8px;background:{{.Color}};color:#ffffff;text-decoration:none;border-radius:6px;font-size:14px;">View incident</a>{{end}}
+      {{if .MonitorURL}}<a href="{{.MonitorURL}}" style="display:inline-block;padding:10px 16px;border:1px solid #d4d4d8;color:#18181b;text-decoration:none;border-radius:6px;font-size:14px;">View monitor</a>{{end}}
+    </td></tr>{{end}}
+  </table>
+</body>
+</html>
+`))
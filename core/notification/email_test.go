@@ -0,0 +1,58 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestBuildEmail_ThreadsIncidentMessages(t *testing.T) {
+	addresses := []string{"ops@example.com", "lead@example.com"}
+	msg := Message{
+		Title:       "api is FAILED",
+		Description: "Monitor: api\nStatus: FAILED",
+		Status:      models.PingStatusFailed,
+		IncidentID:  42,
+		EventType:   models.NotificationEventTypeDown,
+		MonitorURL:  "https://app/1/monitors/2",
+		IncidentURL: "https://app/1/incidents/42",
+	}
+
+	opened, err := buildEmail("Kymarium <alerts@status.example.com>", addresses, msg)
+	require.NoError(t, err)
+	require.Equal(t, "<incident-42@status.example.com>", opened.MessageID)
+	require.Empty(t, opened.InReplyTo)
+	require.Equal(t, []string{"ops@example.com"}, opened.To)
+	require.Equal(t, []string{"lead@example.com"}, opened.Bcc)
+	require.Contains(t, opened.HTML, "#dc2626")
+	require.Contains(t, opened.HTML, `href="https://app/1/incidents/42"`)
+	require.Contains(t, opened.Text, "View monitor: https://app/1/monitors/2")
+
+	msg.EventType = models.NotificationEventTypeRecovered
+	msg.Status = models.PingStatusSuccessful
+	resolved, err := buildEmail("alerts@status.example.com", addresses, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, opened.MessageID, resolved.MessageID)
+	require.Equal(t, opened.MessageID, resolved.InReplyTo)
+	require.Equal(t, []string{opened.MessageID}, resolved.References)
+	require.Contains(t, resolved.HTML, "#16a34a")
+
+	msg.EventType = models.NotificationEventTypeDown
+	msg.FollowUp = true
+	reminder, err := buildEmail("alerts@status.example.com", addresses, msg)
+	require.NoError(t, err)
+	require.NotEqual(t, opened.MessageID, reminder.MessageID)
+	require.Equal(t, opened.MessageID, reminder.InReplyTo)
+}
+
+func TestBuildEmail_EscapesContent(t *testing.T) {
+	email, err := buildEmail("alerts@example.com", []string{"ops@example.com"}, Message{
+		Title:       "<script>alert(1)</script>",
+		Description: "Details: <b>oops</b>",
+	})
+	require.NoError(t, err)
+	require.NotContains(t, email.HTML, "<script>")
+	require.NotContains(t, email.HTML, "<b>oops</b>")
+	require.Empty(t, email.InReplyTo)
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+// MonitorURL links to a monitor in the dashboard, or returns an empty string when no frontend is configured.
+func MonitorURL(teamID, monitorID int64) string {
+	if teamID == 0 || monitorID == 0 {
+		return ""
+	}
+	return frontendURL("/" + strconv.FormatInt(teamID, 10) + "/monitors/" + strconv.FormatInt(monitorID, 10))
+}
+
+// IncidentURL links to an incident in the dashboard, or returns an empty string when no frontend is configured.
+func IncidentURL(teamID, incidentID int64) string {
+	if teamID == 0 || incidentID == 0 {
+		return ""
+	}
+	return frontendURL("/" + strconv.FormatInt(teamID, 10) + "/incidents/" + strconv.FormatInt(incidentID, 10))
+}
+
+func frontendURL(path string) string {
+	base := strings.TrimSpace(config.Env().FrontendDomain)
+	if base == "" {
+		return ""
+	}
+
+	if !strings.HasPrefix(base, "http://") && !strings.HasPrefix(base, "https://") {
+		base = "http://" + base
+	}
+
+	return strings.TrimRight(base, "/") + path
+}
@@ -17,6 +17,7 @@ type MessageInput struct {
 	LatencyMs   int
 	CheckedAt   time.Time
 	Detail      string
+	AckURL      string
 }
 
 // FormatMessage generates a title and description for a notification.
@@ -45,6 +46,39 @@ func FormatMessage(input MessageInput) (string, string) {
 		builder.WriteString(fmt.Sprintf("\n\nDetails: %s", detail))
 	}
 
+	if input.AckURL != "" {
+		builder.WriteString(fmt.Sprintf("\n\nAcknowledge: %s", input.AckURL))
+	}
+
+	return title, strings.TrimSpace(builder.String())
+}
+
+// FormatIncidentUpdate generates a title and description for an incident update posted by a team member.
+func FormatIncidentUpdate(input MessageInput) (string, string) {
+	title := fmt.Sprintf("Incident update: %s", input.MonitorName)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Monitor: %s\n", input.MonitorName))
+	if detail := strings.TrimSpace(input.Detail); detail != "" {
+		builder.WriteString(fmt.Sprintf("\n%s", detail))
+	}
+
+	return title, strings.TrimSpace(builder.String())
+}
+
+// FormatCertExpiry generates a title and description for a certificate that is about to expire.
+func FormatCertExpiry(input MessageInput) (string, string) {
+	title := fmt.Sprintf("%s certificate expires soon", input.MonitorName)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("Monitor: %s\n", input.MonitorName))
+	if input.RegionName != "" {
+		builder.WriteString(fmt.Sprintf("Region: %s\n", input.RegionName))
+	}
+	if detail := strings.TrimSpace(input.Detail); detail != "" {
+		builder.WriteString(fmt.Sprintf("\n%s", detail))
+	}
+
 	return title, strings.TrimSpace(builder.String())
 }
 
@@ -12,6 +12,37 @@ import (
 	"github.com/yorukot/kymarium/models"
 )
 
+// Result captures the provider response for a single delivery attempt.
+// MessageID identifies the sent message at the provider when it can be edited later (e.g. Slack "channel:ts").
+type Result struct {
+	StatusCode int
+	Body       string
+	MessageID  string
+}
+
+// Message is the content of a notification together with the context it was sent for.
+// Only Title, Description and Status are required; the remaining fields let richer channels
+// such as email link to the monitor and incident and thread related messages.
+type Message struct {
+	Title       string
+	Description string
+	Status      models.PingStatus
+	TeamID      int64
+	MonitorID   int64
+	MonitorName string
+	IncidentID  int64
+	EventType   models.NotificationEventType
+	// FollowUp marks messages sent after the one that opened the incident, e.g. reminders and escalations.
+	FollowUp     bool
+	Acknowledged bool
+	// Muted marks incidents whose follow-ups were silenced for the channel; chat buttons hide the mute action.
+	Muted       bool
+	MonitorURL  string
+	IncidentURL string
+	// ReplaceMessageID is the provider message ID of an earlier message to edit in place instead of posting anew.
+	ReplaceMessageID string
+}
+
 // Send dispatches a notification using the provided notification model.
 // Title and description are sent to the configured channel depending on the notification type.
 func Send(ctx context.Context, notification models.Notification, title, description string, status models.PingStatus) error {
@@ -20,46 +51,62 @@ func Send(ctx context.Context, notification models.Notification, title, descript
 
 // SendWithClient allows injecting a custom HTTP client (useful for tests) while sending the notification.
 func SendWithClient(ctx context.Context, client *http.Client, notification models.Notification, title, description string, status models.PingStatus) error {
+	_, err := Deliver(ctx, client, notification, title, description, status)
+	return err
+}
+
+// Deliver sends the notification and reports the provider response so callers can log the attempt.
+func Deliver(ctx context.Context, client *http.Client, notification models.Notification, title, description string, status models.PingStatus) (Result, error) {
+	return DeliverMessage(ctx, client, notification, Message{Title: title, Description: description, Status: status})
+}
+
+// DeliverMessage is Deliver with the full message context.
+func DeliverMessage(ctx context.Context, client *http.Client, notification models.Notification, msg Message) (Result, error) {
 	if client == nil {
 		client = http.DefaultClient
 	}
 
 	switch notification.Type {
 	case models.NotificationTypeDiscord:
-		return sendDiscord(ctx, client, notification, title, description, status)
+		return sendDiscord(ctx, client, notification, msg.Title, msg.Description, msg.Status)
 	case models.NotificationTypeSlack:
-		return sendSlack(ctx, client, notification, title, description, status)
+		return sendSlack(ctx, client, notification, msg)
 	case models.NotificationTypeTelegram:
-		return sendTelegram(ctx, client, notification, title, description, status)
+		return sendTelegram(ctx, client, notification, msg)
 	case models.NotificationTypeEmail:
-		return sendEmail(ctx, client, notification, title, description, status)
+		return sendEmail(ctx, notification, msg)
 	default:
-		return fmt.Errorf("unsupported notification type %q", notification.Type)
+		return Result{}, fmt.Errorf("unsupported notification type %q", notification.Type)
 	}
 }
 
-func postJSON(ctx context.Context, client *http.Client, url string, payload any) error {
+func postJSON(ctx context.Context, client *http.Client, url string, payload any) (Result, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("marshal payload: %w", err)
+		return Result{}, fmt.Errorf("marshal payload: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return Result{}, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("send request: %w", err)
+		return Result{}, fmt.Errorf("send request: %w", err)
 	}
 	defer resp.Body.Close()
 
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	result := Result{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(respBody)),
+	}
+
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		return nil
+		return result, nil
 	}
 
-	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
-	return fmt.Errorf("unexpected status %d from %s: %s", resp.StatusCode, url, strings.TrimSpace(string(respBody)))
+	return result, fmt.Errorf("unexpected status %d from %s: %s", resp.StatusCode, url, result.Body)
 }
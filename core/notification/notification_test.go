@@ -0,0 +1,54 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestDeliver_ReportsProviderResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"rate limited"}`))
+	}))
+	defer server.Close()
+
+	config, err := json.Marshal(models.DiscordNotificationConfig{WebhookURL: server.URL})
+	require.NoError(t, err)
+
+	notification := models.Notification{
+		Type:   models.NotificationTypeDiscord,
+		Name:   "Alerts",
+		Config: config,
+	}
+
+	result, err := Deliver(context.Background(), server.Client(), notification, "title", "description", models.PingStatusFailed)
+	require.Error(t, err)
+	require.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	require.Equal(t, `{"message":"rate limited"}`, result.Body)
+}
+
+func TestDeliver_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	config, err := json.Marshal(models.SlackNotificationConfig{WebhookURL: server.URL})
+	require.NoError(t, err)
+
+	notification := models.Notification{
+		Type:   models.NotificationTypeSlack,
+		Name:   "Alerts",
+		Config: config,
+	}
+
+	result, err := Deliver(context.Background(), server.Client(), notification, "title", "description", models.PingStatusSuccessful)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, result.StatusCode)
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/yorukot/kymarium/models"
+)
+
+// QuietHoursLayout is the wall-clock format of quiet hours window boundaries.
+const QuietHoursLayout = "15:04"
+
+// ParseQuietHours decodes and validates quiet hours from a request. A JSON null yields nil, which disables them.
+func ParseQuietHours(raw json.RawMessage) (*models.NotificationQuietHours, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var quiet models.NotificationQuietHours
+	if err := json.Unmarshal(raw, &quiet); err != nil {
+		return nil, fmt.Errorf("decode quiet hours: %w", err)
+	}
+
+	if err := validator.New().Struct(quiet); err != nil {
+		return nil, err
+	}
+
+	return &quiet, nil
+}
+
+// BypassesQuietHours reports whether events of the given incident severity are delivered during quiet hours.
+func BypassesQuietHours(severity models.IncidentSeverity) bool {
+	return SeverityRank(severity) >= SeverityRank(models.IncidentSeverityCritical)
+}
+
+// QuietUntil reports whether at falls inside the quiet hours and, if so, when quiet hours end.
+// Back-to-back windows (for example 22:00-00:00 followed by 00:00-07:00 the next day) are merged.
+func QuietUntil(quiet *models.NotificationQuietHours, at time.Time) (time.Time, bool, error) {
+	if quiet == nil || len(quiet.Windows) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	loc, err := time.LoadLocation(quiet.Timezone)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("load quiet hours timezone: %w", err)
+	}
+
+	end, ok, err := windowEnd(quiet.Windows, at.In(loc))
+	if err != nil || !ok {
+		return time.Time{}, false, err
+	}
+
+	// Follow adjacent windows; a week of them covers every possible chain.
+	for range 7 * len(quiet.Windows) {
+		next, ok, err := windowEnd(quiet.Windows, end)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if !ok || !next.After(end) {
+			break
+		}
+		end = next
+	}
+
+	return end.UTC(), true, nil
+}
+
+// windowEnd returns the latest end of the windows covering at, which must be in the quiet hours location.
+func windowEnd(windows []models.QuietHoursWindow, at time.Time) (time.Time, bool, error) {
+	var end time.Time
+	found := false
+
+	for _, window := range windows {
+		start, err := time.Parse(QuietHoursLayout, window.Start)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("parse quiet hours start: %w", err)
+		}
+
+		stop, err := time.Parse(QuietHoursLayout, window.End)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("parse quiet hours end: %w", err)
+		}
+
+		// A window that runs past midnight may have started the day before.
+		for _, offset := range []int{0, -1} {
+			day := at.AddDate(0, 0, offset)
+			if !slices.Contains(window.Weekdays, day.Weekday()) {
+				continue
+			}
+
+			windowStart := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, at.Location())
+			windowStop := time.Date(day.Year(), day.Month(), day.Day(), stop.Hour(), stop.Minute(), 0, 0, at.Location())
+			if !windowStop.After(windowStart) {
+				windowStop = windowStop.AddDate(0, 0, 1)
+			}
+
+			if !at.Before(windowStart) && at.Before(windowStop) && windowStop.After(end) {
+				end = windowStop
+				found = true
+			}
+		}
+	}
+
+	return end, found, nil
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestQuietUntil_OvernightWindow(t *testing.T) {
+	quiet := &models.NotificationQuietHours{
+		Timezone: "Europe/Berlin",
+		Windows: []models.QuietHoursWindow{
+			{Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, Start: "22:00", End: "07:00"},
+		},
+	}
+
+	// Tuesday 02:00 in Berlin falls into Monday's window.
+	at := time.Date(2026, 3, 3, 1, 0, 0, 0, time.UTC)
+	until, ok, err := QuietUntil(quiet, at)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2026, 3, 3, 6, 0, 0, 0, time.UTC), until)
+
+	// Tuesday 12:00 in Berlin is outside quiet hours.
+	_, ok, err = QuietUntil(quiet, time.Date(2026, 3, 3, 11, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Sunday 02:00 is outside because Saturday has no window.
+	_, ok, err = QuietUntil(quiet, time.Date(2026, 3, 8, 1, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestQuietUntil_MergesAdjacentWindows(t *testing.T) {
+	quiet := &models.NotificationQuietHours{
+		Timezone: "UTC",
+		Windows: []models.QuietHoursWindow{
+			{Weekdays: []time.Weekday{time.Saturday, time.Sunday}, Start: "00:00", End: "00:00"},
+			{Weekdays: []time.Weekday{time.Friday}, Start: "18:00", End: "00:00"},
+		},
+	}
+
+	until, ok, err := QuietUntil(quiet, time.Date(2026, 3, 6, 20, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), until)
+}
+
+func TestBypassesQuietHours(t *testing.T) {
+	require.True(t, BypassesQuietHours(models.IncidentSeverityEmergency))
+	require.True(t, BypassesQuietHours(models.IncidentSeverityCritical))
+	require.False(t, BypassesQuietHours(models.IncidentSeverityMajor))
+	require.False(t, BypassesQuietHours(""))
+}
@@ -0,0 +1,89 @@
+package notification
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// RoutingEvent describes an event being considered for delivery to a notification channel.
+type RoutingEvent struct {
+	Type     models.NotificationEventType
+	Severity models.IncidentSeverity
+	RegionID int64
+	Tags     []string
+}
+
+// optInEventTypes are only delivered to channels with a rule naming them, so introducing them does not
+// start messaging every existing channel.
+var optInEventTypes = []models.NotificationEventType{models.NotificationEventTypeManualStatusUpdate}
+
+// ShouldDeliver reports whether the channel's routing rules accept the event.
+// A channel without rules accepts every event except opt-in ones; otherwise any matching rule is enough.
+func ShouldDeliver(rules []models.NotificationRoutingRule, event RoutingEvent) bool {
+	optIn := slices.Contains(optInEventTypes, event.Type)
+	if len(rules) == 0 {
+		return !optIn
+	}
+
+	for _, rule := range rules {
+		if optIn && !slices.Contains(rule.EventTypes, event.Type) {
+			continue
+		}
+		if ruleMatches(rule, event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleMatches(rule models.NotificationRoutingRule, event RoutingEvent) bool {
+	if len(rule.EventTypes) > 0 && !slices.Contains(rule.EventTypes, event.Type) {
+		return false
+	}
+
+	if rule.MinSeverity != "" && SeverityRank(event.Severity) < SeverityRank(rule.MinSeverity) {
+		return false
+	}
+
+	if len(rule.RegionIDs) > 0 && !slices.Contains(rule.RegionIDs, event.RegionID) {
+		return false
+	}
+
+	if len(rule.Tags) > 0 && !hasAnyTag(event.Tags, rule.Tags) {
+		return false
+	}
+
+	return true
+}
+
+// SeverityRank orders incident severities from least (info) to most (emergency) severe.
+func SeverityRank(severity models.IncidentSeverity) int {
+	switch severity {
+	case models.IncidentSeverityEmergency:
+		return 5
+	case models.IncidentSeverityCritical:
+		return 4
+	case models.IncidentSeverityMajor:
+		return 3
+	case models.IncidentSeverityMinor:
+		return 2
+	case models.IncidentSeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func hasAnyTag(tags, wanted []string) bool {
+	for _, tag := range tags {
+		for _, candidate := range wanted {
+			if strings.EqualFold(strings.TrimSpace(tag), strings.TrimSpace(candidate)) {
+				return true
+			}
+		}
+	}
+	return false
+}
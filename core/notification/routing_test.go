@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestShouldDeliver(t *testing.T) {
+	down := RoutingEvent{
+		Type:     models.NotificationEventTypeDown,
+		Severity: models.IncidentSeverityMajor,
+		RegionID: 1,
+		Tags:     []string{"payments", "prod"},
+	}
+	manual := RoutingEvent{
+		Type:     models.NotificationEventTypeManualStatusUpdate,
+		Severity: models.IncidentSeverityMajor,
+		Tags:     []string{"payments", "prod"},
+	}
+
+	tests := []struct {
+		name  string
+		rules []models.NotificationRoutingRule
+		event RoutingEvent
+		want  bool
+	}{
+		{
+			name:  "no rules accepts everything",
+			event: down,
+			want:  true,
+		},
+		{
+			name:  "event type mismatch",
+			rules: []models.NotificationRoutingRule{{EventTypes: []models.NotificationEventType{models.NotificationEventTypeRecovered}}},
+			event: down,
+			want:  false,
+		},
+		{
+			name:  "severity below minimum",
+			rules: []models.NotificationRoutingRule{{MinSeverity: models.IncidentSeverityCritical}},
+			event: down,
+			want:  false,
+		},
+		{
+			name:  "severity at minimum",
+			rules: []models.NotificationRoutingRule{{MinSeverity: models.IncidentSeverityMajor}},
+			event: down,
+			want:  true,
+		},
+		{
+			name:  "region mismatch",
+			rules: []models.NotificationRoutingRule{{RegionIDs: []int64{2}}},
+			event: down,
+			want:  false,
+		},
+		{
+			name:  "tag match is case insensitive",
+			rules: []models.NotificationRoutingRule{{Tags: []string{"PROD"}}},
+			event: down,
+			want:  true,
+		},
+		{
+			name:  "manual status update is opt-in without rules",
+			event: manual,
+			want:  false,
+		},
+		{
+			name:  "manual status update skipped by rule without event types",
+			rules: []models.NotificationRoutingRule{{Tags: []string{"prod"}}},
+			event: manual,
+			want:  false,
+		},
+		{
+			name:  "manual status update skipped by down-only rule",
+			rules: []models.NotificationRoutingRule{{EventTypes: []models.NotificationEventType{models.NotificationEventTypeDown}}},
+			event: manual,
+			want:  false,
+		},
+		{
+			name:  "manual status update accepted by its event type",
+			rules: []models.NotificationRoutingRule{{EventTypes: []models.NotificationEventType{models.NotificationEventTypeManualStatusUpdate}, Tags: []string{"prod"}}},
+			event: manual,
+			want:  true,
+		},
+		{
+			name: "any rule may match",
+			rules: []models.NotificationRoutingRule{
+				{Tags: []string{"staging"}},
+				{EventTypes: []models.NotificationEventType{models.NotificationEventTypeDown}, RegionIDs: []int64{1}},
+			},
+			event: down,
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ShouldDeliver(tt.rules, tt.event))
+		})
+	}
+}
@@ -1,30 +1,252 @@
 package notification
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
 )
 
-func sendSlack(ctx context.Context, client *http.Client, notification models.Notification, title, description string, _ models.PingStatus) error {
+// Slack interactive button action IDs.
+const (
+	SlackActionAcknowledge = "incident_acknowledge"
+	SlackActionResolve     = "incident_resolve"
+)
+
+// slackSignatureMaxAge bounds the replay window for signed Slack requests.
+const slackSignatureMaxAge = 5 * time.Minute
+
+// slackAPIBaseURL is the Slack Web API root; tests point it at a local server.
+var slackAPIBaseURL = "https://slack.com/api"
+
+func sendSlack(ctx context.Context, client *http.Client, notification models.Notification, msg Message) (Result, error) {
 	var cfg models.SlackNotificationConfig
 	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
-		return fmt.Errorf("decode slack config: %w", err)
+		return Result{}, fmt.Errorf("decode slack config: %w", err)
+	}
+
+	if cfg.BotToken != "" {
+		return sendSlackBot(ctx, client, notification, cfg, msg)
 	}
 
 	if cfg.WebhookURL == "" {
-		return errors.New("slack webhook_url is required")
+		return Result{}, errors.New("slack webhook_url is required")
 	}
 
-	text := strings.TrimSpace(fmt.Sprintf("*%s*\n%s", title, description))
+	text := strings.TrimSpace(fmt.Sprintf("*%s*\n%s", msg.Title, msg.Description))
 	payload := map[string]any{
 		"text": text,
 	}
 
 	return postJSON(ctx, client, cfg.WebhookURL, payload)
 }
+
+// sendSlackBot posts a Block Kit message with chat.postMessage, or edits an earlier one with chat.update
+// when the message replaces one sent before (e.g. the resolve update of an incident).
+func sendSlackBot(ctx context.Context, client *http.Client, notification models.Notification, cfg models.SlackNotificationConfig, msg Message) (Result, error) {
+	payload := map[string]any{
+		"channel": cfg.ChannelID,
+		"text":    msg.Title,
+		"attachments": []map[string]any{{
+			"color":  slackColor(msg),
+			"blocks": slackBlocks(notification, msg),
+		}},
+	}
+
+	method := "chat.postMessage"
+	if msg.ReplaceMessageID != "" {
+		channel, ts, ok := strings.Cut(msg.ReplaceMessageID, ":")
+		if !ok {
+			return Result{}, fmt.Errorf("invalid slack message id %q", msg.ReplaceMessageID)
+		}
+		payload["channel"] = channel
+		payload["ts"] = ts
+		method = "chat.update"
+	}
+
+	return postSlackAPI(ctx, client, cfg.BotToken, method, payload)
+}
+
+func slackBlocks(notification models.Notification, msg Message) []map[string]any {
+	blocks := []map[string]any{
+		{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": "*" + slackEscape(msg.Title) + "*"},
+		},
+	}
+
+	if description := strings.TrimSpace(msg.Description); description != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": slackEscape(description)},
+		})
+	}
+
+	var links []string
+	if msg.IncidentURL != "" {
+		links = append(links, fmt.Sprintf("<%s|View incident timeline>", msg.IncidentURL))
+	}
+	if msg.MonitorURL != "" {
+		links = append(links, fmt.Sprintf("<%s|View monitor>", msg.MonitorURL))
+	}
+	if len(links) > 0 {
+		blocks = append(blocks, map[string]any{
+			"type":     "context",
+			"elements": []map[string]any{{"type": "mrkdwn", "text": strings.Join(links, " · ")}},
+		})
+	}
+
+	// Buttons only make sense while the incident is open.
+	if msg.IncidentID == 0 || msg.EventType != models.NotificationEventTypeDown {
+		return blocks
+	}
+
+	value := SlackActionValue(config.Env().JWTSecretKey, msg.TeamID, msg.IncidentID, notification.ID)
+	var buttons []map[string]any
+	if !msg.Acknowledged {
+		buttons = append(buttons, map[string]any{
+			"type":      "button",
+			"action_id": SlackActionAcknowledge,
+			"text":      map[string]any{"type": "plain_text", "text": "Acknowledge"},
+			"style":     "primary",
+			"value":     value,
+		})
+	}
+	buttons = append(buttons, map[string]any{
+		"type":      "button",
+		"action_id": SlackActionResolve,
+		"text":      map[string]any{"type": "plain_text", "text": "Resolve"},
+		"value":     value,
+	})
+
+	return append(blocks, map[string]any{"type": "actions", "elements": buttons})
+}
+
+func slackColor(msg Message) string {
+	if msg.EventType == models.NotificationEventTypeCertExpiring || (msg.Acknowledged && msg.EventType == models.NotificationEventTypeDown) {
+		return "#f59e0b"
+	}
+	return statusColorHex(msg.Status)
+}
+
+func postSlackAPI(ctx context.Context, client *http.Client, token, method string, payload any) (Result, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Result{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, slackAPIBaseURL+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	result := Result{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(respBody)),
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, fmt.Errorf("slack %s returned status %d", method, resp.StatusCode)
+	}
+
+	// The Web API reports failures with HTTP 200 and ok=false.
+	var decoded struct {
+		OK      bool   `json:"ok"`
+		Error   string `json:"error"`
+		Channel string `json:"channel"`
+		TS      string `json:"ts"`
+	}
+	if err := json.Unmarshal(respBody, &decoded); err != nil {
+		return result, fmt.Errorf("decode slack %s response: %w", method, err)
+	}
+	if !decoded.OK {
+		return result, fmt.Errorf("slack %s failed: %s", method, decoded.Error)
+	}
+
+	result.MessageID = SlackMessageID(decoded.Channel, decoded.TS)
+	return result, nil
+}
+
+// SlackMessageID combines a channel and message timestamp into the ID stored for later chat.update calls.
+func SlackMessageID(channel, ts string) string {
+	if channel == "" || ts == "" {
+		return ""
+	}
+	return channel + ":" + ts
+}
+
+// SlackActionValue encodes the incident a button acts on, signed with secret so that buttons cannot be
+// forged for another team's incident.
+func SlackActionValue(secret string, teamID, incidentID, notificationID int64) string {
+	return signChatAction(secret, fmt.Sprintf("%d:%d:%d", teamID, incidentID, notificationID))
+}
+
+// ParseSlackActionValue verifies and decodes a button value produced by SlackActionValue.
+func ParseSlackActionValue(secret, value string) (teamID, incidentID, notificationID int64, err error) {
+	data, ok := verifyChatAction(secret, value)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("invalid slack action value signature")
+	}
+
+	parts := strings.Split(data, ":")
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid slack action value %q", value)
+	}
+
+	ids := make([]int64, 3)
+	for i, part := range parts {
+		if ids[i], err = strconv.ParseInt(part, 10, 64); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid slack action value %q: %w", value, err)
+		}
+	}
+
+	return ids[0], ids[1], ids[2], nil
+}
+
+// VerifySlackSignature checks the X-Slack-Signature of a request body signed with the app's signing secret.
+func VerifySlackSignature(secret, timestamp, signature string, body []byte, now time.Time) bool {
+	if secret == "" || timestamp == "" || signature == "" {
+		return false
+	}
+
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(sent, 0)); age > slackSignatureMaxAge || age < -slackSignatureMaxAge {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// slackEscape escapes the characters Slack treats as control sequences in mrkdwn.
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+func newSlackAPIServer(t *testing.T, response string) (*httptest.Server, *[]string, *[]map[string]any) {
+	t.Helper()
+
+	var paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer xoxb-test", r.Header.Get("Authorization"))
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(raw, &body))
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	previous := slackAPIBaseURL
+	slackAPIBaseURL = server.URL
+	t.Cleanup(func() { slackAPIBaseURL = previous })
+
+	return server, &paths, &bodies
+}
+
+func slackBotNotification(t *testing.T) models.Notification {
+	config, err := json.Marshal(models.SlackNotificationConfig{BotToken: "xoxb-test", ChannelID: "C123"})
+	require.NoError(t, err)
+	return models.Notification{ID: 7, Type: models.NotificationTypeSlack, Config: config}
+}
+
+func TestDeliverMessage_SlackBotPostsBlocks(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, paths, bodies := newSlackAPIServer(t, `{"ok":true,"channel":"C123","ts":"1700000000.000100"}`)
+
+	result, err := DeliverMessage(context.Background(), server.Client(), slackBotNotification(t), Message{
+		Title:       "api is FAILED",
+		Description: "Status: FAILED",
+		Status:      models.PingStatusFailed,
+		TeamID:      1,
+		IncidentID:  42,
+		EventType:   models.NotificationEventTypeDown,
+		IncidentURL: "https://app/1/incidents/42",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "C123:1700000000.000100", result.MessageID)
+	require.Equal(t, []string{"/chat.postMessage"}, *paths)
+
+	raw, err := json.Marshal((*bodies)[0])
+	require.NoError(t, err)
+	require.Contains(t, string(raw), `"action_id":"incident_acknowledge"`)
+	require.Contains(t, string(raw), `"value":"`+SlackActionValue(config.Env().JWTSecretKey, 1, 42, 7)+`"`)
+	require.Contains(t, string(raw), "View incident timeline")
+	require.Contains(t, string(raw), `"color":"#dc2626"`)
+}
+
+func TestDeliverMessage_SlackBotUpdatesResolvedMessage(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, paths, bodies := newSlackAPIServer(t, `{"ok":true,"channel":"C123","ts":"1700000000.000100"}`)
+
+	_, err := DeliverMessage(context.Background(), server.Client(), slackBotNotification(t), Message{
+		Title:            "api is SUCCESSFUL",
+		Status:           models.PingStatusSuccessful,
+		IncidentID:       42,
+		EventType:        models.NotificationEventTypeRecovered,
+		ReplaceMessageID: "C123:1700000000.000100",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"/chat.update"}, *paths)
+	require.Equal(t, "1700000000.000100", (*bodies)[0]["ts"])
+
+	raw, err := json.Marshal((*bodies)[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(raw), "incident_resolve")
+}
+
+func TestDeliverMessage_SlackBotReportsAPIError(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, _, _ := newSlackAPIServer(t, `{"ok":false,"error":"channel_not_found"}`)
+
+	_, err := DeliverMessage(context.Background(), server.Client(), slackBotNotification(t), Message{Title: "api is FAILED"})
+	require.ErrorContains(t, err, "channel_not_found")
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte("payload=%7B%7D")
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	signature := "v0=" + hex.EncodeToString(mac.Sum(nil))
+
+	require.True(t, VerifySlackSignature("secret", timestamp, signature, body, now))
+	require.False(t, VerifySlackSignature("other", timestamp, signature, body, now))
+	require.False(t, VerifySlackSignature("secret", timestamp, signature, []byte("payload=tampered"), now))
+	require.False(t, VerifySlackSignature("secret", timestamp, signature, body, now.Add(10*time.Minute)))
+}
+
+func TestParseSlackActionValue(t *testing.T) {
+	value := SlackActionValue("secret", 1, 42, 7)
+	teamID, incidentID, notificationID, err := ParseSlackActionValue("secret", value)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 42, 7}, []int64{teamID, incidentID, notificationID})
+
+	_, _, _, err = ParseSlackActionValue("other", value)
+	require.Error(t, err)
+
+	// Changing the IDs of a signed value breaks the signature.
+	_, _, _, err = ParseSlackActionValue("secret", "2"+value[1:])
+	require.Error(t, err)
+
+	_, _, _, err = ParseSlackActionValue("secret", "1:42:7")
+	require.Error(t, err)
+}
@@ -1,36 +1,277 @@
 package notification
 
 import (
+	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+// Telegram inline keyboard actions. They are single letters because callback data is limited to 64 bytes.
+const (
+	TelegramActionAcknowledge = "a"
+	TelegramActionResolve     = "r"
+	TelegramActionMute        = "m"
 )
 
 // telegramAPIBase is overridable for testing.
 var telegramAPIBase = "https://api.telegram.org"
 
-func sendTelegram(ctx context.Context, client *http.Client, notification models.Notification, title, description string, _ models.PingStatus) error {
+// sendTelegram sends an HTML formatted message, or edits an earlier one with editMessageText
+// when the message replaces one sent before (e.g. the resolve update of an incident).
+func sendTelegram(ctx context.Context, client *http.Client, notification models.Notification, msg Message) (Result, error) {
 	var cfg models.TelegramNotificationConfig
 	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
-		return fmt.Errorf("decode telegram config: %w", err)
+		return Result{}, fmt.Errorf("decode telegram config: %w", err)
 	}
 
 	if cfg.BotToken == "" || cfg.ChatID == "" {
-		return errors.New("telegram bot_token and chat_id are required")
+		return Result{}, errors.New("telegram bot_token and chat_id are required")
+	}
+
+	payload := map[string]any{
+		"chat_id":                  cfg.ChatID,
+		"text":                     telegramText(msg),
+		"parse_mode":               "HTML",
+		"disable_web_page_preview": true,
+	}
+
+	// Omitting reply_markup on an edit removes the buttons, which is what a resolved incident wants.
+	if keyboard := telegramKeyboard(notification, msg); keyboard != nil {
+		payload["reply_markup"] = map[string]any{"inline_keyboard": keyboard}
+	}
+
+	method := "sendMessage"
+	if msg.ReplaceMessageID != "" {
+		chatID, messageID, ok := parseTelegramMessageID(msg.ReplaceMessageID)
+		if !ok {
+			return Result{}, fmt.Errorf("invalid telegram message id %q", msg.ReplaceMessageID)
+		}
+		payload["chat_id"] = chatID
+		payload["message_id"] = messageID
+		method = "editMessageText"
+	}
+
+	return postTelegramAPI(ctx, client, cfg.BotToken, method, payload)
+}
+
+// telegramText renders the message as Telegram HTML with a status emoji and dashboard links.
+func telegramText(msg Message) string {
+	var b strings.Builder
+	b.WriteString(telegramEmoji(msg))
+	b.WriteString(" <b>")
+	b.WriteString(html.EscapeString(msg.Title))
+	b.WriteString("</b>")
+
+	if description := strings.TrimSpace(msg.Description); description != "" {
+		b.WriteString("\n\n")
+		b.WriteString(html.EscapeString(description))
+	}
+
+	var links []string
+	if msg.IncidentURL != "" {
+		links = append(links, fmt.Sprintf(`<a href="%s">View incident timeline</a>`, html.EscapeString(msg.IncidentURL)))
+	}
+	if msg.MonitorURL != "" {
+		links = append(links, fmt.Sprintf(`<a href="%s">View monitor</a>`, html.EscapeString(msg.MonitorURL)))
+	}
+	if len(links) > 0 {
+		b.WriteString("\n\n")
+		b.WriteString(strings.Join(links, " · "))
+	}
+
+	return b.String()
+}
+
+func telegramEmoji(msg Message) string {
+	if msg.EventType == models.NotificationEventTypeCertExpiring || (msg.Acknowledged && msg.EventType == models.NotificationEventTypeDown) {
+		return "🟡"
+	}
+
+	switch msg.Status {
+	case models.PingStatusSuccessful:
+		return "🟢"
+	case models.PingStatusFailed, models.PingStatusTimeout:
+		return "🔴"
+	default:
+		return "⚪"
+	}
+}
+
+// telegramKeyboard returns the inline buttons for an open incident, or nil when there is nothing to act on.
+func telegramKeyboard(notification models.Notification, msg Message) [][]map[string]string {
+	if msg.IncidentID == 0 || msg.EventType != models.NotificationEventTypeDown {
+		return nil
+	}
+
+	button := func(text, action string) map[string]string {
+		return map[string]string{
+			"text":          text,
+			"callback_data": TelegramCallbackData(config.Env().JWTSecretKey, action, msg.TeamID, msg.IncidentID, notification.ID),
+		}
+	}
+
+	var first []map[string]string
+	if !msg.Acknowledged {
+		first = append(first, button("Acknowledge", TelegramActionAcknowledge))
 	}
+	first = append(first, button("Resolve", TelegramActionResolve))
 
-	apiBase := strings.TrimSuffix(telegramAPIBase, "/")
-	url := fmt.Sprintf("%s/bot%s/sendMessage", apiBase, cfg.BotToken)
+	keyboard := [][]map[string]string{first}
+	if !msg.Muted {
+		keyboard = append(keyboard, []map[string]string{button("Mute reminders", TelegramActionMute)})
+	}
+
+	return keyboard
+}
+
+func postTelegramAPI(ctx context.Context, client *http.Client, token, method string, payload any) (Result, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Result{}, fmt.Errorf("marshal payload: %w", err)
+	}
 
-	payload := map[string]interface{}{
-		"chat_id": cfg.ChatID,
-		"text":    strings.TrimSpace(fmt.Sprintf("%s\n\n%s", title, description)),
+	url := fmt.Sprintf("%s/bot%s/%s", strings.TrimSuffix(telegramAPIBase, "/"), token, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, fmt.Errorf("create request: %w", err)
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	return postJSON(ctx, client, url, payload)
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	result := Result{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(respBody)),
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return result, fmt.Errorf("telegram %s returned status %d: %s", method, resp.StatusCode, result.Body)
+	}
+
+	var decoded struct {
+		OK     bool            `json:"ok"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(respBody, &decoded); err != nil {
+		return result, fmt.Errorf("decode telegram %s response: %w", method, err)
+	}
+	if !decoded.OK {
+		return result, fmt.Errorf("telegram %s failed: %s", method, result.Body)
+	}
+
+	// sendMessage and editMessageText return the message; other methods return true.
+	var message struct {
+		MessageID int64 `json:"message_id"`
+		Chat      struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+	}
+	if json.Unmarshal(decoded.Result, &message) == nil && message.MessageID != 0 {
+		result.MessageID = TelegramMessageID(message.Chat.ID, message.MessageID)
+	}
+
+	return result, nil
+}
+
+// AnswerTelegramCallback acknowledges a button press so the client stops its loading indicator.
+func AnswerTelegramCallback(ctx context.Context, client *http.Client, botToken, callbackID, text string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	_, err := postTelegramAPI(ctx, client, botToken, "answerCallbackQuery", map[string]any{
+		"callback_query_id": callbackID,
+		"text":              text,
+	})
+	return err
+}
+
+// TelegramMessageID combines a chat and message ID into the ID stored for later editMessageText calls.
+func TelegramMessageID(chatID, messageID int64) string {
+	if chatID == 0 || messageID == 0 {
+		return ""
+	}
+	return strconv.FormatInt(chatID, 10) + ":" + strconv.FormatInt(messageID, 10)
+}
+
+func parseTelegramMessageID(value string) (chatID, messageID int64, ok bool) {
+	chat, message, found := strings.Cut(value, ":")
+	if !found {
+		return 0, 0, false
+	}
+
+	chatID, err := strconv.ParseInt(chat, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	messageID, err = strconv.ParseInt(message, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return chatID, messageID, true
+}
+
+// TelegramCallbackData encodes a button action and the incident it acts on, signed with secret so that
+// buttons cannot be forged for another team's incident. IDs are base 36 to fit the MAC in 64 bytes.
+func TelegramCallbackData(secret, action string, teamID, incidentID, notificationID int64) string {
+	return signChatAction(secret, fmt.Sprintf("%s:%s:%s:%s", action,
+		strconv.FormatInt(teamID, 36), strconv.FormatInt(incidentID, 36), strconv.FormatInt(notificationID, 36)))
+}
+
+// ParseTelegramCallbackData verifies and decodes callback data produced by TelegramCallbackData.
+func ParseTelegramCallbackData(secret, data string) (action string, teamID, incidentID, notificationID int64, err error) {
+	signed, ok := verifyChatAction(secret, data)
+	if !ok {
+		return "", 0, 0, 0, fmt.Errorf("invalid telegram callback data signature")
+	}
+
+	parts := strings.Split(signed, ":")
+	if len(parts) != 4 || parts[0] == "" {
+		return "", 0, 0, 0, fmt.Errorf("invalid telegram callback data %q", data)
+	}
+
+	ids := make([]int64, 3)
+	for i, part := range parts[1:] {
+		if ids[i], err = strconv.ParseInt(part, 36, 64); err != nil {
+			return "", 0, 0, 0, fmt.Errorf("invalid telegram callback data %q: %w", data, err)
+		}
+	}
+
+	return parts[0], ids[0], ids[1], ids[2], nil
+}
+
+// TelegramChatMatches reports whether a chat is the one configured as chatID, which is either the numeric
+// chat ID or the @username of a public chat.
+func TelegramChatMatches(chatID string, id int64, username string) bool {
+	chatID = strings.TrimSpace(chatID)
+	if name, ok := strings.CutPrefix(chatID, "@"); ok {
+		return username != "" && strings.EqualFold(name, username)
+	}
+	return chatID != "" && chatID == strconv.FormatInt(id, 10)
+}
+
+// VerifyTelegramSecret compares the X-Telegram-Bot-Api-Secret-Token header with the configured secret.
+func VerifyTelegramSecret(secret, header string) bool {
+	if secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(header)) == 1
 }
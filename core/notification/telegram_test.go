@@ -0,0 +1,154 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/internal/testutil"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+)
+
+func newTelegramAPIServer(t *testing.T, response string) (*httptest.Server, *[]string, *[]map[string]any) {
+	t.Helper()
+
+	var paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(raw, &body))
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	previous := telegramAPIBase
+	telegramAPIBase = server.URL
+	t.Cleanup(func() { telegramAPIBase = previous })
+
+	return server, &paths, &bodies
+}
+
+func telegramNotification(t *testing.T) models.Notification {
+	config, err := json.Marshal(models.TelegramNotificationConfig{BotToken: "123:abc", ChatID: "-100200"})
+	require.NoError(t, err)
+	return models.Notification{ID: 7, Type: models.NotificationTypeTelegram, Config: config}
+}
+
+func TestDeliverMessage_TelegramSendsHTMLWithKeyboard(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, paths, bodies := newTelegramAPIServer(t, `{"ok":true,"result":{"message_id":55,"chat":{"id":-100200}}}`)
+
+	result, err := DeliverMessage(context.Background(), server.Client(), telegramNotification(t), Message{
+		Title:       "api <prod> is FAILED",
+		Description: "Detail: a & b",
+		Status:      models.PingStatusFailed,
+		TeamID:      1,
+		IncidentID:  42,
+		EventType:   models.NotificationEventTypeDown,
+		IncidentURL: "https://app/1/incidents/42",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "-100200:55", result.MessageID)
+
+	require.Equal(t, []string{"/bot123:abc/sendMessage"}, *paths)
+	body := (*bodies)[0]
+	require.Equal(t, "HTML", body["parse_mode"])
+	require.Equal(t, "🔴 <b>api &lt;prod&gt; is FAILED</b>\n\nDetail: a &amp; b\n\n<a href=\"https://app/1/incidents/42\">View incident timeline</a>", body["text"])
+
+	keyboard := body["reply_markup"].(map[string]any)["inline_keyboard"].([]any)
+	require.Len(t, keyboard, 2)
+	first := keyboard[0].([]any)
+	require.Len(t, first, 2)
+	require.Equal(t, TelegramCallbackData(config.Env().JWTSecretKey, TelegramActionAcknowledge, 1, 42, 7), first[0].(map[string]any)["callback_data"])
+	require.Equal(t, TelegramCallbackData(config.Env().JWTSecretKey, TelegramActionResolve, 1, 42, 7), first[1].(map[string]any)["callback_data"])
+	require.Equal(t, TelegramCallbackData(config.Env().JWTSecretKey, TelegramActionMute, 1, 42, 7), keyboard[1].([]any)[0].(map[string]any)["callback_data"])
+}
+
+func TestDeliverMessage_TelegramEditsReplacedMessage(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, paths, bodies := newTelegramAPIServer(t, `{"ok":true,"result":{"message_id":55,"chat":{"id":-100200}}}`)
+
+	_, err := DeliverMessage(context.Background(), server.Client(), telegramNotification(t), Message{
+		Title:            "api is SUCCESSFUL",
+		Status:           models.PingStatusSuccessful,
+		TeamID:           1,
+		IncidentID:       42,
+		EventType:        models.NotificationEventTypeRecovered,
+		ReplaceMessageID: "-100200:55",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"/bot123:abc/editMessageText"}, *paths)
+	body := (*bodies)[0]
+	require.Equal(t, float64(-100200), body["chat_id"])
+	require.Equal(t, float64(55), body["message_id"])
+	require.Equal(t, "🟢 <b>api is SUCCESSFUL</b>", body["text"])
+	require.NotContains(t, body, "reply_markup")
+}
+
+func TestDeliverMessage_TelegramReportsAPIError(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	server, _, _ := newTelegramAPIServer(t, `{"ok":false,"description":"Bad Request: chat not found"}`)
+
+	_, err := DeliverMessage(context.Background(), server.Client(), telegramNotification(t), Message{Title: "t"})
+	require.ErrorContains(t, err, "chat not found")
+}
+
+func TestTelegramKeyboard_AcknowledgedAndMuted(t *testing.T) {
+	testutil.InitTestEnv(t)
+
+	keyboard := telegramKeyboard(models.Notification{ID: 7}, Message{
+		TeamID:       1,
+		IncidentID:   42,
+		EventType:    models.NotificationEventTypeDown,
+		Acknowledged: true,
+		Muted:        true,
+	})
+	require.Len(t, keyboard, 1)
+	require.Len(t, keyboard[0], 1)
+	require.Equal(t, "Resolve", keyboard[0][0]["text"])
+	require.Equal(t, "🟡", telegramEmoji(Message{Acknowledged: true, EventType: models.NotificationEventTypeDown}))
+}
+
+func TestParseTelegramCallbackData(t *testing.T) {
+	data := TelegramCallbackData("secret", TelegramActionMute, 1, 42, 7)
+	action, teamID, incidentID, notificationID, err := ParseTelegramCallbackData("secret", data)
+	require.NoError(t, err)
+	require.Equal(t, TelegramActionMute, action)
+	require.Equal(t, []int64{1, 42, 7}, []int64{teamID, incidentID, notificationID})
+
+	_, _, _, _, err = ParseTelegramCallbackData("other", data)
+	require.Error(t, err)
+	_, _, _, _, err = ParseTelegramCallbackData("secret", "a:1:2:7")
+	require.Error(t, err)
+	_, _, _, _, err = ParseTelegramCallbackData("secret", TelegramActionAcknowledge+data[1:])
+	require.Error(t, err)
+	require.LessOrEqual(t, len(TelegramCallbackData("secret", TelegramActionAcknowledge, math.MaxInt64, math.MaxInt64, math.MaxInt64)), 64)
+}
+
+func TestTelegramChatMatches(t *testing.T) {
+	require.True(t, TelegramChatMatches("-1001234", -1001234, ""))
+	require.False(t, TelegramChatMatches("-1001234", -1005678, ""))
+	require.True(t, TelegramChatMatches("@OpsAlerts", -1001234, "opsalerts"))
+	require.False(t, TelegramChatMatches("@opsalerts", -1001234, "other"))
+	require.False(t, TelegramChatMatches("@opsalerts", -1001234, ""))
+	require.False(t, TelegramChatMatches("", 0, ""))
+}
+
+func TestVerifyTelegramSecret(t *testing.T) {
+	require.True(t, VerifyTelegramSecret("s3cret", "s3cret"))
+	require.False(t, VerifyTelegramSecret("s3cret", "other"))
+	require.False(t, VerifyTelegramSecret("", ""))
+}
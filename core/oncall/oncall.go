@@ -0,0 +1,109 @@
+// Package oncall resolves who is on call for a schedule at a point in time.
+package oncall
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// HandoffLayout is the format of a layer's handoff time.
+const HandoffLayout = "15:04"
+
+// Shift describes who is on call and why.
+type Shift struct {
+	UserID     int64     `json:"user_id,string"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	LayerID    *int64    `json:"layer_id,string,omitempty"`
+	OverrideID *int64    `json:"override_id,string,omitempty"`
+}
+
+// Resolve returns the shift covering at, or nil when nobody is on call.
+// Overrides win over layers; among layers the highest position that has started wins.
+func Resolve(schedule models.OnCallSchedule, layers []models.OnCallLayer, overrides []models.OnCallOverride, at time.Time) (*Shift, error) {
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("load schedule timezone: %w", err)
+	}
+
+	var override *models.OnCallOverride
+	for i := range overrides {
+		o := &overrides[i]
+		if at.Before(o.StartsAt) || !at.Before(o.EndsAt) {
+			continue
+		}
+		// The most recently created override wins when several overlap.
+		if override == nil || o.CreatedAt.After(override.CreatedAt) {
+			override = o
+		}
+	}
+
+	if override != nil {
+		return &Shift{
+			UserID:     override.UserID,
+			Start:      override.StartsAt,
+			End:        override.EndsAt,
+			OverrideID: &override.ID,
+		}, nil
+	}
+
+	var best *Shift
+	bestPosition := 0
+	for _, layer := range layers {
+		shift, err := layerShift(layer, loc, at)
+		if err != nil {
+			return nil, err
+		}
+		if shift == nil {
+			continue
+		}
+		if best == nil || layer.Position > bestPosition {
+			best = shift
+			bestPosition = layer.Position
+		}
+	}
+
+	return best, nil
+}
+
+// layerShift computes the layer's shift at the given instant. Rotation is counted in calendar
+// days in the schedule timezone so handoffs stay at the same wall-clock time across DST changes.
+func layerShift(layer models.OnCallLayer, loc *time.Location, at time.Time) (*Shift, error) {
+	if len(layer.UserIDs) == 0 || layer.RotationDays <= 0 {
+		return nil, nil
+	}
+
+	handoff, err := time.Parse(HandoffLayout, layer.HandoffTime)
+	if err != nil {
+		return nil, fmt.Errorf("parse handoff time %q: %w", layer.HandoffTime, err)
+	}
+
+	local := at.In(loc)
+	last := time.Date(local.Year(), local.Month(), local.Day(), handoff.Hour(), handoff.Minute(), 0, 0, loc)
+	if last.After(local) {
+		last = last.AddDate(0, 0, -1)
+	}
+
+	startDay := civilDay(layer.StartDate.Year(), layer.StartDate.Month(), layer.StartDate.Day())
+	days := int(civilDay(last.Year(), last.Month(), last.Day()).Sub(startDay).Hours() / 24)
+	if days < 0 {
+		return nil, nil
+	}
+
+	index := days / layer.RotationDays
+	start := time.Date(layer.StartDate.Year(), layer.StartDate.Month(), layer.StartDate.Day()+index*layer.RotationDays, handoff.Hour(), handoff.Minute(), 0, 0, loc)
+	layerID := layer.ID
+
+	return &Shift{
+		UserID:  layer.UserIDs[index%len(layer.UserIDs)],
+		Start:   start,
+		End:     start.AddDate(0, 0, layer.RotationDays),
+		LayerID: &layerID,
+	}, nil
+}
+
+func civilDay(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
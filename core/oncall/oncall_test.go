@@ -0,0 +1,87 @@
+package oncall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestResolve(t *testing.T) {
+	schedule := models.OnCallSchedule{ID: 1, Timezone: "Europe/Berlin"}
+	primary := models.OnCallLayer{
+		ID:           10,
+		Position:     1,
+		StartDate:    time.Date(2026, 3, 23, 0, 0, 0, 0, time.UTC),
+		HandoffTime:  "09:00",
+		RotationDays: 7,
+		UserIDs:      []int64{100, 200, 300},
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	t.Run("before the layer starts", func(t *testing.T) {
+		shift, err := Resolve(schedule, []models.OnCallLayer{primary}, nil, time.Date(2026, 3, 23, 8, 59, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Nil(t, shift)
+	})
+
+	t.Run("rotates weekly at the handoff time", func(t *testing.T) {
+		shift, err := Resolve(schedule, []models.OnCallLayer{primary}, nil, time.Date(2026, 3, 30, 8, 59, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Equal(t, int64(100), shift.UserID)
+
+		shift, err = Resolve(schedule, []models.OnCallLayer{primary}, nil, time.Date(2026, 3, 30, 9, 0, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Equal(t, int64(200), shift.UserID)
+		require.Equal(t, time.Date(2026, 3, 30, 9, 0, 0, 0, berlin), shift.Start)
+		require.Equal(t, time.Date(2026, 4, 6, 9, 0, 0, 0, berlin), shift.End)
+	})
+
+	t.Run("wraps around the member list", func(t *testing.T) {
+		shift, err := Resolve(schedule, []models.OnCallLayer{primary}, nil, time.Date(2026, 4, 13, 12, 0, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Equal(t, int64(100), shift.UserID)
+	})
+
+	t.Run("higher layer wins once started", func(t *testing.T) {
+		weekend := models.OnCallLayer{
+			ID:           11,
+			Position:     2,
+			StartDate:    time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			HandoffTime:  "18:00",
+			RotationDays: 1,
+			UserIDs:      []int64{400},
+		}
+
+		shift, err := Resolve(schedule, []models.OnCallLayer{primary, weekend}, nil, time.Date(2026, 3, 31, 12, 0, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Equal(t, int64(200), shift.UserID)
+
+		shift, err = Resolve(schedule, []models.OnCallLayer{weekend, primary}, nil, time.Date(2026, 4, 2, 12, 0, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Equal(t, int64(400), shift.UserID)
+		require.Equal(t, int64(11), *shift.LayerID)
+	})
+
+	t.Run("override beats layers", func(t *testing.T) {
+		now := time.Date(2026, 3, 31, 12, 0, 0, 0, berlin)
+		overrides := []models.OnCallOverride{
+			{ID: 1, UserID: 500, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), CreatedAt: now.Add(-2 * time.Hour)},
+			{ID: 2, UserID: 600, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), CreatedAt: now.Add(-time.Hour)},
+			{ID: 3, UserID: 700, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour), CreatedAt: now},
+		}
+
+		shift, err := Resolve(schedule, []models.OnCallLayer{primary}, overrides, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(600), shift.UserID)
+		require.Equal(t, int64(2), *shift.OverrideID)
+		require.Nil(t, shift.LayerID)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := Resolve(models.OnCallSchedule{Timezone: "Mars/Olympus"}, nil, nil, time.Now())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,147 @@
+// Package slo evaluates service level objectives: their window, error budget and burn rates.
+package slo
+
+import (
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// BucketSize is the size of the monitor rollup buckets SLOs are computed from (monitor_30min_summary).
+const BucketSize = 30 * time.Minute
+
+// burnRateWindows are the trailing windows burn rates are reported for, from the fast and slow
+// burn alerting windows commonly paired with an SLO.
+var burnRateWindows = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"1h", time.Hour},
+	{"6h", 6 * time.Hour},
+	{"24h", 24 * time.Hour},
+}
+
+// Status is the state of an SLO over its current window.
+type Status struct {
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	TotalCount  int64     `json:"total_count"`
+	GoodCount   int64     `json:"good_count"`
+	BadCount    int64     `json:"bad_count"`
+	// SLIPct is the share of good checks so far in the window, nil before the first check.
+	SLIPct *float64 `json:"sli_pct"`
+	// ErrorBudgetCount is how many bad checks the target allows for the checks so far.
+	ErrorBudgetCount float64 `json:"error_budget_count"`
+	// ErrorBudgetRemainingPct is the share of the error budget left; it goes negative once the budget is spent.
+	ErrorBudgetRemainingPct float64 `json:"error_budget_remaining_pct"`
+	BudgetExhausted         bool    `json:"budget_exhausted"`
+	// BurnRate is how fast the budget is spent over the window so far: 1 spends exactly the budget.
+	BurnRate  float64    `json:"burn_rate"`
+	BurnRates []BurnRate `json:"burn_rates"`
+}
+
+// BurnRate is the budget burn rate over a trailing window.
+type BurnRate struct {
+	Window     string  `json:"window"`
+	TotalCount int64   `json:"total_count"`
+	BadCount   int64   `json:"bad_count"`
+	Rate       float64 `json:"rate"`
+}
+
+// Window returns the current window of an SLO at now. Rolling windows end at now; calendar windows
+// are the UTC week (from Monday), month or quarter containing now.
+func Window(slo models.SLO, now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	if slo.WindowType == models.SLOWindowRolling {
+		days := 1
+		if slo.WindowDays != nil {
+			days = *slo.WindowDays
+		}
+		return now.AddDate(0, 0, -days).Truncate(BucketSize), now
+	}
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	period := models.SLOCalendarMonth
+	if slo.CalendarPeriod != nil {
+		period = *slo.CalendarPeriod
+	}
+
+	switch period {
+	case models.SLOCalendarWeek:
+		start = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7)
+	case models.SLOCalendarQuarter:
+		start = time.Date(now.Year(), now.Month()-(now.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 3, 0)
+	default:
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+}
+
+// Lookback returns the start of the buckets Evaluate needs: the window start, or earlier when a burn
+// rate window reaches further back.
+func Lookback(slo models.SLO, now time.Time) time.Time {
+	start, _ := Window(slo, now)
+	longest := burnRateWindows[len(burnRateWindows)-1].duration
+	if earliest := now.UTC().Add(-longest).Truncate(BucketSize); earliest.Before(start) {
+		return earliest
+	}
+	return start
+}
+
+// Evaluate computes the status of an SLO at now from its buckets since Lookback.
+func Evaluate(slo models.SLO, buckets []models.SLOBucket, now time.Time) Status {
+	now = now.UTC()
+	start, end := Window(slo, now)
+	allowed := (100 - slo.TargetPct) / 100
+
+	status := Status{
+		WindowStart:             start,
+		WindowEnd:               end,
+		ErrorBudgetRemainingPct: 100,
+		BurnRates:               make([]BurnRate, 0, len(burnRateWindows)),
+	}
+
+	for _, bucket := range buckets {
+		if bucket.Bucket.Before(start) || !bucket.Bucket.Before(now) {
+			continue
+		}
+		status.TotalCount += bucket.TotalCount
+		status.GoodCount += bucket.GoodCount
+	}
+	status.BadCount = status.TotalCount - status.GoodCount
+
+	if status.TotalCount > 0 {
+		sli := float64(status.GoodCount) / float64(status.TotalCount) * 100
+		status.SLIPct = &sli
+		status.ErrorBudgetCount = allowed * float64(status.TotalCount)
+		status.BurnRate = burnRate(status.TotalCount, status.BadCount, allowed)
+		status.ErrorBudgetRemainingPct = (1 - status.BurnRate) * 100
+		status.BudgetExhausted = status.BurnRate >= 1 && status.BadCount > 0
+	}
+
+	for _, window := range burnRateWindows {
+		// A bucket counts when it overlaps the trailing window.
+		since := now.Add(-window.duration - BucketSize)
+		rate := BurnRate{Window: window.name}
+		for _, bucket := range buckets {
+			if bucket.Bucket.After(since) && bucket.Bucket.Before(now) {
+				rate.TotalCount += bucket.TotalCount
+				rate.BadCount += bucket.TotalCount - bucket.GoodCount
+			}
+		}
+		rate.Rate = burnRate(rate.TotalCount, rate.BadCount, allowed)
+		status.BurnRates = append(status.BurnRates, rate)
+	}
+
+	return status
+}
+
+// burnRate is the observed error rate relative to the error rate the target allows.
+func burnRate(total, bad int64, allowed float64) float64 {
+	if total == 0 || allowed <= 0 {
+		return 0
+	}
+	return float64(bad) / float64(total) / allowed
+}
@@ -0,0 +1,86 @@
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func calendarSLO(period models.SLOCalendarPeriod) models.SLO {
+	return models.SLO{TargetPct: 99, WindowType: models.SLOWindowCalendar, CalendarPeriod: &period}
+}
+
+func TestWindow(t *testing.T) {
+	now := time.Date(2026, 8, 13, 10, 45, 0, 0, time.UTC) // a Thursday
+
+	days := 7
+	start, end := Window(models.SLO{WindowType: models.SLOWindowRolling, WindowDays: &days}, now)
+	require.Equal(t, time.Date(2026, 8, 6, 10, 30, 0, 0, time.UTC), start)
+	require.Equal(t, now, end)
+
+	start, end = Window(calendarSLO(models.SLOCalendarWeek), now)
+	require.Equal(t, time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = Window(calendarSLO(models.SLOCalendarMonth), now)
+	require.Equal(t, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = Window(calendarSLO(models.SLOCalendarQuarter), now)
+	require.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, _ = Window(calendarSLO(models.SLOCalendarWeek), time.Date(2026, 8, 16, 23, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestLookback(t *testing.T) {
+	now := time.Date(2026, 8, 1, 6, 10, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 7, 31, 6, 0, 0, 0, time.UTC), Lookback(calendarSLO(models.SLOCalendarMonth), now))
+
+	days := 30
+	require.Equal(t, time.Date(2026, 7, 2, 6, 0, 0, 0, time.UTC), Lookback(models.SLO{WindowType: models.SLOWindowRolling, WindowDays: &days}, now))
+}
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, 8, 2, 12, 10, 0, 0, time.UTC)
+	slo := calendarSLO(models.SLOCalendarMonth)
+
+	status := Evaluate(slo, []models.SLOBucket{
+		{Bucket: time.Date(2026, 7, 31, 23, 30, 0, 0, time.UTC), TotalCount: 100, GoodCount: 0},
+		{Bucket: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), TotalCount: 1000, GoodCount: 1000},
+		{Bucket: time.Date(2026, 8, 2, 11, 30, 0, 0, time.UTC), TotalCount: 500, GoodCount: 496},
+		{Bucket: time.Date(2026, 8, 2, 12, 0, 0, 0, time.UTC), TotalCount: 500, GoodCount: 499},
+	}, now)
+
+	require.Equal(t, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), status.WindowStart)
+	require.Equal(t, int64(2000), status.TotalCount)
+	require.Equal(t, int64(5), status.BadCount)
+	require.InDelta(t, 99.75, *status.SLIPct, 1e-9)
+	require.InDelta(t, 20, status.ErrorBudgetCount, 1e-9)
+	require.InDelta(t, 0.25, status.BurnRate, 1e-9)
+	require.InDelta(t, 75, status.ErrorBudgetRemainingPct, 1e-9)
+	require.False(t, status.BudgetExhausted)
+
+	require.Len(t, status.BurnRates, 3)
+	require.Equal(t, BurnRate{Window: "1h", TotalCount: 1000, BadCount: 5, Rate: 0.5}, status.BurnRates[0])
+	require.Equal(t, "24h", status.BurnRates[2].Window)
+	require.Equal(t, int64(1000), status.BurnRates[2].TotalCount)
+}
+
+func TestEvaluateExhaustedAndEmpty(t *testing.T) {
+	now := time.Date(2026, 8, 2, 12, 10, 0, 0, time.UTC)
+	slo := calendarSLO(models.SLOCalendarMonth)
+
+	status := Evaluate(slo, nil, now)
+	require.Nil(t, status.SLIPct)
+	require.Equal(t, float64(100), status.ErrorBudgetRemainingPct)
+	require.Zero(t, status.BurnRate)
+
+	status = Evaluate(slo, []models.SLOBucket{{Bucket: time.Date(2026, 8, 2, 0, 0, 0, 0, time.UTC), TotalCount: 100, GoodCount: 97}}, now)
+	require.InDelta(t, 3, status.BurnRate, 1e-9)
+	require.InDelta(t, -200, status.ErrorBudgetRemainingPct, 1e-9)
+	require.True(t, status.BudgetExhausted)
+}
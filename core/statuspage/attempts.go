@@ -0,0 +1,69 @@
+package statuspage
+
+import (
+	"sync"
+	"time"
+)
+
+// Unlock attempts allowed per status page and client IP within UnlockAttemptWindow.
+const (
+	UnlockAttemptLimit  = 10
+	UnlockAttemptWindow = 5 * time.Minute
+)
+
+// AttemptLimiter counts attempts per key in fixed windows, e.g. password checks per page and client IP.
+// It is in memory, so every API instance enforces its own limit.
+type AttemptLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu       sync.Mutex
+	attempts map[string]attemptWindow
+	swept    time.Time
+}
+
+type attemptWindow struct {
+	start time.Time
+	count int
+}
+
+// NewAttemptLimiter allows limit attempts per key within each window.
+func NewAttemptLimiter(limit int, window time.Duration) *AttemptLimiter {
+	return &AttemptLimiter{limit: limit, window: window, attempts: make(map[string]attemptWindow)}
+}
+
+// Allow records an attempt for key at now. When the key is over its limit it returns false and how long
+// until the window ends.
+func (l *AttemptLimiter) Allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	current, ok := l.attempts[key]
+	if !ok || now.Sub(current.start) >= l.window {
+		current = attemptWindow{start: now}
+	}
+
+	if current.count >= l.limit {
+		return false, current.start.Add(l.window).Sub(now)
+	}
+
+	current.count++
+	l.attempts[key] = current
+	return true, 0
+}
+
+// sweep drops ended windows at most once per window, so keys of one-off clients do not pile up.
+func (l *AttemptLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	l.swept = now
+
+	for key, current := range l.attempts {
+		if now.Sub(current.start) >= l.window {
+			delete(l.attempts, key)
+		}
+	}
+}
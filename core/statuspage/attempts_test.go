@@ -0,0 +1,44 @@
+package statuspage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttemptLimiter(t *testing.T) {
+	limiter := NewAttemptLimiter(3, time.Minute)
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.Allow("page:1|203.0.113.7", now.Add(time.Duration(i)*time.Second))
+		require.True(t, ok)
+	}
+
+	ok, retryAfter := limiter.Allow("page:1|203.0.113.7", now.Add(10*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, 50*time.Second, retryAfter)
+
+	// Other pages and clients have their own budget.
+	ok, _ = limiter.Allow("page:2|203.0.113.7", now.Add(10*time.Second))
+	assert.True(t, ok)
+	ok, _ = limiter.Allow("page:1|198.51.100.9", now.Add(10*time.Second))
+	assert.True(t, ok)
+
+	// A new window starts once the previous one ends.
+	ok, _ = limiter.Allow("page:1|203.0.113.7", now.Add(time.Minute))
+	assert.True(t, ok)
+}
+
+func TestAttemptLimiterSweepsEndedWindows(t *testing.T) {
+	limiter := NewAttemptLimiter(1, time.Minute)
+	now := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+
+	limiter.Allow("a", now)
+	limiter.Allow("b", now.Add(2*time.Minute))
+
+	require.Len(t, limiter.attempts, 1)
+	require.Contains(t, limiter.attempts, "b")
+}
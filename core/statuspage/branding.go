@@ -0,0 +1,84 @@
+package statuspage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for DecodeConfig
+	_ "image/jpeg" // register JPEG for DecodeConfig
+	_ "image/png"  // register PNG for DecodeConfig
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// ImageLimits bounds the size of an uploaded status page image.
+type ImageLimits struct {
+	MaxBytes     int
+	MaxDimension int
+}
+
+// Limits of the images a status page can carry.
+var (
+	IconLimits    = ImageLimits{MaxBytes: 256 << 10, MaxDimension: 512}
+	LogoLimits    = ImageLimits{MaxBytes: 512 << 10, MaxDimension: 2048}
+	FaviconLimits = ImageLimits{MaxBytes: 64 << 10, MaxDimension: 256}
+)
+
+// allowedImageTypes lists the sniffed content types accepted for uploads. SVG is excluded because
+// it can carry scripts.
+var allowedImageTypes = map[string]struct{}{
+	"image/png":    {},
+	"image/jpeg":   {},
+	"image/gif":    {},
+	"image/webp":   {},
+	"image/x-icon": {},
+}
+
+// ValidateImage checks an uploaded image against the type allowlist and limits and returns its
+// content type. Empty data is valid and means no image.
+func ValidateImage(data []byte, limits ImageLimits) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	if len(data) > limits.MaxBytes {
+		return "", fmt.Errorf("image must be at most %d KB", limits.MaxBytes>>10)
+	}
+
+	contentType := http.DetectContentType(data)
+	if _, ok := allowedImageTypes[contentType]; !ok {
+		return "", errors.New("image must be a PNG, JPEG, GIF, WebP or ICO file")
+	}
+
+	// WebP and ICO have no decoder in the standard library; their byte size limit still applies.
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return contentType, nil
+	}
+	if err != nil {
+		return "", errors.New("image could not be decoded")
+	}
+	if config.Width > limits.MaxDimension || config.Height > limits.MaxDimension {
+		return "", fmt.Errorf("image must be at most %dx%d pixels", limits.MaxDimension, limits.MaxDimension)
+	}
+
+	return contentType, nil
+}
+
+// ValidateBranding checks what struct tags cannot express: header links must be http(s) and the
+// custom CSS must not be able to close the style element it is rendered into.
+func ValidateBranding(branding models.StatusPageBranding) error {
+	for _, link := range branding.HeaderLinks {
+		parsed, err := url.Parse(link.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("header link %q must be an http or https URL", link.Label)
+		}
+	}
+	if strings.Contains(branding.CustomCSS, "<") {
+		return errors.New("custom_css must not contain '<'")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package statuspage
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestValidateImage(t *testing.T) {
+	contentType, err := ValidateImage(nil, IconLimits)
+	require.NoError(t, err)
+	assert.Empty(t, contentType)
+
+	contentType, err = ValidateImage(encodePNG(t, 64, 64), IconLimits)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+
+	_, err = ValidateImage(encodePNG(t, 600, 64), IconLimits)
+	assert.ErrorContains(t, err, "512x512")
+
+	_, err = ValidateImage(bytes.Repeat([]byte{0}, FaviconLimits.MaxBytes+1), FaviconLimits)
+	assert.ErrorContains(t, err, "64 KB")
+
+	_, err = ValidateImage([]byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), LogoLimits)
+	assert.ErrorContains(t, err, "PNG, JPEG, GIF, WebP or ICO")
+
+	_, err = ValidateImage([]byte("\x89PNG\r\n\x1a\ngarbage"), LogoLimits)
+	assert.ErrorContains(t, err, "decoded")
+
+	ico := append([]byte{0, 0, 1, 0, 1, 0}, make([]byte, 32)...)
+	contentType, err = ValidateImage(ico, FaviconLimits)
+	require.NoError(t, err)
+	assert.Equal(t, "image/x-icon", contentType)
+}
+
+func TestValidateBranding(t *testing.T) {
+	valid := models.StatusPageBranding{
+		HeaderLinks: []models.StatusPageHeaderLink{{Label: "Home", URL: "https://acme.com"}},
+		CustomCSS:   `.header { background: url("https://acme.com/bg.png"); }`,
+	}
+	require.NoError(t, ValidateBranding(valid))
+
+	invalidLink := valid
+	invalidLink.HeaderLinks = []models.StatusPageHeaderLink{{Label: "Bad", URL: "javascript:alert(1)"}}
+	assert.ErrorContains(t, ValidateBranding(invalidLink), `"Bad"`)
+
+	invalidCSS := valid
+	invalidCSS.CustomCSS = "body {}</style><script>alert(1)</script>"
+	assert.Error(t, ValidateBranding(invalidCSS))
+}
@@ -0,0 +1,123 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DomainVerificationPrefix prefixes the TXT record value and the HTTP token file content.
+const DomainVerificationPrefix = "kymarium-verification="
+
+// domainVerificationPath is where the HTTP verification token is served on the custom domain.
+const domainVerificationPath = "/.well-known/kymarium-verification.txt"
+
+// DomainClaimTTL is how long a custom domain stays claimed without being verified.
+const DomainClaimTTL = 7 * 24 * time.Hour
+
+// ErrDomainNotVerified means neither the TXT record nor the HTTP token matched.
+var ErrDomainNotVerified = errors.New("domain verification record not found")
+
+// lookupTXT is overridable for testing.
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// NormalizeDomain lowercases a hostname and strips a port and trailing dot. IP addresses,
+// single-label names and invalid labels are rejected.
+func NormalizeDomain(value string) (string, error) {
+	domain := strings.ToLower(strings.TrimSpace(value))
+	if host, _, err := net.SplitHostPort(domain); err == nil {
+		domain = host
+	}
+	domain = strings.TrimSuffix(domain, ".")
+
+	if domain == "" || len(domain) > 253 {
+		return "", errors.New("domain must be between 1 and 253 characters")
+	}
+	if net.ParseIP(domain) != nil {
+		return "", errors.New("domain must be a hostname, not an IP address")
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", errors.New("domain must contain at least one dot")
+	}
+	for _, label := range labels {
+		if !validDomainLabel(label) {
+			return "", fmt.Errorf("invalid domain label %q", label)
+		}
+	}
+
+	return domain, nil
+}
+
+func validDomainLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, r := range label {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// DomainTXTRecordName is the DNS name the verification TXT record is published under.
+func DomainTXTRecordName(domain string) string {
+	return "_kymarium-challenge." + domain
+}
+
+// DomainVerificationValue is the TXT record value, and the HTTP token file content, proving ownership.
+func DomainVerificationValue(token string) string {
+	return DomainVerificationPrefix + token
+}
+
+// DomainVerificationURL is where the HTTP token file must be served.
+func DomainVerificationURL(domain string) string {
+	return "http://" + domain + domainVerificationPath
+}
+
+// VerifyDomain checks the TXT record first and falls back to the HTTP token file.
+// It returns the method that succeeded ("dns" or "http"). A nil client uses one from NewWebhookClient, since
+// the domain may resolve to an internal address.
+func VerifyDomain(ctx context.Context, client *http.Client, domain, token string) (string, error) {
+	if token == "" {
+		return "", ErrDomainNotVerified
+	}
+	expected := DomainVerificationValue(token)
+
+	if records, err := lookupTXT(ctx, DomainTXTRecordName(domain)); err == nil {
+		for _, record := range records {
+			if strings.TrimSpace(record) == expected {
+				return "dns", nil
+			}
+		}
+	}
+
+	if client == nil {
+		client = defaultWebhookClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DomainVerificationURL(domain), nil)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", ErrDomainNotVerified
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode == http.StatusOK && strings.TrimSpace(string(body)) == expected {
+		return "http", nil
+	}
+
+	return "", ErrDomainNotVerified
+}
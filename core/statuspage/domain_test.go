@@ -0,0 +1,91 @@
+package statuspage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDomain(t *testing.T) {
+	valid := map[string]string{
+		"Status.Example.com":      "status.example.com",
+		" status.example.com. ":   "status.example.com",
+		"status.example.com:8443": "status.example.com",
+		"a-b.example.co.uk":       "a-b.example.co.uk",
+	}
+	for input, want := range valid {
+		got, err := NormalizeDomain(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got)
+	}
+
+	for _, input := range []string{"", "localhost", "10.0.0.1", "-bad.example.com", "bad_.example.com", "a..example.com", "https://example.com"} {
+		_, err := NormalizeDomain(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func stubLookupTXT(t *testing.T, fn func(ctx context.Context, name string) ([]string, error)) {
+	original := lookupTXT
+	lookupTXT = fn
+	t.Cleanup(func() { lookupTXT = original })
+}
+
+func TestVerifyDomainDNS(t *testing.T) {
+	stubLookupTXT(t, func(_ context.Context, name string) ([]string, error) {
+		assert.Equal(t, "_kymarium-challenge.status.example.com", name)
+		return []string{"other", "kymarium-verification=tok"}, nil
+	})
+
+	method, err := VerifyDomain(context.Background(), nil, "status.example.com", "tok")
+	require.NoError(t, err)
+	assert.Equal(t, "dns", method)
+}
+
+func TestVerifyDomainHTTPFallback(t *testing.T) {
+	stubLookupTXT(t, func(context.Context, string) ([]string, error) {
+		return nil, errors.New("no such host")
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/kymarium-verification.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("kymarium-verification=tok\n"))
+	}))
+	defer server.Close()
+
+	domain := strings.TrimPrefix(server.URL, "http://")
+
+	method, err := VerifyDomain(context.Background(), server.Client(), domain, "tok")
+	require.NoError(t, err)
+	assert.Equal(t, "http", method)
+
+	_, err = VerifyDomain(context.Background(), server.Client(), domain, "other")
+	assert.ErrorIs(t, err, ErrDomainNotVerified)
+}
+
+func TestVerifyDomainHTTPRefusesLoopback(t *testing.T) {
+	stubLookupTXT(t, func(context.Context, string) ([]string, error) {
+		return nil, errors.New("no such host")
+	})
+
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		_, _ = w.Write([]byte("kymarium-verification=tok\n"))
+	}))
+	defer server.Close()
+
+	// The default client refuses to connect to the loopback test server.
+	_, err := VerifyDomain(context.Background(), nil, strings.TrimPrefix(server.URL, "http://"), "tok")
+	assert.ErrorIs(t, err, ErrDomainNotVerified)
+	assert.False(t, requested)
+}
@@ -0,0 +1,254 @@
+package statuspage
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// maxFeedEntries bounds how many incidents a feed lists, newest first.
+const maxFeedEntries = 50
+
+// Feed is the format-neutral content of a status page incident feed.
+type Feed struct {
+	Title   string
+	Link    string
+	SelfURL string
+	Updated time.Time
+	Entries []FeedEntry
+}
+
+// FeedEntry is one incident of a feed. ID never changes for an incident, so readers keep a single item per incident.
+type FeedEntry struct {
+	ID        string
+	Title     string
+	Link      string
+	Content   string
+	Published time.Time
+	Updated   time.Time
+}
+
+// BuildFeed turns public incidents and their public timeline events into a feed.
+// Incidents are expected newest first and unique; events may belong to any of them.
+func BuildFeed(page models.StatusPage, link, selfURL string, incidents []models.Incident, events []models.EventTimeline) Feed {
+	eventsByIncident := make(map[int64][]models.EventTimeline, len(incidents))
+	for _, event := range events {
+		eventsByIncident[event.IncidentID] = append(eventsByIncident[event.IncidentID], event)
+	}
+
+	if len(incidents) > maxFeedEntries {
+		incidents = incidents[:maxFeedEntries]
+	}
+
+	feed := Feed{
+		Title:   page.Title + " status",
+		Link:    link,
+		SelfURL: selfURL,
+		Updated: page.UpdatedAt,
+		Entries: make([]FeedEntry, 0, len(incidents)),
+	}
+
+	for _, incident := range incidents {
+		timeline := eventsByIncident[incident.ID]
+		updated := incident.UpdatedAt
+		for _, event := range timeline {
+			updated = latest(updated, event.CreatedAt, event.UpdatedAt)
+		}
+
+		entry := FeedEntry{
+			ID:        "urn:kymarium:incident:" + strconv.FormatInt(incident.ID, 10),
+			Title:     feedIncidentTitle(incident),
+			Link:      link + "#incident-" + strconv.FormatInt(incident.ID, 10),
+			Content:   feedIncidentContent(incident, timeline),
+			Published: incident.StartedAt,
+			Updated:   updated,
+		}
+		feed.Updated = latest(feed.Updated, entry.Updated)
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed
+}
+
+// LastModified is the feed's update time at the second precision of HTTP dates.
+func (f Feed) LastModified() time.Time {
+	return f.Updated.UTC().Truncate(time.Second)
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	AtomLink      atomLink  `xml:"atom:link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RenderRSS renders the feed as RSS 2.0.
+func RenderRSS(feed Feed) ([]byte, error) {
+	doc := rssDocument{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:         feed.Title,
+			Link:          feed.Link,
+			AtomLink:      atomLink{Href: feed.SelfURL, Rel: "self", Type: "application/rss+xml"},
+			Description:   "Incident history for " + feed.Title,
+			LastBuildDate: feed.Updated.UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(feed.Entries)),
+		},
+	}
+
+	for _, entry := range feed.Entries {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       entry.Title,
+			Link:        entry.Link,
+			GUID:        rssGUID{Value: entry.ID},
+			PubDate:     entry.Published.UTC().Format(time.RFC1123Z),
+			Description: entry.Content,
+		})
+	}
+
+	return marshalFeed(doc)
+}
+
+type atomDocument struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// RenderAtom renders the feed as Atom 1.0.
+func RenderAtom(feed Feed) ([]byte, error) {
+	doc := atomDocument{
+		XMLNS:   "http://www.w3.org/2005/Atom",
+		ID:      feed.SelfURL,
+		Title:   feed.Title,
+		Updated: feed.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: feed.Link, Rel: "alternate"},
+			{Href: feed.SelfURL, Rel: "self", Type: "application/atom+xml"},
+		},
+		Entries: make([]atomEntry, 0, len(feed.Entries)),
+	}
+
+	for _, entry := range feed.Entries {
+		doc.Entries = append(doc.Entries, atomEntry{
+			ID:        entry.ID,
+			Title:     entry.Title,
+			Link:      atomLink{Href: entry.Link, Rel: "alternate"},
+			Published: entry.Published.UTC().Format(time.RFC3339),
+			Updated:   entry.Updated.UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: entry.Content},
+		})
+	}
+
+	return marshalFeed(doc)
+}
+
+func marshalFeed(doc any) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal feed: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func feedIncidentTitle(incident models.Incident) string {
+	if incident.Title != nil && strings.TrimSpace(*incident.Title) != "" {
+		return strings.TrimSpace(*incident.Title)
+	}
+	return "Incident " + strconv.FormatInt(incident.ID, 10)
+}
+
+// feedIncidentContent lists the public updates of an incident as HTML, newest first like a status page.
+func feedIncidentContent(incident models.Incident, timeline []models.EventTimeline) string {
+	sorted := append([]models.EventTimeline(nil), timeline...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<p><strong>Status: %s</strong></p>", html.EscapeString(statusLabel(incident.Status)))
+	for _, event := range sorted {
+		fmt.Fprintf(&b, "<p><small>%s</small><br><strong>%s</strong> - %s</p>",
+			event.CreatedAt.UTC().Format("Jan 2, 15:04 UTC"),
+			html.EscapeString(EventLabel(event.EventType)),
+			html.EscapeString(event.Message))
+	}
+
+	return b.String()
+}
+
+// EventLabel is the human readable name of a public timeline event type.
+func EventLabel(eventType models.EventType) string {
+	switch eventType {
+	case models.IncidentEventTypeManuallyResolved, models.IncidentEventTypeAutoResolved:
+		return "Resolved"
+	case "":
+		return "Update"
+	default:
+		label := strings.ReplaceAll(string(eventType), "_", " ")
+		return strings.ToUpper(label[:1]) + label[1:]
+	}
+}
+
+func latest(times ...time.Time) time.Time {
+	var result time.Time
+	for _, t := range times {
+		if t.After(result) {
+			result = t
+		}
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package statuspage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestBuildFeedUsesLatestEventAsUpdated(t *testing.T) {
+	started := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	incidents := []models.Incident{{ID: 7, Status: models.IncidentStatusResolved, StartedAt: started, UpdatedAt: started}}
+	events := []models.EventTimeline{
+		{IncidentID: 7, Message: "Investigating", EventType: models.IncidentEventTypeInvestigating, CreatedAt: started, UpdatedAt: started},
+		{IncidentID: 7, Message: "Fixed <now>", EventType: models.IncidentEventTypeManuallyResolved, CreatedAt: started.Add(time.Hour), UpdatedAt: started.Add(time.Hour)},
+	}
+
+	feed := BuildFeed(models.StatusPage{Title: "Acme", UpdatedAt: started.Add(-time.Hour)}, "https://example.com/p", "https://example.com/p/feed.rss", incidents, events)
+
+	require.Len(t, feed.Entries, 1)
+	entry := feed.Entries[0]
+	assert.Equal(t, "urn:kymarium:incident:7", entry.ID)
+	assert.Equal(t, "Incident 7", entry.Title)
+	assert.Equal(t, started, entry.Published)
+	assert.Equal(t, started.Add(time.Hour), entry.Updated)
+	assert.Equal(t, started.Add(time.Hour), feed.Updated)
+	assert.Contains(t, entry.Content, "Fixed &lt;now&gt;")
+	assert.Less(t, strings.Index(entry.Content, "Resolved"), strings.Index(entry.Content, "Investigating"))
+}
+
+func TestRenderRSS(t *testing.T) {
+	published := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	body, err := RenderRSS(Feed{
+		Title:   "Acme status",
+		Link:    "https://example.com/p",
+		SelfURL: "https://example.com/p/feed.rss",
+		Updated: published,
+		Entries: []FeedEntry{{ID: "urn:kymarium:incident:7", Title: "Outage", Link: "https://example.com/p#incident-7", Content: "<p>down</p>", Published: published, Updated: published}},
+	})
+	require.NoError(t, err)
+
+	rss := string(body)
+	assert.Contains(t, rss, `<guid isPermaLink="false">urn:kymarium:incident:7</guid>`)
+	assert.Contains(t, rss, "<pubDate>Thu, 01 Jan 2026 10:00:00 +0000</pubDate>")
+	assert.Contains(t, rss, "&lt;p&gt;down&lt;/p&gt;")
+	assert.Contains(t, rss, `rel="self"`)
+}
+
+func TestEventLabel(t *testing.T) {
+	assert.Equal(t, "Resolved", EventLabel(models.IncidentEventTypeAutoResolved))
+	assert.Equal(t, "Investigating", EventLabel(models.IncidentEventTypeInvestigating))
+	assert.Equal(t, "Notification sent", EventLabel(models.IncidentEventTypeNotificationSent))
+}
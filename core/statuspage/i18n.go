@@ -0,0 +1,256 @@
+package statuspage
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yorukot/kymarium/models"
+)
+
+// DefaultLocale is the language of a status page that did not choose one.
+const DefaultLocale = "en"
+
+// Locales are the languages status pages can be written in and served with translated system strings.
+var Locales = []string{"en", "zh-TW", "ja", "de", "fr", "es"}
+
+// MatchLocale returns the supported locale for a language tag, matched case-insensitively on the full
+// tag first and on its base language second (so "ja-JP" is "ja" and "zh-Hant" is "zh-TW").
+func MatchLocale(tag string) (string, bool) {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	if tag == "" {
+		return "", false
+	}
+
+	for _, locale := range Locales {
+		if strings.EqualFold(locale, tag) {
+			return locale, true
+		}
+	}
+
+	base, _, _ := strings.Cut(tag, "-")
+	for _, locale := range Locales {
+		localeBase, _, _ := strings.Cut(locale, "-")
+		if strings.EqualFold(localeBase, base) {
+			return locale, true
+		}
+	}
+
+	return "", false
+}
+
+// NegotiateLocale picks the locale of a public request: an explicit lang wins, then the preferred
+// supported language of the Accept-Language header, then fallback.
+func NegotiateLocale(lang, acceptLanguage, fallback string) string {
+	if locale, ok := MatchLocale(lang); ok {
+		return locale
+	}
+
+	type preference struct {
+		tag     string
+		quality float64
+	}
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if tag == "" || tag == "*" || quality <= 0 {
+			continue
+		}
+		preferences = append(preferences, preference{tag: tag, quality: quality})
+	}
+	sort.SliceStable(preferences, func(i, j int) bool { return preferences[i].quality > preferences[j].quality })
+
+	for _, pref := range preferences {
+		if locale, ok := MatchLocale(pref.tag); ok {
+			return locale
+		}
+	}
+
+	return fallback
+}
+
+// Translate returns the translation of text for locale, or text itself when there is none.
+func Translate(text string, translations models.Translations, locale string) string {
+	if translated, ok := translations[locale]; ok && strings.TrimSpace(translated) != "" {
+		return translated
+	}
+	return text
+}
+
+// ValidateTranslations checks that every key of translations is a supported locale other than the
+// default one and that no translation is longer than maxLen characters.
+func ValidateTranslations(field string, translations models.Translations, defaultLocale string, maxLen int) error {
+	for locale, text := range translations {
+		matched, ok := MatchLocale(locale)
+		if !ok || matched != locale {
+			return fmt.Errorf("%s: unsupported locale %q", field, locale)
+		}
+		if locale == defaultLocale {
+			return fmt.Errorf("%s: %q is the default locale", field, locale)
+		}
+		if len([]rune(text)) > maxLen {
+			return fmt.Errorf("%s: %s translation must be at most %d characters", field, locale, maxLen)
+		}
+	}
+	return nil
+}
+
+// labels holds the system strings of a status page per locale. Every locale has the same keys.
+var labels = map[string]map[string]string{
+	"en": {
+		"status.up":                    "Operational",
+		"status.down":                  "Outage",
+		"status.maintenance":           "Under maintenance",
+		"page.operational":             "All systems operational",
+		"page.outage":                  "Some systems are experiencing issues",
+		"page.maintenance":             "Maintenance in progress",
+		"incident.detected":            "Detected",
+		"incident.investigating":       "Investigating",
+		"incident.identified":          "Identified",
+		"incident.monitoring":          "Monitoring",
+		"incident.resolved":            "Resolved",
+		"maintenance.scheduled":        "Scheduled",
+		"maintenance.in_progress":      "In progress",
+		"maintenance.completed":        "Completed",
+		"section.incidents":            "Incidents",
+		"section.active_maintenance":   "Ongoing maintenance",
+		"section.upcoming_maintenance": "Upcoming maintenance",
+		"section.no_incidents":         "No incidents reported",
+		"timeline.uptime":              "Uptime",
+		"timeline.no_data":             "No data",
+		"subscribe":                    "Subscribe to updates",
+	},
+	"zh-TW": {
+		"status.up":                    "正常運作",
+		"status.down":                  "服務中斷",
+		"status.maintenance":           "維護中",
+		"page.operational":             "所有系統正常運作",
+		"page.outage":                  "部分系統發生問題",
+		"page.maintenance":             "正在進行維護",
+		"incident.detected":            "已偵測",
+		"incident.investigating":       "調查中",
+		"incident.identified":          "已確認原因",
+		"incident.monitoring":          "監控中",
+		"incident.resolved":            "已解決",
+		"maintenance.scheduled":        "已排程",
+		"maintenance.in_progress":      "進行中",
+		"maintenance.completed":        "已完成",
+		"section.incidents":            "事件",
+		"section.active_maintenance":   "進行中的維護",
+		"section.upcoming_maintenance": "即將進行的維護",
+		"section.no_incidents":         "沒有回報的事件",
+		"timeline.uptime":              "可用率",
+		"timeline.no_data":             "無資料",
+		"subscribe":                    "訂閱更新",
+	},
+	"ja": {
+		"status.up":                    "正常",
+		"status.down":                  "障害",
+		"status.maintenance":           "メンテナンス中",
+		"page.operational":             "すべてのシステムは正常に稼働しています",
+		"page.outage":                  "一部のシステムで問題が発生しています",
+		"page.maintenance":             "メンテナンスを実施中です",
+		"incident.detected":            "検知",
+		"incident.investigating":       "調査中",
+		"incident.identified":          "原因特定",
+		"incident.monitoring":          "経過観察中",
+		"incident.resolved":            "解決済み",
+		"maintenance.scheduled":        "予定",
+		"maintenance.in_progress":      "実施中",
+		"maintenance.completed":        "完了",
+		"section.incidents":            "インシデント",
+		"section.active_maintenance":   "実施中のメンテナンス",
+		"section.upcoming_maintenance": "予定されているメンテナンス",
+		"section.no_incidents":         "報告されたインシデントはありません",
+		"timeline.uptime":              "稼働率",
+		"timeline.no_data":             "データなし",
+		"subscribe":                    "更新を購読",
+	},
+	"de": {
+		"status.up":                    "Betriebsbereit",
+		"status.down":                  "Ausfall",
+		"status.maintenance":           "In Wartung",
+		"page.operational":             "Alle Systeme betriebsbereit",
+		"page.outage":                  "Bei einigen Systemen treten Probleme auf",
+		"page.maintenance":             "Wartung läuft",
+		"incident.detected":            "Erkannt",
+		"incident.investigating":       "Wird untersucht",
+		"incident.identified":          "Ursache erkannt",
+		"incident.monitoring":          "Wird beobachtet",
+		"incident.resolved":            "Behoben",
+		"maintenance.scheduled":        "Geplant",
+		"maintenance.in_progress":      "Läuft",
+		"maintenance.completed":        "Abgeschlossen",
+		"section.incidents":            "Vorfälle",
+		"section.active_maintenance":   "Laufende Wartung",
+		"section.upcoming_maintenance": "Geplante Wartung",
+		"section.no_incidents":         "Keine Vorfälle gemeldet",
+		"timeline.uptime":              "Verfügbarkeit",
+		"timeline.no_data":             "Keine Daten",
+		"subscribe":                    "Updates abonnieren",
+	},
+	"fr": {
+		"status.up":                    "Opérationnel",
+		"status.down":                  "Panne",
+		"status.maintenance":           "En maintenance",
+		"page.operational":             "Tous les systèmes sont opérationnels",
+		"page.outage":                  "Certains systèmes rencontrent des problèmes",
+		"page.maintenance":             "Maintenance en cours",
+		"incident.detected":            "Détecté",
+		"incident.investigating":       "En cours d'analyse",
+		"incident.identified":          "Cause identifiée",
+		"incident.monitoring":          "Sous surveillance",
+		"incident.resolved":            "Résolu",
+		"maintenance.scheduled":        "Planifiée",
+		"maintenance.in_progress":      "En cours",
+		"maintenance.completed":        "Terminée",
+		"section.incidents":            "Incidents",
+		"section.active_maintenance":   "Maintenance en cours",
+		"section.upcoming_maintenance": "Maintenance planifiée",
+		"section.no_incidents":         "Aucun incident signalé",
+		"timeline.uptime":              "Disponibilité",
+		"timeline.no_data":             "Aucune donnée",
+		"subscribe":                    "S'abonner aux mises à jour",
+	},
+	"es": {
+		"status.up":                    "Operativo",
+		"status.down":                  "Interrupción",
+		"status.maintenance":           "En mantenimiento",
+		"page.operational":             "Todos los sistemas operativos",
+		"page.outage":                  "Algunos sistemas presentan problemas",
+		"page.maintenance":             "Mantenimiento en curso",
+		"incident.detected":            "Detectado",
+		"incident.investigating":       "Investigando",
+		"incident.identified":          "Causa identificada",
+		"incident.monitoring":          "En observación",
+		"incident.resolved":            "Resuelto",
+		"maintenance.scheduled":        "Programado",
+		"maintenance.in_progress":      "En curso",
+		"maintenance.completed":        "Completado",
+		"section.incidents":            "Incidentes",
+		"section.active_maintenance":   "Mantenimiento en curso",
+		"section.upcoming_maintenance": "Mantenimiento programado",
+		"section.no_incidents":         "No se han reportado incidentes",
+		"timeline.uptime":              "Disponibilidad",
+		"timeline.no_data":             "Sin datos",
+		"subscribe":                    "Suscribirse a las actualizaciones",
+	},
+}
+
+// Labels returns a copy of the system strings of a status page in locale, falling back to English.
+func Labels(locale string) map[string]string {
+	if localized, ok := labels[locale]; ok {
+		return maps.Clone(localized)
+	}
+	return maps.Clone(labels[DefaultLocale])
+}
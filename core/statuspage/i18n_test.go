@@ -0,0 +1,61 @@
+package statuspage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestMatchLocale(t *testing.T) {
+	cases := map[string]string{
+		"ja":      "ja",
+		"ja-JP":   "ja",
+		"zh-tw":   "zh-TW",
+		"zh-Hant": "zh-TW",
+		"de_AT":   "de",
+		"en-GB":   "en",
+	}
+	for tag, want := range cases {
+		got, ok := MatchLocale(tag)
+		require.True(t, ok, tag)
+		require.Equal(t, want, got, tag)
+	}
+
+	_, ok := MatchLocale("ko")
+	require.False(t, ok)
+	_, ok = MatchLocale("")
+	require.False(t, ok)
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	require.Equal(t, "ja", NegotiateLocale("ja", "de", "en"))
+	require.Equal(t, "fr", NegotiateLocale("ko", "ko-KR, de;q=0.8, fr;q=0.9", "en"))
+	require.Equal(t, "fr", NegotiateLocale("", "de;q=0.5, fr-CA", "en"))
+	require.Equal(t, "zh-TW", NegotiateLocale("", "zh-TW,zh;q=0.9,en-US;q=0.8", "ja"))
+	require.Equal(t, "ja", NegotiateLocale("", "ko, *;q=0.1, de;q=0", "ja"))
+	require.Equal(t, "en", NegotiateLocale("", "", "en"))
+}
+
+func TestTranslate(t *testing.T) {
+	translations := models.Translations{"ja": "API サーバー", "de": " "}
+	require.Equal(t, "API サーバー", Translate("API server", translations, "ja"))
+	require.Equal(t, "API server", Translate("API server", translations, "de"))
+	require.Equal(t, "API server", Translate("API server", nil, "fr"))
+}
+
+func TestValidateTranslations(t *testing.T) {
+	require.NoError(t, ValidateTranslations("title", models.Translations{"ja": "ステータス", "zh-TW": "狀態"}, "en", 10))
+	require.Error(t, ValidateTranslations("title", models.Translations{"ko": "상태"}, "en", 10))
+	require.Error(t, ValidateTranslations("title", models.Translations{"JA": "ステータス"}, "en", 10))
+	require.Error(t, ValidateTranslations("title", models.Translations{"en": "Status"}, "en", 10))
+	require.Error(t, ValidateTranslations("title", models.Translations{"ja": "ステータスページです"}, "en", 5))
+}
+
+func TestLabels(t *testing.T) {
+	for _, locale := range Locales {
+		require.Len(t, Labels(locale), len(Labels(DefaultLocale)), locale)
+	}
+	require.Equal(t, "メンテナンス中", Labels("ja")["status.maintenance"])
+	require.Equal(t, "Operational", Labels("ko")["status.up"])
+}
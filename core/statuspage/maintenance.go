@@ -0,0 +1,147 @@
+package statuspage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/mailer"
+)
+
+// MaintenanceStatusAt is the status a maintenance window has at now.
+func MaintenanceStatusAt(maintenance models.Maintenance, now time.Time) models.MaintenanceStatus {
+	switch {
+	case !now.Before(maintenance.EndsAt):
+		return models.MaintenanceStatusCompleted
+	case !now.Before(maintenance.StartsAt):
+		return models.MaintenanceStatusInProgress
+	default:
+		return models.MaintenanceStatusScheduled
+	}
+}
+
+// MaintenanceStatusMessage is the timeline message recorded when a maintenance reaches status on its own.
+func MaintenanceStatusMessage(status models.MaintenanceStatus) string {
+	switch status {
+	case models.MaintenanceStatusInProgress:
+		return "Scheduled maintenance is in progress."
+	case models.MaintenanceStatusCompleted:
+		return "Scheduled maintenance has been completed."
+	default:
+		return "Maintenance has been scheduled."
+	}
+}
+
+// MaintenanceAffects reports whether a subscriber wants updates about a maintenance. Maintenance without
+// monitors concerns the whole page and reaches every subscriber.
+func MaintenanceAffects(subscriber models.StatusPageSubscriber, maintenance models.Maintenance) bool {
+	return len(maintenance.MonitorIDs) == 0 || Matches(subscriber, maintenance.MonitorIDs)
+}
+
+// MaintenanceUpdate is a maintenance timeline entry sent to the subscribers of a status page.
+type MaintenanceUpdate struct {
+	PageTitle      string
+	PageSlug       string
+	MaintenanceID  int64
+	Title          string
+	Status         models.MaintenanceStatus
+	StartsAt       time.Time
+	EndsAt         time.Time
+	Message        string
+	CreatedAt      time.Time
+	UnsubscribeURL string
+}
+
+// BuildMaintenanceEmail renders a maintenance update for an email subscriber.
+func BuildMaintenanceEmail(from, to string, update MaintenanceUpdate) (mailer.Message, error) {
+	status := maintenanceStatusLabel(update.Status)
+	window := maintenanceWindow(update)
+	subject := fmt.Sprintf("[%s] %s: %s", update.PageTitle, update.Title, status)
+
+	lines := []string{"Window: " + window, ""}
+	lines = append(lines, strings.Split(strings.TrimSpace(update.Message), "\n")...)
+
+	var html bytes.Buffer
+	if err := emailTemplate.Execute(&html, emailTemplateData{
+		PageTitle:      update.PageTitle,
+		Heading:        update.Title,
+		Status:         status,
+		Lines:          lines,
+		Time:           update.CreatedAt.UTC().Format("Jan 2, 2006 15:04 UTC"),
+		UnsubscribeURL: update.UnsubscribeURL,
+	}); err != nil {
+		return mailer.Message{}, fmt.Errorf("render maintenance email: %w", err)
+	}
+
+	text := fmt.Sprintf("%s\nStatus: %s\nWindow: %s\n\n%s\n\n%s", update.Title, status, window, strings.TrimSpace(update.Message), update.CreatedAt.UTC().Format("Jan 2, 2006 15:04 UTC"))
+	if update.UnsubscribeURL != "" {
+		text += "\n\nUnsubscribe: " + update.UnsubscribeURL
+	}
+
+	return mailer.Message{
+		From:            from,
+		To:              []string{to},
+		Subject:         subject,
+		Text:            text,
+		HTML:            html.String(),
+		Date:            time.Now(),
+		ListUnsubscribe: update.UnsubscribeURL,
+	}, nil
+}
+
+// maintenanceWebhookPayload is the JSON body posted to webhook subscribers for maintenance updates.
+type maintenanceWebhookPayload struct {
+	StatusPage struct {
+		Title string `json:"title"`
+		Slug  string `json:"slug"`
+	} `json:"status_page"`
+	Maintenance struct {
+		ID       string                   `json:"id"`
+		Title    string                   `json:"title"`
+		Status   models.MaintenanceStatus `json:"status"`
+		StartsAt time.Time                `json:"starts_at"`
+		EndsAt   time.Time                `json:"ends_at"`
+	} `json:"maintenance"`
+	Update struct {
+		Message   string    `json:"message"`
+		CreatedAt time.Time `json:"created_at"`
+	} `json:"update"`
+	UnsubscribeURL string `json:"unsubscribe_url"`
+}
+
+// SendMaintenanceWebhook posts a maintenance update to a webhook subscriber. Any non-2xx response is an error.
+func SendMaintenanceWebhook(ctx context.Context, client *http.Client, target string, update MaintenanceUpdate) error {
+	var payload maintenanceWebhookPayload
+	payload.StatusPage.Title = update.PageTitle
+	payload.StatusPage.Slug = update.PageSlug
+	payload.Maintenance.ID = strconv.FormatInt(update.MaintenanceID, 10)
+	payload.Maintenance.Title = update.Title
+	payload.Maintenance.Status = update.Status
+	payload.Maintenance.StartsAt = update.StartsAt
+	payload.Maintenance.EndsAt = update.EndsAt
+	payload.Update.Message = update.Message
+	payload.Update.CreatedAt = update.CreatedAt
+	payload.UnsubscribeURL = update.UnsubscribeURL
+
+	return postWebhook(ctx, client, target, payload)
+}
+
+func maintenanceStatusLabel(status models.MaintenanceStatus) string {
+	switch status {
+	case models.MaintenanceStatusInProgress:
+		return "In progress"
+	case models.MaintenanceStatusCompleted:
+		return "Completed"
+	default:
+		return "Scheduled"
+	}
+}
+
+func maintenanceWindow(update MaintenanceUpdate) string {
+	return update.StartsAt.UTC().Format("Jan 2, 2006 15:04") + " - " + update.EndsAt.UTC().Format("Jan 2, 2006 15:04 UTC")
+}
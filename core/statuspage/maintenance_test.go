@@ -0,0 +1,77 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestMaintenanceStatusAt(t *testing.T) {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	maintenance := models.Maintenance{StartsAt: start, EndsAt: start.Add(2 * time.Hour)}
+
+	assert.Equal(t, models.MaintenanceStatusScheduled, MaintenanceStatusAt(maintenance, start.Add(-time.Second)))
+	assert.Equal(t, models.MaintenanceStatusInProgress, MaintenanceStatusAt(maintenance, start))
+	assert.Equal(t, models.MaintenanceStatusInProgress, MaintenanceStatusAt(maintenance, start.Add(time.Hour)))
+	assert.Equal(t, models.MaintenanceStatusCompleted, MaintenanceStatusAt(maintenance, start.Add(2*time.Hour)))
+}
+
+func TestMaintenanceAffects(t *testing.T) {
+	components := models.StatusPageSubscriber{MonitorIDs: []int64{1}}
+
+	assert.True(t, MaintenanceAffects(components, models.Maintenance{}))
+	assert.True(t, MaintenanceAffects(components, models.Maintenance{MonitorIDs: []int64{1, 2}}))
+	assert.False(t, MaintenanceAffects(components, models.Maintenance{MonitorIDs: []int64{2}}))
+}
+
+func TestBuildMaintenanceEmail(t *testing.T) {
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	update := MaintenanceUpdate{
+		PageTitle:      "Acme",
+		Title:          "Database upgrade",
+		Status:         models.MaintenanceStatusInProgress,
+		StartsAt:       start,
+		EndsAt:         start.Add(2 * time.Hour),
+		Message:        MaintenanceStatusMessage(models.MaintenanceStatusInProgress),
+		CreatedAt:      start,
+		UnsubscribeURL: "https://api.example.com/unsubscribe",
+	}
+
+	email, err := BuildMaintenanceEmail("", "visitor@example.com", update)
+	require.NoError(t, err)
+
+	assert.Equal(t, "[Acme] Database upgrade: In progress", email.Subject)
+	assert.Contains(t, email.Text, "Window: May 1, 2026 10:00 - May 1, 2026 12:00 UTC")
+	assert.Contains(t, email.HTML, "Scheduled maintenance is in progress.")
+	assert.Equal(t, update.UnsubscribeURL, email.ListUnsubscribe)
+}
+
+func TestSendMaintenanceWebhook(t *testing.T) {
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := SendMaintenanceWebhook(context.Background(), server.Client(), server.URL, MaintenanceUpdate{
+		PageSlug:      "acme",
+		MaintenanceID: 42,
+		Title:         "Database upgrade",
+		Status:        models.MaintenanceStatusScheduled,
+		Message:       "Planned downtime",
+	})
+	require.NoError(t, err)
+
+	maintenance := received["maintenance"].(map[string]any)
+	assert.Equal(t, "42", maintenance["id"])
+	assert.Equal(t, "scheduled", maintenance["status"])
+	assert.Equal(t, "Planned downtime", received["update"].(map[string]any)["message"])
+}
@@ -0,0 +1,191 @@
+package statuspage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// SnapshotFreshFor is how long a snapshot is served as-is. Changes to incidents, monitor status and
+	// pages invalidate snapshots right away, so this only bounds how far uptime figures lag behind.
+	SnapshotFreshFor = 30 * time.Second
+	// SnapshotStaleFor is how long after going stale a snapshot is still served while a newer one is built.
+	SnapshotStaleFor = 60 * time.Second
+	// maxSnapshots bounds the memory of a cache; a page has one snapshot per requested locale.
+	maxSnapshots = 4096
+)
+
+// Snapshot is a rendered public view of a status page.
+type Snapshot struct {
+	Body        []byte
+	ETag        string
+	GeneratedAt time.Time
+}
+
+// NewSnapshot wraps a rendered body, deriving its strong ETag from the content.
+func NewSnapshot(body []byte, generatedAt time.Time) Snapshot {
+	sum := sha256.Sum256(body)
+	return Snapshot{
+		Body:        body,
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		GeneratedAt: generatedAt,
+	}
+}
+
+// ETagMatches reports whether an If-None-Match header value matches etag.
+func ETagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+type snapshotEntry struct {
+	statusPageID int64
+	snapshot     Snapshot
+	refreshing   bool
+}
+
+// SnapshotCache keeps the rendered public views of status pages in memory. Stale snapshots are served
+// while one caller rebuilds them. All methods are safe on a nil cache, which caches nothing.
+type SnapshotCache struct {
+	mu         sync.Mutex
+	freshFor   time.Duration
+	staleFor   time.Duration
+	entries    map[string]*snapshotEntry
+	generation uint64
+	// invalidated holds the generation of the last invalidation of each page since the cache was
+	// last cleared, at generation cleared.
+	invalidated map[int64]uint64
+	cleared     uint64
+}
+
+// NewSnapshotCache creates a cache serving snapshots fresh for freshFor and stale for staleFor after that.
+func NewSnapshotCache(freshFor, staleFor time.Duration) *SnapshotCache {
+	return &SnapshotCache{
+		freshFor:    freshFor,
+		staleFor:    staleFor,
+		entries:     make(map[string]*snapshotEntry),
+		invalidated: make(map[int64]uint64),
+	}
+}
+
+// Get returns the snapshot stored under key unless it is past its stale period. refresh is true for
+// the first caller getting a stale snapshot, which must rebuild it and call Release when done.
+func (c *SnapshotCache) Get(key string, now time.Time) (snapshot Snapshot, ok bool, refresh bool) {
+	if c == nil {
+		return Snapshot{}, false, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return Snapshot{}, false, false
+	}
+
+	age := now.Sub(entry.snapshot.GeneratedAt)
+	if age >= c.freshFor+c.staleFor {
+		delete(c.entries, key)
+		return Snapshot{}, false, false
+	}
+	if age >= c.freshFor && !entry.refreshing {
+		entry.refreshing = true
+		refresh = true
+	}
+	return entry.snapshot, true, refresh
+}
+
+// Begin returns the generation to pass to Put for a snapshot whose data is about to be read.
+func (c *SnapshotCache) Begin() uint64 {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Put stores the snapshot of a page under key. It is dropped when the page was invalidated after
+// generation, as its data may predate the change.
+func (c *SnapshotCache) Put(key string, statusPageID int64, snapshot Snapshot, generation uint64) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cleared > generation || c.invalidated[statusPageID] > generation {
+		return
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxSnapshots {
+		c.evictOldest()
+	}
+	c.entries[key] = &snapshotEntry{statusPageID: statusPageID, snapshot: snapshot}
+}
+
+// Release ends a refresh started by Get, so a failed refresh is retried by a later caller.
+func (c *SnapshotCache) Release(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok {
+		entry.refreshing = false
+	}
+}
+
+// Invalidate drops every snapshot of a page.
+func (c *SnapshotCache) Invalidate(statusPageID int64) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.invalidated[statusPageID] = c.generation
+	for key, entry := range c.entries {
+		if entry.statusPageID == statusPageID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Clear drops every snapshot, for when invalidations may have been missed.
+func (c *SnapshotCache) Clear() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.cleared = c.generation
+	clear(c.invalidated)
+	clear(c.entries)
+}
+
+func (c *SnapshotCache) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if oldestKey == "" || entry.snapshot.GeneratedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.snapshot.GeneratedAt
+		}
+	}
+	delete(c.entries, oldestKey)
+}
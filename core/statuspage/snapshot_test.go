@@ -0,0 +1,82 @@
+package statuspage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotCache_FreshAndStale(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	cache := NewSnapshotCache(30*time.Second, time.Minute)
+	cache.Put("slug:acme|", 1, NewSnapshot([]byte(`{}`), now), cache.Begin())
+
+	snapshot, ok, refresh := cache.Get("slug:acme|", now.Add(10*time.Second))
+	require.True(t, ok)
+	require.False(t, refresh)
+	require.Equal(t, `{}`, string(snapshot.Body))
+
+	// Only the first caller past the fresh period rebuilds; the others keep getting the stale snapshot.
+	_, ok, refresh = cache.Get("slug:acme|", now.Add(40*time.Second))
+	require.True(t, ok)
+	require.True(t, refresh)
+	_, ok, refresh = cache.Get("slug:acme|", now.Add(41*time.Second))
+	require.True(t, ok)
+	require.False(t, refresh)
+
+	cache.Release("slug:acme|")
+	_, _, refresh = cache.Get("slug:acme|", now.Add(42*time.Second))
+	require.True(t, refresh)
+
+	_, ok, _ = cache.Get("slug:acme|", now.Add(90*time.Second))
+	require.False(t, ok)
+}
+
+func TestSnapshotCache_Invalidate(t *testing.T) {
+	now := time.Now()
+	cache := NewSnapshotCache(time.Minute, time.Minute)
+	cache.Put("slug:acme|", 1, NewSnapshot([]byte(`a`), now), cache.Begin())
+	cache.Put("slug:acme|ja", 1, NewSnapshot([]byte(`b`), now), cache.Begin())
+	cache.Put("slug:other|", 2, NewSnapshot([]byte(`c`), now), cache.Begin())
+
+	// A build that read the page before the change must not store its outdated snapshot.
+	generation := cache.Begin()
+	cache.Invalidate(1)
+	cache.Put("slug:acme|", 1, NewSnapshot([]byte(`old`), now), generation)
+
+	_, ok, _ := cache.Get("slug:acme|", now)
+	require.False(t, ok)
+	_, ok, _ = cache.Get("slug:acme|ja", now)
+	require.False(t, ok)
+	_, ok, _ = cache.Get("slug:other|", now)
+	require.True(t, ok)
+
+	cache.Put("slug:acme|", 1, NewSnapshot([]byte(`new`), now), cache.Begin())
+	_, ok, _ = cache.Get("slug:acme|", now)
+	require.True(t, ok)
+
+	generation = cache.Begin()
+	cache.Clear()
+	cache.Put("slug:other|", 2, NewSnapshot([]byte(`old`), now), generation)
+	_, ok, _ = cache.Get("slug:other|", now)
+	require.False(t, ok)
+}
+
+func TestSnapshotCache_Nil(t *testing.T) {
+	var cache *SnapshotCache
+	cache.Put("slug:acme|", 1, NewSnapshot([]byte(`{}`), time.Now()), cache.Begin())
+	_, ok, _ := cache.Get("slug:acme|", time.Now())
+	require.False(t, ok)
+	cache.Invalidate(1)
+	cache.Clear()
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := NewSnapshot([]byte(`{}`), time.Now()).ETag
+	require.True(t, ETagMatches(etag, etag))
+	require.True(t, ETagMatches(`"other", W/`+etag, etag))
+	require.True(t, ETagMatches("*", etag))
+	require.False(t, ETagMatches(`"other"`, etag))
+	require.False(t, ETagMatches("", etag))
+}
@@ -0,0 +1,213 @@
+// Package statuspage formats and delivers public status page updates to subscribers.
+package statuspage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/mailer"
+)
+
+// ConfirmationResendInterval is how long a repeated email subscription waits before another confirmation email.
+const ConfirmationResendInterval = 10 * time.Minute
+
+// WebhookVerificationInterval is how long a repeated webhook subscription waits before another verification request.
+const WebhookVerificationInterval = time.Minute
+
+// Update is a public incident event sent to the subscribers of a status page.
+type Update struct {
+	PageTitle      string
+	PageSlug       string
+	IncidentID     int64
+	IncidentTitle  string
+	IncidentStatus models.IncidentStatus
+	EventType      models.EventType
+	Message        string
+	CreatedAt      time.Time
+	UnsubscribeURL string
+}
+
+// NewToken returns a random token used in confirmation and unsubscribe links.
+func NewToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Matches reports whether a subscriber wants updates about an incident affecting the given monitors.
+// Subscribers without chosen monitors follow the whole page.
+func Matches(subscriber models.StatusPageSubscriber, monitorIDs []int64) bool {
+	if len(subscriber.MonitorIDs) == 0 {
+		return true
+	}
+
+	for _, wanted := range subscriber.MonitorIDs {
+		for _, affected := range monitorIDs {
+			if wanted == affected {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// PageURL is the public API address of a status page on the API at base.
+func PageURL(base, slug string) string {
+	return strings.TrimRight(base, "/") + "/api/status-pages/" + url.PathEscape(slug)
+}
+
+// SubscriptionURL builds the confirm or unsubscribe link of a subscriber on the API at base.
+func SubscriptionURL(base, slug, action, token string) string {
+	return PageURL(base, slug) + "/subscribers/" + action + "?token=" + url.QueryEscape(token)
+}
+
+// BuildConfirmationEmail renders the double opt-in email sent to a new email subscriber.
+func BuildConfirmationEmail(from, to, pageTitle, confirmURL string) (mailer.Message, error) {
+	var html bytes.Buffer
+	if err := emailTemplate.Execute(&html, emailTemplateData{
+		PageTitle: pageTitle,
+		Heading:   "Confirm your subscription",
+		Lines:     []string{"You asked to receive incident updates from " + pageTitle + ".", "If this was not you, ignore this email and nothing will happen."},
+		ActionURL: confirmURL,
+		Action:    "Confirm subscription",
+	}); err != nil {
+		return mailer.Message{}, fmt.Errorf("render confirmation email: %w", err)
+	}
+
+	return mailer.Message{
+		From:    from,
+		To:      []string{to},
+		Subject: "Confirm your subscription to " + pageTitle,
+		Text:    "You asked to receive incident updates from " + pageTitle + ".\n\nConfirm your subscription: " + confirmURL + "\n\nIf this was not you, ignore this email.",
+		HTML:    html.String(),
+		Date:    time.Now(),
+	}, nil
+}
+
+// BuildUpdateEmail renders an incident update for an email subscriber, with an unsubscribe link and header.
+func BuildUpdateEmail(from, to string, update Update) (mailer.Message, error) {
+	subject := fmt.Sprintf("[%s] %s: %s", update.PageTitle, incidentTitle(update), statusLabel(update.IncidentStatus))
+	lines := strings.Split(strings.TrimSpace(update.Message), "\n")
+
+	var html bytes.Buffer
+	if err := emailTemplate.Execute(&html, emailTemplateData{
+		PageTitle:      update.PageTitle,
+		Heading:        incidentTitle(update),
+		Status:         statusLabel(update.IncidentStatus),
+		Lines:          lines,
+		Time:           update.CreatedAt.UTC().Format("Jan 2, 2006 15:04 UTC"),
+		UnsubscribeURL: update.UnsubscribeURL,
+	}); err != nil {
+		return mailer.Message{}, fmt.Errorf("render update email: %w", err)
+	}
+
+	text := fmt.Sprintf("%s\nStatus: %s\n\n%s\n\n%s", incidentTitle(update), statusLabel(update.IncidentStatus), strings.TrimSpace(update.Message), update.CreatedAt.UTC().Format("Jan 2, 2006 15:04 UTC"))
+	if update.UnsubscribeURL != "" {
+		text += "\n\nUnsubscribe: " + update.UnsubscribeURL
+	}
+
+	return mailer.Message{
+		From:            from,
+		To:              []string{to},
+		Subject:         subject,
+		Text:            text,
+		HTML:            html.String(),
+		Date:            time.Now(),
+		ListUnsubscribe: update.UnsubscribeURL,
+	}, nil
+}
+
+// webhookPayload is the JSON body posted to webhook subscribers.
+type webhookPayload struct {
+	StatusPage struct {
+		Title string `json:"title"`
+		Slug  string `json:"slug"`
+	} `json:"status_page"`
+	Incident struct {
+		ID     string                `json:"id"`
+		Title  string                `json:"title"`
+		Status models.IncidentStatus `json:"status"`
+	} `json:"incident"`
+	Update struct {
+		EventType models.EventType `json:"event_type"`
+		Message   string           `json:"message"`
+		CreatedAt time.Time        `json:"created_at"`
+	} `json:"update"`
+	UnsubscribeURL string `json:"unsubscribe_url"`
+}
+
+// SendWebhook posts an update to a webhook subscriber. Any non-2xx response is an error.
+func SendWebhook(ctx context.Context, client *http.Client, target string, update Update) error {
+	var payload webhookPayload
+	payload.StatusPage.Title = update.PageTitle
+	payload.StatusPage.Slug = update.PageSlug
+	payload.Incident.ID = strconv.FormatInt(update.IncidentID, 10)
+	payload.Incident.Title = incidentTitle(update)
+	payload.Incident.Status = update.IncidentStatus
+	payload.Update.EventType = update.EventType
+	payload.Update.Message = update.Message
+	payload.Update.CreatedAt = update.CreatedAt
+	payload.UnsubscribeURL = update.UnsubscribeURL
+
+	return postWebhook(ctx, client, target, payload)
+}
+
+func incidentTitle(update Update) string {
+	if title := strings.TrimSpace(update.IncidentTitle); title != "" {
+		return title
+	}
+	return "Incident update"
+}
+
+func statusLabel(status models.IncidentStatus) string {
+	if status == "" {
+		return ""
+	}
+	return strings.ToUpper(string(status[:1])) + string(status[1:])
+}
+
+type emailTemplateData struct {
+	PageTitle      string
+	Heading        string
+	Status         string
+	Lines          []string
+	Time           string
+	ActionURL      string
+	Action         string
+	UnsubscribeURL string
+}
+
+var emailTemplate = template.Must(template.New("status_page_email").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin:0;padding:24px;background:#f4f4f5;font-family:-apple-system,BlinkMacSystemFont,'Segoe UI',Roboto,sans-serif;color:#18181b;">
+  <table role="presentation" width="100%" cellpadding="0" cellspacing="0" style="max-width:600px;margin:0 auto;background:#ffffff;border-radius:8px;overflow:hidden;">
+    <tr><td style="padding:24px 24px 0;font-size:13px;font-weight:600;letter-spacing:0.04em;text-transform:uppercase;color:#71717a;">{{.PageTitle}}</td></tr>
+    <tr><td style="padding:8px 24px 4px;font-size:20px;font-weight:600;">{{.Heading}}</td></tr>
+    {{if .Status}}<tr><td style="padding:0 24px 12px;font-size:14px;font-weight:600;color:#52525b;">{{.Status}}</td></tr>{{end}}
+    <tr><td style="padding:0 24px 16px;font-size:14px;line-height:1.6;">
+      {{range .Lines}}{{if .}}{{.}}<br>{{else}}<br>{{end}}{{end}}
+    </td></tr>
+    {{if .Time}}<tr><td style="padding:0 24px 16px;font-size:12px;color:#71717a;">{{.Time}}</td></tr>{{end}}
+    {{if .ActionURL}}<tr><td style="padding:8px 24px 24px;">
+      <a href="{{.ActionURL}}" style="display:inline-block;padding:10px 16px;background:#18181b;color:#ffffff;text-decoration:none;border-radius:6px;font-size:14px;">{{.Action}}</a>
+    </td></tr>{{end}}
+    {{if .UnsubscribeURL}}<tr><td style="padding:16px 24px 24px;font-size:12px;color:#71717a;border-top:1px solid #e4e4e7;">
+      You are receiving this because you subscribed to {{.PageTitle}}. <a href="{{.UnsubscribeURL}}" style="color:#71717a;">Unsubscribe</a>
+    </td></tr>{{end}}
+  </table>
+</body>
+</html>
+`))
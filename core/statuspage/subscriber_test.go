@@ -0,0 +1,106 @@
+package statuspage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+)
+
+func TestMatches(t *testing.T) {
+	wholePage := models.StatusPageSubscriber{}
+	components := models.StatusPageSubscriber{MonitorIDs: []int64{1, 2}}
+
+	assert.True(t, Matches(wholePage, []int64{9}))
+	assert.True(t, Matches(components, []int64{9, 2}))
+	assert.False(t, Matches(components, []int64{3}))
+	assert.False(t, Matches(components, nil))
+}
+
+func TestSubscriptionURL(t *testing.T) {
+	got := SubscriptionURL("https://api.example.com/", "acme status", "unsubscribe", "a+b")
+	assert.Equal(t, "https://api.example.com/api/status-pages/acme%20status/subscribers/unsubscribe?token=a%2Bb", got)
+}
+
+func TestNewTokenIsRandom(t *testing.T) {
+	first, err := NewToken()
+	require.NoError(t, err)
+	second, err := NewToken()
+	require.NoError(t, err)
+
+	assert.Len(t, first, 48)
+	assert.NotEqual(t, first, second)
+}
+
+func TestBuildUpdateEmail(t *testing.T) {
+	update := Update{
+		PageTitle:      "Acme",
+		IncidentTitle:  "API <outage>",
+		IncidentStatus: models.IncidentStatusInvestigating,
+		Message:        "Looking into it",
+		CreatedAt:      time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC),
+		UnsubscribeURL: "https://api.example.com/unsubscribe",
+	}
+
+	email, err := BuildUpdateEmail("status@example.com", "visitor@example.com", update)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"visitor@example.com"}, email.To)
+	assert.Equal(t, "[Acme] API <outage>: Investigating", email.Subject)
+	assert.Equal(t, update.UnsubscribeURL, email.ListUnsubscribe)
+	assert.Contains(t, email.HTML, "API &lt;outage&gt;")
+	assert.Contains(t, email.HTML, update.UnsubscribeURL)
+	assert.Contains(t, email.Text, "Unsubscribe: "+update.UnsubscribeURL)
+}
+
+func TestBuildConfirmationEmail(t *testing.T) {
+	email, err := BuildConfirmationEmail("", "visitor@example.com", "Acme", "https://api.example.com/confirm")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Confirm your subscription to Acme", email.Subject)
+	assert.Contains(t, email.HTML, "https://api.example.com/confirm")
+	assert.Empty(t, email.ListUnsubscribe)
+}
+
+func TestSendWebhook(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := SendWebhook(context.Background(), server.Client(), server.URL, Update{
+		PageTitle:      "Acme",
+		PageSlug:       "acme",
+		IncidentID:     42,
+		IncidentStatus: models.IncidentStatusResolved,
+		EventType:      models.IncidentEventTypeManuallyResolved,
+		Message:        "Fixed",
+		UnsubscribeURL: "https://api.example.com/unsubscribe",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme", body["status_page"].(map[string]any)["slug"])
+	assert.Equal(t, "42", body["incident"].(map[string]any)["id"])
+	assert.Equal(t, "Incident update", body["incident"].(map[string]any)["title"])
+	assert.Equal(t, "Fixed", body["update"].(map[string]any)["message"])
+	assert.Equal(t, "https://api.example.com/unsubscribe", body["unsubscribe_url"])
+}
+
+func TestSendWebhookRejectsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	err := SendWebhook(context.Background(), server.Client(), server.URL, Update{})
+	assert.Error(t, err)
+}
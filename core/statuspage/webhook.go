@@ -0,0 +1,186 @@
+package statuspage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// WebhookTimeout bounds a single request to a subscriber webhook.
+const WebhookTimeout = 10 * time.Second
+
+// maxWebhookResponse is how much of a webhook response is read.
+const maxWebhookResponse = 4096
+
+// ErrNonPublicAddress is returned when a webhook would connect to a loopback, private or otherwise
+// non-public address.
+var ErrNonPublicAddress = errors.New("webhook address is not public")
+
+// nonPublicPrefixes are the special-purpose ranges (RFC 6890 and friends) not covered by the netip helpers.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// defaultWebhookClient is used when callers pass no client.
+var defaultWebhookClient = NewWebhookClient()
+
+// NewWebhookClient returns the HTTP client for subscriber webhooks and domain verification. The hosts come
+// from users, so the client only connects to public addresses, checked on the resolved address when dialing so that
+// DNS rebinding cannot get around it, ignores proxy settings and does not follow redirects.
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !IsPublicAddress(addrPort.Addr()) {
+				return ErrNonPublicAddress
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: WebhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// IsPublicAddress reports whether addr is a globally reachable unicast address.
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() || addr.IsUnspecified() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckWebhookURL validates a webhook URL given by a subscriber: http or https, with a host that is not
+// obviously local. Hostnames are checked again on every connection by NewWebhookClient.
+func CheckWebhookURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("webhook URL must be an http or https URL")
+	}
+	if parsed.User != nil {
+		return fmt.Errorf("webhook URL must not contain credentials")
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("webhook URL must point to a public address")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddress(addr) {
+		return fmt.Errorf("webhook URL must point to a public address")
+	}
+
+	return nil
+}
+
+// webhookVerification is the JSON body of the verification request sent to a new webhook subscriber.
+type webhookVerification struct {
+	Type       string `json:"type"`
+	StatusPage struct {
+		Title string `json:"title"`
+		Slug  string `json:"slug"`
+	} `json:"status_page"`
+	Challenge string `json:"challenge"`
+}
+
+// VerifyWebhook proves that a new webhook subscriber wants the updates of a page: the endpoint must
+// answer the verification request with a 2xx JSON body echoing the challenge, e.g. {"challenge":"..."}.
+func VerifyWebhook(ctx context.Context, client *http.Client, target, pageTitle, pageSlug, challenge string) error {
+	payload := webhookVerification{Type: "subscription.verify", Challenge: challenge}
+	payload.StatusPage.Title = pageTitle
+	payload.StatusPage.Slug = pageSlug
+
+	body, err := doWebhook(ctx, client, target, payload)
+	if err != nil {
+		return err
+	}
+
+	var answer struct {
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(body, &answer); err != nil || answer.Challenge != challenge {
+		return fmt.Errorf("subscriber webhook did not echo the challenge")
+	}
+
+	return nil
+}
+
+// postWebhook posts payload as JSON to target. Any non-2xx response is an error.
+func postWebhook(ctx context.Context, client *http.Client, target string, payload any) error {
+	_, err := doWebhook(ctx, client, target, payload)
+	return err
+}
+
+// doWebhook posts payload as JSON to target and returns the start of the response body. Any non-2xx
+// response is an error.
+func doWebhook(ctx context.Context, client *http.Client, target string, payload any) ([]byte, error) {
+	if client == nil {
+		client = defaultWebhookClient
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponse))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %d from subscriber webhook", resp.StatusCode)
+	}
+
+	return respBody, nil
+}
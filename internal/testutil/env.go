@@ -52,4 +52,5 @@ func seedEnvVars() {
 	_ = os.Setenv("GOOGLE_REDIRECT_URL", "http://localhost/callback")
 	_ = os.Setenv("JWT_SECRET_KEY", "secret")
 	_ = os.Setenv("APP_MACHINE_ID", "1")
+	_ = os.Setenv("TELEGRAM_WEBHOOK_SECRET", "telegram-secret")
 }
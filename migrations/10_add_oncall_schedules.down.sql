@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "public"."user_contact_methods";
+DROP TABLE IF EXISTS "public"."oncall_overrides";
+DROP TABLE IF EXISTS "public"."oncall_layers";
+DROP TABLE IF EXISTS "public"."oncall_schedules";
+
+-- PostgreSQL cannot drop enum values; remove on-call channels so the value is unused.
+DELETE FROM "public"."notifications" WHERE "type" = 'oncall';
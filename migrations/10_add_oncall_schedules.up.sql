@@ -0,0 +1,59 @@
+ALTER TYPE "notification_type" ADD VALUE IF NOT EXISTS 'oncall';
+
+CREATE TABLE "public"."oncall_schedules" (
+    "id" bigint NOT NULL,
+    "team_id" bigint NOT NULL,
+    "name" text NOT NULL,
+    "description" text,
+    "timezone" text NOT NULL,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_oncall_schedules_id" PRIMARY KEY ("id")
+);
+
+CREATE TABLE "public"."oncall_layers" (
+    "id" bigint NOT NULL,
+    "schedule_id" bigint NOT NULL,
+    "position" integer NOT NULL,
+    "name" text NOT NULL,
+    "start_date" date NOT NULL,
+    "handoff_time" text NOT NULL,
+    "rotation_days" integer NOT NULL,
+    "user_ids" bigint[] NOT NULL DEFAULT '{}',
+    CONSTRAINT "pk_oncall_layers_id" PRIMARY KEY ("id")
+);
+
+CREATE TABLE "public"."oncall_overrides" (
+    "id" bigint NOT NULL,
+    "schedule_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    "starts_at" timestamp NOT NULL,
+    "ends_at" timestamp NOT NULL,
+    "created_by" bigint,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_oncall_overrides_id" PRIMARY KEY ("id")
+);
+
+CREATE TABLE "public"."user_contact_methods" (
+    "id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    "type" notification_type NOT NULL,
+    "name" text NOT NULL,
+    "config" jsonb NOT NULL,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_user_contact_methods_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE INDEX "idx_oncall_schedules_team_id" ON "public"."oncall_schedules" ("team_id");
+CREATE UNIQUE INDEX "uq_oncall_layers_schedule_id_position" ON "public"."oncall_layers" ("schedule_id", "position");
+CREATE INDEX "idx_oncall_overrides_schedule_id_ends_at" ON "public"."oncall_overrides" ("schedule_id", "ends_at");
+CREATE INDEX "idx_user_contact_methods_user_id" ON "public"."user_contact_methods" ("user_id");
+
+ALTER TABLE "public"."oncall_schedules" ADD CONSTRAINT "fk_oncall_schedules_team_id_teams_id" FOREIGN KEY("team_id") REFERENCES "public"."teams"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."oncall_layers" ADD CONSTRAINT "fk_oncall_layers_schedule_id_oncall_schedules_id" FOREIGN KEY("schedule_id") REFERENCES "public"."oncall_schedules"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."oncall_overrides" ADD CONSTRAINT "fk_oncall_overrides_schedule_id_oncall_schedules_id" FOREIGN KEY("schedule_id") REFERENCES "public"."oncall_schedules"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."oncall_overrides" ADD CONSTRAINT "fk_oncall_overrides_user_id_users_id" FOREIGN KEY("user_id") REFERENCES "public"."users"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."oncall_overrides" ADD CONSTRAINT "fk_oncall_overrides_created_by_users_id" FOREIGN KEY("created_by") REFERENCES "public"."users"("id") ON DELETE SET NULL;
+ALTER TABLE "public"."user_contact_methods" ADD CONSTRAINT "fk_user_contact_methods_user_id_users_id" FOREIGN KEY("user_id") REFERENCES "public"."users"("id") ON DELETE CASCADE;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "public"."notification_events";
+
+-- Digest deliveries are not tied to a monitor; drop them before restoring the constraint.
+DELETE FROM "public"."notification_deliveries" WHERE "monitor_id" IS NULL;
+ALTER TABLE "public"."notification_deliveries" ALTER COLUMN "monitor_id" SET NOT NULL;
+
+ALTER TABLE "public"."notifications" DROP COLUMN IF EXISTS "digest_interval";
+ALTER TABLE "public"."notifications" DROP COLUMN IF EXISTS "rate_limit";
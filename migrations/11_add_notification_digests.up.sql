@@ -0,0 +1,24 @@
+ALTER TABLE "public"."notifications" ADD COLUMN "rate_limit" integer NOT NULL DEFAULT 20;
+ALTER TABLE "public"."notifications" ADD COLUMN "digest_interval" integer NOT NULL DEFAULT 300;
+
+ALTER TABLE "public"."notification_deliveries" ALTER COLUMN "monitor_id" DROP NOT NULL;
+
+CREATE TABLE "public"."notification_events" (
+    "id" bigint NOT NULL,
+    "notification_id" bigint NOT NULL,
+    "monitor_id" bigint NOT NULL,
+    "monitor_name" text NOT NULL,
+    "event_type" text NOT NULL,
+    "status" text NOT NULL,
+    "held" boolean NOT NULL DEFAULT false,
+    "flushed_at" timestamp,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_notification_events_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE INDEX "idx_notification_events_notification_id_created_at" ON "public"."notification_events" ("notification_id", "created_at");
+CREATE INDEX "idx_notification_events_pending" ON "public"."notification_events" ("notification_id") WHERE "held" AND "flushed_at" IS NULL;
+
+ALTER TABLE "public"."notification_events" ADD CONSTRAINT "fk_notification_events_notification_id_notifications_id" FOREIGN KEY("notification_id") REFERENCES "public"."notifications"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."notification_events" ADD CONSTRAINT "fk_notification_events_monitor_id_monitors_id" FOREIGN KEY("monitor_id") REFERENCES "public"."monitors"("id") ON DELETE CASCADE;
@@ -0,0 +1,3 @@
+ALTER TABLE "public"."notification_events" DROP COLUMN IF EXISTS "severity";
+
+ALTER TABLE "public"."notifications" DROP COLUMN IF EXISTS "quiet_hours";
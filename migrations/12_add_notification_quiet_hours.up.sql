@@ -0,0 +1,3 @@
+ALTER TABLE "public"."notifications" ADD COLUMN "quiet_hours" jsonb;
+
+ALTER TABLE "public"."notification_events" ADD COLUMN "severity" text NOT NULL DEFAULT '';
@@ -0,0 +1,14 @@
+CREATE TABLE "public"."notification_messages" (
+    "id" bigint NOT NULL,
+    "notification_id" bigint NOT NULL,
+    "incident_id" bigint NOT NULL,
+    "message_id" text NOT NULL,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_notification_messages_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE UNIQUE INDEX "uq_notification_messages_notification_id_incident_id" ON "public"."notification_messages" ("notification_id", "incident_id");
+
+ALTER TABLE "public"."notification_messages" ADD CONSTRAINT "fk_notification_messages_notification_id_notifications_id" FOREIGN KEY("notification_id") REFERENCES "public"."notifications"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."notification_messages" ADD CONSTRAINT "fk_notification_messages_incident_id_incidents_id" FOREIGN KEY("incident_id") REFERENCES "public"."incidents"("id") ON DELETE CASCADE;
@@ -0,0 +1 @@
+ALTER TABLE "public"."notification_messages" DROP COLUMN IF EXISTS "muted_at";
@@ -0,0 +1 @@
+ALTER TABLE "public"."notification_messages" ADD COLUMN "muted_at" timestamp;
@@ -0,0 +1,18 @@
+CREATE TABLE "public"."status_page_subscribers" (
+    "id" bigint NOT NULL,
+    "status_page_id" bigint NOT NULL,
+    "type" text NOT NULL,
+    "target" text NOT NULL,
+    "monitor_ids" bigint[] NOT NULL DEFAULT '{}',
+    "token" text NOT NULL,
+    "confirmed_at" timestamp,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_status_page_subscribers_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE UNIQUE INDEX "uq_status_page_subscribers_status_page_id_type_target" ON "public"."status_page_subscribers" ("status_page_id", "type", "target");
+CREATE UNIQUE INDEX "uq_status_page_subscribers_token" ON "public"."status_page_subscribers" ("token");
+
+ALTER TABLE "public"."status_page_subscribers" ADD CONSTRAINT "fk_status_page_subscribers_status_page_id_status_pages_id" FOREIGN KEY("status_page_id") REFERENCES "public"."status_pages"("id") ON DELETE CASCADE;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "uq_status_pages_custom_domain";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "domain_verified_at";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "domain_verification_token";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "custom_domain";
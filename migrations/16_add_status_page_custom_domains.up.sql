@@ -0,0 +1,6 @@
+ALTER TABLE "public"."status_pages" ADD COLUMN "custom_domain" text;
+ALTER TABLE "public"."status_pages" ADD COLUMN "domain_verification_token" text;
+ALTER TABLE "public"."status_pages" ADD COLUMN "domain_verified_at" timestamp;
+
+-- Indexes
+CREATE UNIQUE INDEX "uq_status_pages_custom_domain" ON "public"."status_pages" ("custom_domain") WHERE "custom_domain" IS NOT NULL;
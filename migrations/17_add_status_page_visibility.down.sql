@@ -0,0 +1,4 @@
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "password_hash";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "visibility";
+
+DROP TYPE IF EXISTS "status_page_visibility";
@@ -0,0 +1,4 @@
+CREATE TYPE "status_page_visibility" AS ENUM ('public', 'password', 'team');
+
+ALTER TABLE "public"."status_pages" ADD COLUMN "visibility" "status_page_visibility" NOT NULL DEFAULT 'public';
+ALTER TABLE "public"."status_pages" ADD COLUMN "password_hash" text;
@@ -0,0 +1,13 @@
+CREATE TABLE "public"."monitor_badges" (
+    "monitor_id" bigint NOT NULL,
+    "team_id" bigint NOT NULL,
+    "token" text NOT NULL,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_monitor_badges_monitor_id" PRIMARY KEY ("monitor_id")
+);
+
+-- Indexes
+CREATE UNIQUE INDEX "uq_monitor_badges_token" ON "public"."monitor_badges" ("token");
+
+ALTER TABLE "public"."monitor_badges" ADD CONSTRAINT "fk_monitor_badges_monitor_id_monitors_id" FOREIGN KEY("monitor_id") REFERENCES "public"."monitors"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."monitor_badges" ADD CONSTRAINT "fk_monitor_badges_team_id_teams_id" FOREIGN KEY("team_id") REFERENCES "public"."teams"("id") ON DELETE CASCADE;
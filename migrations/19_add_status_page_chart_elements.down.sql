@@ -0,0 +1,8 @@
+ALTER TABLE "public"."status_page_monitors" DROP COLUMN IF EXISTS "per_region";
+ALTER TABLE "public"."status_page_monitors" DROP COLUMN IF EXISTS "days";
+ALTER TABLE "public"."status_page_groups" DROP COLUMN IF EXISTS "per_region";
+ALTER TABLE "public"."status_page_groups" DROP COLUMN IF EXISTS "days";
+
+-- PostgreSQL cannot drop enum values; fall back to the historical timeline so the values are unused.
+UPDATE "public"."status_page_monitors" SET "type" = 'historical_timeline' WHERE "type" IN ('response_time_chart', 'uptime_bars');
+UPDATE "public"."status_page_groups" SET "type" = 'historical_timeline' WHERE "type" IN ('response_time_chart', 'uptime_bars');
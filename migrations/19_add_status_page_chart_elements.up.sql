@@ -0,0 +1,7 @@
+ALTER TYPE "status_page_element_type" ADD VALUE IF NOT EXISTS 'response_time_chart';
+ALTER TYPE "status_page_element_type" ADD VALUE IF NOT EXISTS 'uptime_bars';
+
+ALTER TABLE "public"."status_page_groups" ADD COLUMN "days" integer;
+ALTER TABLE "public"."status_page_groups" ADD COLUMN "per_region" boolean NOT NULL DEFAULT false;
+ALTER TABLE "public"."status_page_monitors" ADD COLUMN "days" integer;
+ALTER TABLE "public"."status_page_monitors" ADD COLUMN "per_region" boolean NOT NULL DEFAULT false;
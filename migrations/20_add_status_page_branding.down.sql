@@ -0,0 +1,3 @@
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "branding";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "favicon";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "logo";
@@ -0,0 +1,3 @@
+ALTER TABLE "public"."status_pages" ADD COLUMN "logo" bytea;
+ALTER TABLE "public"."status_pages" ADD COLUMN "favicon" bytea;
+ALTER TABLE "public"."status_pages" ADD COLUMN "branding" jsonb NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "public"."maintenance_updates";
+DROP TABLE IF EXISTS "public"."maintenances";
+
+DROP TYPE IF EXISTS "maintenance_status";
@@ -0,0 +1,36 @@
+CREATE TYPE "maintenance_status" AS ENUM ('scheduled', 'in_progress', 'completed');
+
+CREATE TABLE "public"."maintenances" (
+    "id" bigint NOT NULL,
+    "team_id" bigint NOT NULL,
+    "status_page_id" bigint NOT NULL,
+    "title" text NOT NULL,
+    "description" text NOT NULL DEFAULT '',
+    "status" maintenance_status NOT NULL DEFAULT 'scheduled',
+    "monitor_ids" bigint[] NOT NULL DEFAULT '{}',
+    "starts_at" timestamp NOT NULL,
+    "ends_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_maintenances_id" PRIMARY KEY ("id"),
+    CONSTRAINT "ck_maintenances_window" CHECK ("ends_at" > "starts_at")
+);
+
+CREATE TABLE "public"."maintenance_updates" (
+    "id" bigint NOT NULL,
+    "maintenance_id" bigint NOT NULL,
+    "status" maintenance_status NOT NULL,
+    "message" text NOT NULL,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_maintenance_updates_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE INDEX "idx_maintenances_status_page_id_starts_at" ON "public"."maintenances" ("status_page_id", "starts_at");
+CREATE INDEX "idx_maintenances_status" ON "public"."maintenances" ("status") WHERE "status" <> 'completed';
+CREATE INDEX "idx_maintenances_monitor_ids" ON "public"."maintenances" USING GIN ("monitor_ids");
+CREATE INDEX "idx_maintenance_updates_maintenance_id" ON "public"."maintenance_updates" ("maintenance_id");
+
+ALTER TABLE "public"."maintenances" ADD CONSTRAINT "fk_maintenances_team_id_teams_id" FOREIGN KEY("team_id") REFERENCES "public"."teams"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."maintenances" ADD CONSTRAINT "fk_maintenances_status_page_id_status_pages_id" FOREIGN KEY("status_page_id") REFERENCES "public"."status_pages"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."maintenance_updates" ADD CONSTRAINT "fk_maintenance_updates_maintenance_id_maintenances_id" FOREIGN KEY("maintenance_id") REFERENCES "public"."maintenances"("id") ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+ALTER TABLE "public"."event_timelines" DROP COLUMN IF EXISTS "message_translations";
+ALTER TABLE "public"."status_page_monitors" DROP COLUMN IF EXISTS "name_translations";
+ALTER TABLE "public"."status_page_groups" DROP COLUMN IF EXISTS "name_translations";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "title_translations";
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "default_locale";
@@ -0,0 +1,5 @@
+ALTER TABLE "public"."status_pages" ADD COLUMN "default_locale" text NOT NULL DEFAULT 'en';
+ALTER TABLE "public"."status_pages" ADD COLUMN "title_translations" jsonb NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE "public"."status_page_groups" ADD COLUMN "name_translations" jsonb NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE "public"."status_page_monitors" ADD COLUMN "name_translations" jsonb NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE "public"."event_timelines" ADD COLUMN "message_translations" jsonb NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS "trg_event_timelines_notify_changes" ON "public"."event_timelines";
+DROP TRIGGER IF EXISTS "trg_incident_monitors_notify_changes" ON "public"."incident_monitors";
+DROP TRIGGER IF EXISTS "trg_incidents_notify_changes" ON "public"."incidents";
+DROP TRIGGER IF EXISTS "trg_monitors_notify_changes" ON "public"."monitors";
+DROP TRIGGER IF EXISTS "trg_maintenance_updates_notify_changes" ON "public"."maintenance_updates";
+DROP TRIGGER IF EXISTS "trg_maintenances_notify_changes" ON "public"."maintenances";
+DROP TRIGGER IF EXISTS "trg_status_page_monitors_notify_changes" ON "public"."status_page_monitors";
+DROP TRIGGER IF EXISTS "trg_status_page_groups_notify_changes" ON "public"."status_page_groups";
+DROP TRIGGER IF EXISTS "trg_status_pages_notify_changes" ON "public"."status_pages";
+
+DROP FUNCTION IF EXISTS "public"."notify_status_page_changes"();
@@ -0,0 +1,62 @@
+-- Public status page snapshots cached by the API are dropped when anything they show changes. Every
+-- trigger below notifies the ids of the affected pages on the status_page_changes channel; Postgres
+-- delivers them on commit, once per page and transaction.
+CREATE FUNCTION "public"."notify_status_page_changes"() RETURNS trigger AS $$
+DECLARE
+    changed jsonb;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := to_jsonb(OLD);
+    ELSE
+        changed := to_jsonb(NEW);
+    END IF;
+
+    CASE TG_TABLE_NAME
+    WHEN 'status_pages' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'id');
+    WHEN 'status_page_groups', 'status_page_monitors', 'maintenances' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'status_page_id');
+    WHEN 'maintenance_updates' THEN
+        PERFORM pg_notify('status_page_changes', m.status_page_id::text)
+        FROM "public"."maintenances" m
+        WHERE m.id = (changed ->> 'maintenance_id')::bigint;
+    WHEN 'monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'id')::bigint;
+    WHEN 'incident_monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'monitor_id')::bigint;
+    WHEN 'incidents', 'event_timelines' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."incident_monitors" im
+        INNER JOIN "public"."status_page_monitors" spm ON spm.monitor_id = im.monitor_id
+        WHERE im.incident_id = (changed ->> CASE TG_TABLE_NAME WHEN 'incidents' THEN 'id' ELSE 'event_id' END)::bigint;
+    ELSE
+        NULL;
+    END CASE;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "trg_status_pages_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."status_pages"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_status_page_groups_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."status_page_groups"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_status_page_monitors_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."status_page_monitors"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_maintenances_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."maintenances"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_maintenance_updates_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."maintenance_updates"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+-- Monitors are updated on every check; only a status change is visible on a page.
+CREATE TRIGGER "trg_monitors_notify_changes" AFTER UPDATE OF "status" ON "public"."monitors"
+    FOR EACH ROW WHEN (OLD."status" IS DISTINCT FROM NEW."status") EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_incidents_notify_changes" AFTER UPDATE OR DELETE ON "public"."incidents"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_incident_monitors_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."incident_monitors"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
+CREATE TRIGGER "trg_event_timelines_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."event_timelines"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
@@ -0,0 +1,45 @@
+DROP TRIGGER IF EXISTS "trg_component_overrides_notify_changes" ON "public"."component_overrides";
+DROP TABLE IF EXISTS "public"."component_overrides";
+
+CREATE OR REPLACE FUNCTION "public"."notify_status_page_changes"() RETURNS trigger AS $$
+DECLARE
+    changed jsonb;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := to_jsonb(OLD);
+    ELSE
+        changed := to_jsonb(NEW);
+    END IF;
+
+    CASE TG_TABLE_NAME
+    WHEN 'status_pages' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'id');
+    WHEN 'status_page_groups', 'status_page_monitors', 'maintenances' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'status_page_id');
+    WHEN 'maintenance_updates' THEN
+        PERFORM pg_notify('status_page_changes', m.status_page_id::text)
+        FROM "public"."maintenances" m
+        WHERE m.id = (changed ->> 'maintenance_id')::bigint;
+    WHEN 'monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'id')::bigint;
+    WHEN 'incident_monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'monitor_id')::bigint;
+    WHEN 'incidents', 'event_timelines' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."incident_monitors" im
+        INNER JOIN "public"."status_page_monitors" spm ON spm.monitor_id = im.monitor_id
+        WHERE im.incident_id = (changed ->> CASE TG_TABLE_NAME WHEN 'incidents' THEN 'id' ELSE 'event_id' END)::bigint;
+    ELSE
+        NULL;
+    END CASE;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+
+DROP TYPE IF EXISTS "component_status";
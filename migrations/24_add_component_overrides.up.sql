@@ -0,0 +1,71 @@
+CREATE TYPE "component_status" AS ENUM ('operational', 'degraded_performance', 'partial_outage', 'major_outage', 'under_maintenance');
+
+CREATE TABLE "public"."component_overrides" (
+    "id" bigint NOT NULL,
+    "status_page_id" bigint NOT NULL,
+    "group_id" bigint,
+    "monitor_id" bigint,
+    "status" component_status NOT NULL,
+    "message" text NOT NULL DEFAULT '',
+    "expires_at" timestamp,
+    "created_by" bigint,
+    "created_at" timestamp NOT NULL,
+    "cleared_at" timestamp,
+    "cleared_by" bigint,
+    CONSTRAINT "pk_component_overrides_id" PRIMARY KEY ("id"),
+    CONSTRAINT "ck_component_overrides_component" CHECK (("group_id" IS NULL) <> ("monitor_id" IS NULL))
+);
+
+-- Indexes
+CREATE INDEX "idx_component_overrides_status_page_id_created_at" ON "public"."component_overrides" ("status_page_id", "created_at");
+CREATE INDEX "idx_component_overrides_active" ON "public"."component_overrides" ("status_page_id") WHERE "cleared_at" IS NULL;
+
+-- group_id has no foreign key: groups are recreated on page edits and an override of a removed group is simply not shown.
+ALTER TABLE "public"."component_overrides" ADD CONSTRAINT "fk_component_overrides_status_page_id_status_pages_id" FOREIGN KEY("status_page_id") REFERENCES "public"."status_pages"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."component_overrides" ADD CONSTRAINT "fk_component_overrides_monitor_id_monitors_id" FOREIGN KEY("monitor_id") REFERENCES "public"."monitors"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."component_overrides" ADD CONSTRAINT "fk_component_overrides_created_by_users_id" FOREIGN KEY("created_by") REFERENCES "public"."users"("id") ON DELETE SET NULL;
+ALTER TABLE "public"."component_overrides" ADD CONSTRAINT "fk_component_overrides_cleared_by_users_id" FOREIGN KEY("cleared_by") REFERENCES "public"."users"("id") ON DELETE SET NULL;
+
+-- Overrides change what public pages show, like the tables of migration 23.
+CREATE OR REPLACE FUNCTION "public"."notify_status_page_changes"() RETURNS trigger AS $$
+DECLARE
+    changed jsonb;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := to_jsonb(OLD);
+    ELSE
+        changed := to_jsonb(NEW);
+    END IF;
+
+    CASE TG_TABLE_NAME
+    WHEN 'status_pages' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'id');
+    WHEN 'status_page_groups', 'status_page_monitors', 'maintenances', 'component_overrides' THEN
+        PERFORM pg_notify('status_page_changes', changed ->> 'status_page_id');
+    WHEN 'maintenance_updates' THEN
+        PERFORM pg_notify('status_page_changes', m.status_page_id::text)
+        FROM "public"."maintenances" m
+        WHERE m.id = (changed ->> 'maintenance_id')::bigint;
+    WHEN 'monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'id')::bigint;
+    WHEN 'incident_monitors' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."status_page_monitors" spm
+        WHERE spm.monitor_id = (changed ->> 'monitor_id')::bigint;
+    WHEN 'incidents', 'event_timelines' THEN
+        PERFORM pg_notify('status_page_changes', spm.status_page_id::text)
+        FROM "public"."incident_monitors" im
+        INNER JOIN "public"."status_page_monitors" spm ON spm.monitor_id = im.monitor_id
+        WHERE im.incident_id = (changed ->> CASE TG_TABLE_NAME WHEN 'incidents' THEN 'id' ELSE 'event_id' END)::bigint;
+    ELSE
+        NULL;
+    END CASE;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "trg_component_overrides_notify_changes" AFTER INSERT OR UPDATE OR DELETE ON "public"."component_overrides"
+    FOR EACH ROW EXECUTE FUNCTION "public"."notify_status_page_changes"();
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "public"."slos";
+
+DROP TYPE IF EXISTS "slo_calendar_period";
+DROP TYPE IF EXISTS "slo_window_type";
+DROP TYPE IF EXISTS "slo_indicator";
@@ -0,0 +1,37 @@
+CREATE TYPE "slo_indicator" AS ENUM ('availability', 'latency');
+CREATE TYPE "slo_window_type" AS ENUM ('rolling', 'calendar');
+CREATE TYPE "slo_calendar_period" AS ENUM ('week', 'month', 'quarter');
+
+CREATE TABLE "public"."slos" (
+    "id" bigint NOT NULL,
+    "team_id" bigint NOT NULL,
+    "name" text NOT NULL,
+    "description" text NOT NULL DEFAULT '',
+    "monitor_ids" bigint[] NOT NULL,
+    "indicator" slo_indicator NOT NULL,
+    "latency_threshold_ms" integer,
+    "latency_percentile" integer,
+    "target_pct" double precision NOT NULL,
+    "window_type" slo_window_type NOT NULL,
+    "window_days" integer,
+    "calendar_period" slo_calendar_period,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_slos_id" PRIMARY KEY ("id"),
+    CONSTRAINT "ck_slos_monitor_ids" CHECK (cardinality("monitor_ids") > 0),
+    CONSTRAINT "ck_slos_target_pct" CHECK ("target_pct" > 0 AND "target_pct" < 100),
+    CONSTRAINT "ck_slos_latency" CHECK (
+        ("indicator" = 'latency') = ("latency_threshold_ms" IS NOT NULL AND "latency_percentile" IS NOT NULL)
+        AND ("latency_threshold_ms" IS NULL) = ("latency_percentile" IS NULL)
+    ),
+    CONSTRAINT "ck_slos_window" CHECK (
+        ("window_type" = 'rolling' AND "window_days" BETWEEN 1 AND 365 AND "calendar_period" IS NULL)
+        OR ("window_type" = 'calendar' AND "window_days" IS NULL AND "calendar_period" IS NOT NULL)
+    )
+);
+
+-- Indexes
+CREATE INDEX "idx_slos_team_id" ON "public"."slos" ("team_id");
+CREATE INDEX "idx_slos_monitor_ids" ON "public"."slos" USING GIN ("monitor_ids");
+
+ALTER TABLE "public"."slos" ADD CONSTRAINT "fk_slos_team_id_teams_id" FOREIGN KEY("team_id") REFERENCES "public"."teams"("id") ON DELETE CASCADE;
@@ -0,0 +1 @@
+ALTER TABLE "public"."status_page_subscribers" ADD COLUMN "confirmation_sent_at" timestamp;
@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS "public"."idx_status_pages_custom_domain";
+DROP INDEX IF EXISTS "public"."uq_status_pages_custom_domain";
+
+-- Drop unverified claims on a domain that is verified or claimed earlier by another page.
+UPDATE "public"."status_pages" AS "page"
+SET "custom_domain" = NULL, "domain_verification_token" = NULL
+WHERE "page"."domain_verified_at" IS NULL
+  AND EXISTS (
+      SELECT 1 FROM "public"."status_pages" AS "other"
+      WHERE "other"."custom_domain" = "page"."custom_domain"
+        AND "other"."id" <> "page"."id"
+        AND ("other"."domain_verified_at" IS NOT NULL OR "other"."id" < "page"."id")
+  );
+
+CREATE UNIQUE INDEX "uq_status_pages_custom_domain" ON "public"."status_pages" ("custom_domain") WHERE "custom_domain" IS NOT NULL;
+ALTER TABLE "public"."status_pages" DROP COLUMN IF EXISTS "domain_claimed_at";
@@ -0,0 +1,7 @@
+ALTER TABLE "public"."status_pages" ADD COLUMN "domain_claimed_at" timestamp;
+UPDATE "public"."status_pages" SET "domain_claimed_at" = "updated_at" WHERE "custom_domain" IS NOT NULL;
+
+-- Only a verified domain is exclusive, so an unverified claim cannot lock the owner of the domain out.
+DROP INDEX IF EXISTS "public"."uq_status_pages_custom_domain";
+CREATE UNIQUE INDEX "uq_status_pages_custom_domain" ON "public"."status_pages" ("custom_domain") WHERE "domain_verified_at" IS NOT NULL;
+CREATE INDEX "idx_status_pages_custom_domain" ON "public"."status_pages" ("custom_domain") WHERE "custom_domain" IS NOT NULL;
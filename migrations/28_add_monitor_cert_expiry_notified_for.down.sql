@@ -0,0 +1 @@
+ALTER TABLE "public"."monitors" DROP COLUMN IF EXISTS "cert_expiry_notified_for";
@@ -0,0 +1 @@
+ALTER TABLE "public"."monitors" ADD COLUMN "cert_expiry_notified_for" timestamp;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "public"."notification_deliveries";
+DROP TYPE IF EXISTS "notification_delivery_status";
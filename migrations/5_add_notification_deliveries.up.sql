@@ -0,0 +1,28 @@
+CREATE TYPE "notification_delivery_status" AS ENUM ('succeeded', 'failed', 'dead_letter');
+
+CREATE TABLE "public"."notification_deliveries" (
+    "id" bigint NOT NULL,
+    "notification_id" bigint NOT NULL,
+    "monitor_id" bigint NOT NULL,
+    "incident_id" bigint,
+    "task_id" text NOT NULL,
+    "attempt" integer NOT NULL,
+    "max_attempts" integer NOT NULL,
+    "status" notification_delivery_status NOT NULL,
+    "http_status" integer,
+    "response" text,
+    "error" text,
+    "duration_ms" integer NOT NULL,
+    "payload" jsonb NOT NULL,
+    "created_at" timestamp NOT NULL,
+    CONSTRAINT "pk_notification_deliveries_id" PRIMARY KEY ("id")
+);
+
+-- Indexes
+CREATE INDEX "idx_notification_deliveries_notification_id_created_at" ON "public"."notification_deliveries" ("notification_id", "created_at" DESC);
+CREATE INDEX "idx_notification_deliveries_incident_id" ON "public"."notification_deliveries" ("incident_id");
+CREATE INDEX "idx_notification_deliveries_status" ON "public"."notification_deliveries" ("status");
+
+ALTER TABLE "public"."notification_deliveries" ADD CONSTRAINT "fk_notification_deliveries_notification_id_notifications_id" FOREIGN KEY("notification_id") REFERENCES "public"."notifications"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."notification_deliveries" ADD CONSTRAINT "fk_notification_deliveries_monitor_id_monitors_id" FOREIGN KEY("monitor_id") REFERENCES "public"."monitors"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."notification_deliveries" ADD CONSTRAINT "fk_notification_deliveries_incident_id_incidents_id" FOREIGN KEY("incident_id") REFERENCES "public"."incidents"("id") ON DELETE SET NULL;
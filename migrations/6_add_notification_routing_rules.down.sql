@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_monitors_tags";
+
+ALTER TABLE "public"."monitors" DROP COLUMN IF EXISTS "tags";
+
+ALTER TABLE "public"."notifications" DROP COLUMN IF EXISTS "routing_rules";
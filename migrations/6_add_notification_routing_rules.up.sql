@@ -0,0 +1,6 @@
+ALTER TABLE "public"."notifications" ADD COLUMN "routing_rules" jsonb NOT NULL DEFAULT '[]';
+
+ALTER TABLE "public"."monitors" ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}';
+
+-- Indexes
+CREATE INDEX "idx_monitors_tags" ON "public"."monitors" USING GIN ("tags");
@@ -0,0 +1,2 @@
+ALTER TABLE "public"."monitors" DROP COLUMN IF EXISTS "reminder_max_count";
+ALTER TABLE "public"."monitors" DROP COLUMN IF EXISTS "reminder_interval";
@@ -0,0 +1,2 @@
+ALTER TABLE "public"."monitors" ADD COLUMN "reminder_interval" integer NOT NULL DEFAULT 0;
+ALTER TABLE "public"."monitors" ADD COLUMN "reminder_max_count" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+ALTER TABLE "public"."incidents" DROP CONSTRAINT IF EXISTS "fk_incidents_acknowledged_by_users_id";
+
+ALTER TABLE "public"."incidents" DROP COLUMN IF EXISTS "acknowledgement_note";
+ALTER TABLE "public"."incidents" DROP COLUMN IF EXISTS "acknowledged_by";
+ALTER TABLE "public"."incidents" DROP COLUMN IF EXISTS "acknowledged_at";
+
+-- PostgreSQL cannot drop enum values; rewrite remaining acknowledgement events as updates.
+UPDATE "public"."event_timelines" SET "event_type" = 'update' WHERE "event_type" = 'acknowledged';
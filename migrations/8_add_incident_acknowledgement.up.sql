@@ -0,0 +1,7 @@
+ALTER TYPE "event_type" ADD VALUE IF NOT EXISTS 'acknowledged';
+
+ALTER TABLE "public"."incidents" ADD COLUMN "acknowledged_at" timestamp;
+ALTER TABLE "public"."incidents" ADD COLUMN "acknowledged_by" bigint;
+ALTER TABLE "public"."incidents" ADD COLUMN "acknowledgement_note" text;
+
+ALTER TABLE "public"."incidents" ADD CONSTRAINT "fk_incidents_acknowledged_by_users_id" FOREIGN KEY("acknowledged_by") REFERENCES "public"."users"("id") ON DELETE SET NULL;
@@ -0,0 +1,6 @@
+ALTER TABLE "public"."monitors" DROP CONSTRAINT IF EXISTS "fk_monitors_escalation_policy_id_escalation_policies_id";
+DROP INDEX IF EXISTS "idx_monitors_escalation_policy_id";
+ALTER TABLE "public"."monitors" DROP COLUMN IF EXISTS "escalation_policy_id";
+
+DROP TABLE IF EXISTS "public"."escalation_policy_levels";
+DROP TABLE IF EXISTS "public"."escalation_policies";
@@ -0,0 +1,29 @@
+CREATE TABLE "public"."escalation_policies" (
+    "id" bigint NOT NULL,
+    "team_id" bigint NOT NULL,
+    "name" text NOT NULL,
+    "description" text,
+    "created_at" timestamp NOT NULL,
+    "updated_at" timestamp NOT NULL,
+    CONSTRAINT "pk_escalation_policies_id" PRIMARY KEY ("id")
+);
+
+CREATE TABLE "public"."escalation_policy_levels" (
+    "id" bigint NOT NULL,
+    "policy_id" bigint NOT NULL,
+    "position" integer NOT NULL,
+    "delay_minutes" integer NOT NULL,
+    "targets" jsonb NOT NULL DEFAULT '[]',
+    CONSTRAINT "pk_escalation_policy_levels_id" PRIMARY KEY ("id")
+);
+
+ALTER TABLE "public"."monitors" ADD COLUMN "escalation_policy_id" bigint;
+
+-- Indexes
+CREATE INDEX "idx_escalation_policies_team_id" ON "public"."escalation_policies" ("team_id");
+CREATE UNIQUE INDEX "uq_escalation_policy_levels_policy_id_position" ON "public"."escalation_policy_levels" ("policy_id", "position");
+CREATE INDEX "idx_monitors_escalation_policy_id" ON "public"."monitors" ("escalation_policy_id");
+
+ALTER TABLE "public"."escalation_policies" ADD CONSTRAINT "fk_escalation_policies_team_id_teams_id" FOREIGN KEY("team_id") REFERENCES "public"."teams"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."escalation_policy_levels" ADD CONSTRAINT "fk_escalation_policy_levels_policy_id_escalation_policies_id" FOREIGN KEY("policy_id") REFERENCES "public"."escalation_policies"("id") ON DELETE CASCADE;
+ALTER TABLE "public"."monitors" ADD CONSTRAINT "fk_monitors_escalation_policy_id_escalation_policies_id" FOREIGN KEY("escalation_policy_id") REFERENCES "public"."escalation_policies"("id") ON DELETE SET NULL;
@@ -23,3 +23,27 @@ type MonitorDailySummary struct {
 	TotalCount int64     `json:"total_count" db:"total_count"`
 	GoodCount  int64     `json:"good_count" db:"good_count"`
 }
+
+// MonitorRegionDailySummary is the daily aggregation of a monitor in a single region.
+type MonitorRegionDailySummary struct {
+	MonitorDailySummary
+	RegionID int64 `json:"region_id,string" db:"region_id"`
+}
+
+// MonitorLatencyBucket is the check-weighted median latency of a monitor in one region and time bucket.
+type MonitorLatencyBucket struct {
+	MonitorID  int64     `json:"monitor_id,string" db:"monitor_id"`
+	RegionID   int64     `json:"region_id,string" db:"region_id"`
+	Bucket     time.Time `json:"bucket" db:"bucket"`
+	TotalCount int64     `json:"total_count" db:"total_count"`
+	LatencyMs  float64   `json:"latency_ms" db:"latency_ms"`
+}
+
+// MonitorMaintenanceFailures counts the failed checks of a monitor in one region and day that fell
+// inside one of its maintenance windows.
+type MonitorMaintenanceFailures struct {
+	MonitorID int64     `json:"monitor_id,string" db:"monitor_id"`
+	RegionID  int64     `json:"region_id,string" db:"region_id"`
+	Day       time.Time `json:"day" db:"day"`
+	FailCount int64     `json:"fail_count" db:"fail_count"`
+}
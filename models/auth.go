@@ -11,6 +11,8 @@ const (
 	CookieNameSession      = "_kymarium_session"
 	CookieNameRefreshToken = "refresh_token"
 	CookieNameAccessToken  = "access_token"
+	// CookieNameStatusPageAccess prefixes the per-page access cookie of password-protected status pages.
+	CookieNameStatusPageAccess = "_kymarium_status_page"
 )
 
 // Session represents a persisted user session.
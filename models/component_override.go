@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// ComponentStatus is a status a team sets by hand for a status page component.
+type ComponentStatus string
+
+// ComponentStatus values, named like the component statuses of the Statuspage v2 API.
+const (
+	ComponentStatusOperational         ComponentStatus = "operational"
+	ComponentStatusDegradedPerformance ComponentStatus = "degraded_performance"
+	ComponentStatusPartialOutage       ComponentStatus = "partial_outage"
+	ComponentStatusMajorOutage         ComponentStatus = "major_outage"
+	ComponentStatusUnderMaintenance    ComponentStatus = "under_maintenance"
+)
+
+// ComponentOverride replaces the displayed status of a status page group or monitor, independent of
+// monitor results. Rows are never deleted: clearing or replacing an override sets ClearedAt, so the
+// table doubles as the audit log.
+type ComponentOverride struct {
+	ID           int64 `json:"id,string" db:"id"`
+	StatusPageID int64 `json:"status_page_id,string" db:"status_page_id"`
+	// Exactly one of GroupID (a status page group) and MonitorID (a monitor shown on the page) is set.
+	GroupID   *int64          `json:"group_id,string,omitempty" db:"group_id"`
+	MonitorID *int64          `json:"monitor_id,string,omitempty" db:"monitor_id"`
+	Status    ComponentStatus `json:"status" db:"status"`
+	Message   string          `json:"message" db:"message"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedBy *int64          `json:"created_by,string,omitempty" db:"created_by"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+	ClearedAt *time.Time      `json:"cleared_at,omitempty" db:"cleared_at"`
+	ClearedBy *int64          `json:"cleared_by,string,omitempty" db:"cleared_by"`
+}
+
+// ActiveAt reports whether the override applies at t.
+func (o ComponentOverride) ActiveAt(t time.Time) bool {
+	return o.ClearedAt == nil && (o.ExpiresAt == nil || o.ExpiresAt.After(t))
+}
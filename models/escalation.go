@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// EscalationTargetType identifies who is notified at an escalation level.
+type EscalationTargetType string
+
+// EscalationTargetType values.
+const (
+	EscalationTargetTypeNotification EscalationTargetType = "notification"
+	EscalationTargetTypeUser         EscalationTargetType = "user"
+	EscalationTargetTypeSchedule     EscalationTargetType = "schedule"
+)
+
+// EscalationPolicy is an ordered chain of levels walked while an incident stays unacknowledged.
+type EscalationPolicy struct {
+	ID          int64                   `json:"id,string" db:"id"`
+	TeamID      int64                   `json:"team_id,string" db:"team_id"`
+	Name        string                  `json:"name" db:"name"`
+	Description *string                 `json:"description,omitempty" db:"description"`
+	Levels      []EscalationPolicyLevel `json:"levels" db:"-"`
+	CreatedAt   time.Time               `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at" db:"updated_at"`
+}
+
+// EscalationPolicyLevel is a single step of an escalation policy.
+// DelayMinutes is how long the level waits for an acknowledgement before escalating to the next one.
+type EscalationPolicyLevel struct {
+	ID           int64              `json:"id,string" db:"id"`
+	PolicyID     int64              `json:"policy_id,string" db:"policy_id"`
+	Position     int                `json:"position" db:"position"`
+	DelayMinutes int                `json:"delay_minutes" db:"delay_minutes"`
+	Targets      []EscalationTarget `json:"targets" db:"targets"`
+}
+
+// EscalationTarget references a notification channel, team member or on-call schedule to notify.
+type EscalationTarget struct {
+	Type EscalationTargetType `json:"type" validate:"required,oneof=notification user schedule"`
+	ID   int64                `json:"id,string" validate:"required"`
+}
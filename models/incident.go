@@ -41,6 +41,15 @@ const (
 	IncidentEventTypeIdentified       EventType = "identified"
 	IncidentEventTypeUpdate           EventType = "update"
 	IncidentEventTypeMonitoring       EventType = "monitoring"
+	IncidentEventTypeAcknowledged     EventType = "acknowledged"
+)
+
+// IncidentAction represents a one-click action that can be performed from a notification.
+type IncidentAction string
+
+// IncidentAction values.
+const (
+	IncidentActionAcknowledge IncidentAction = "acknowledge"
 )
 
 // Incident represents an incident record in the database
@@ -55,6 +64,11 @@ type Incident struct {
 	ResolvedAt  *time.Time       `json:"resolved_at,omitempty" db:"resolved_at"`
 	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at"`
+
+	// Acknowledgement marks an incident as claimed; it stops reminders and escalations.
+	AcknowledgedAt      *time.Time `json:"acknowledged_at,omitempty" db:"acknowledged_at"`
+	AcknowledgedBy      *int64     `json:"acknowledged_by,string,omitempty" db:"acknowledged_by"`
+	AcknowledgementNote *string    `json:"acknowledgement_note,omitempty" db:"acknowledgement_note"`
 }
 
 // IncidentWithMonitorID decorates an incident with the related monitor id.
@@ -79,4 +93,6 @@ type EventTimeline struct {
 	EventType  EventType `json:"event_type" db:"event_type"`
 	CreatedAt  time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	// MessageTranslations optionally overrides Message per locale on status pages.
+	MessageTranslations Translations `json:"message_translations,omitempty" db:"message_translations"`
 }
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// MaintenanceStatus is the lifecycle state of a scheduled maintenance.
+type MaintenanceStatus string
+
+// MaintenanceStatus values. The scheduler moves a maintenance forward as its window starts and ends.
+const (
+	MaintenanceStatusScheduled  MaintenanceStatus = "scheduled"
+	MaintenanceStatusInProgress MaintenanceStatus = "in_progress"
+	MaintenanceStatusCompleted  MaintenanceStatus = "completed"
+)
+
+// Maintenance is a maintenance window announced on a status page.
+// Failed checks of MonitorIDs inside the window count as maintenance rather than downtime.
+type Maintenance struct {
+	ID           int64             `json:"id,string" db:"id"`
+	TeamID       int64             `json:"team_id,string" db:"team_id"`
+	StatusPageID int64             `json:"status_page_id,string" db:"status_page_id"`
+	Title        string            `json:"title" db:"title"`
+	Description  string            `json:"description" db:"description"`
+	Status       MaintenanceStatus `json:"status" db:"status"`
+	MonitorIDs   []int64           `json:"monitor_ids" db:"monitor_ids"`
+	StartsAt     time.Time         `json:"starts_at" db:"starts_at"`
+	EndsAt       time.Time         `json:"ends_at" db:"ends_at"`
+	CreatedAt    time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// MaintenanceUpdate is a timeline entry of a maintenance.
+type MaintenanceUpdate struct {
+	ID            int64             `json:"id,string" db:"id"`
+	MaintenanceID int64             `json:"maintenance_id,string" db:"maintenance_id"`
+	Status        MaintenanceStatus `json:"status" db:"status"`
+	Message       string            `json:"message" db:"message"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+}
@@ -36,6 +36,7 @@ const (
 	NotificationTypeTelegram NotificationType = "telegram"
 	NotificationTypeSlack    NotificationType = "slack"
 	NotificationTypeEmail    NotificationType = "email"
+	NotificationTypeOnCall   NotificationType = "oncall"
 )
 
 // Monitor represents a monitor entity in the database.
@@ -60,6 +61,16 @@ type Monitor struct {
 	FailureThreshold  int16 `json:"failure_threshold" db:"failure_threshold"`
 	RecoveryThreshold int16 `json:"recovery_threshold" db:"recovery_threshold"`
 
+	// Reminders re-notify while an incident stays open; an interval of 0 disables them.
+	ReminderInterval int `json:"reminder_interval" db:"reminder_interval"`
+	ReminderMaxCount int `json:"reminder_max_count" db:"reminder_max_count"`
+
+	// EscalationPolicyID links an optional escalation policy walked while incidents stay unacknowledged.
+	EscalationPolicyID *int64 `json:"escalation_policy_id,string,omitempty" db:"escalation_policy_id"`
+
+	// Tags are free-form labels used for notification routing.
+	Tags []string `json:"tags" db:"tags"`
+
 	// Regions
 	RegionIDs []int64 `json:"regions" db:"region_ids"`
 
@@ -77,6 +88,14 @@ type MonitorWithIncidents struct {
 	Incidents []Incident `json:"incidents,omitempty" db:"incidents"`
 }
 
+// MonitorBadge is the opt-in public badge of a monitor; the token is the only handle on it.
+type MonitorBadge struct {
+	MonitorID int64     `json:"monitor_id,string" db:"monitor_id"`
+	TeamID    int64     `json:"team_id,string" db:"team_id"`
+	Token     string    `json:"token" db:"token"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
 // HTTPConfig decodes the monitor config into an HTTPMonitorConfig.
 func (m Monitor) HTTPConfig() (*monitorm.HTTPMonitorConfig, error) {
 	if m.Type != MonitorTypeHTTP {
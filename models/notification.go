@@ -6,14 +6,61 @@ import (
 )
 
 // Notification represents a notification channel configured by a team.
+// RateLimit is the number of events per minute sent individually before the channel batches
+// further events into a digest every DigestInterval seconds; a RateLimit of 0 disables batching.
+// QuietHours, when set, holds non-critical events until the window ends.
 type Notification struct {
-	ID        int64            `json:"id,string" db:"id"`
-	TeamID    int64            `json:"team_id,string" db:"team_id"`
-	Type      NotificationType `json:"type" db:"type"`
-	Name      string           `json:"name" db:"name"`
-	Config    json.RawMessage  `json:"config" db:"config"`
-	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
-	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+	ID             int64                     `json:"id,string" db:"id"`
+	TeamID         int64                     `json:"team_id,string" db:"team_id"`
+	Type           NotificationType          `json:"type" db:"type"`
+	Name           string                    `json:"name" db:"name"`
+	Config         json.RawMessage           `json:"config" db:"config"`
+	RoutingRules   []NotificationRoutingRule `json:"routing_rules" db:"routing_rules"`
+	RateLimit      int                       `json:"rate_limit" db:"rate_limit"`
+	DigestInterval int                       `json:"digest_interval" db:"digest_interval"`
+	QuietHours     *NotificationQuietHours   `json:"quiet_hours" db:"quiet_hours"`
+	UpdatedAt      time.Time                 `json:"updated_at" db:"updated_at"`
+	CreatedAt      time.Time                 `json:"created_at" db:"created_at"`
+}
+
+// Default rate limiting settings applied to new notification channels.
+const (
+	DefaultNotificationRateLimit      = 20
+	DefaultNotificationDigestInterval = 300
+)
+
+// NotificationEventType identifies the kind of event a notification is sent for.
+type NotificationEventType string
+
+// NotificationEventType values.
+const (
+	NotificationEventTypeDown               NotificationEventType = "down"
+	NotificationEventTypeRecovered          NotificationEventType = "recovered"
+	NotificationEventTypeCertExpiring       NotificationEventType = "cert_expiring"
+	NotificationEventTypeManualStatusUpdate NotificationEventType = "manual_status_update"
+)
+
+// NotificationRoutingRule selects which events are delivered to a notification channel.
+// Empty fields match everything; a channel without rules receives every event.
+type NotificationRoutingRule struct {
+	EventTypes  []NotificationEventType `json:"event_types,omitempty" validate:"omitempty,dive,oneof=down recovered cert_expiring manual_status_update"`
+	MinSeverity IncidentSeverity        `json:"min_severity,omitempty" validate:"omitempty,oneof=emergency critical major minor info"`
+	RegionIDs   []int64                 `json:"region_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	Tags        []string                `json:"tags,omitempty" validate:"omitempty,max=20,dive,required,max=50"`
+}
+
+// NotificationQuietHours describes recurring weekly windows during which a channel holds non-critical events.
+type NotificationQuietHours struct {
+	Timezone string             `json:"timezone" validate:"required,timezone"`
+	Windows  []QuietHoursWindow `json:"windows" validate:"required,min=1,max=20,dive"`
+}
+
+// QuietHoursWindow is a daily time range on the given weekdays (0 = Sunday) in the quiet hours timezone.
+// A window whose end is not after its start runs past midnight into the next day.
+type QuietHoursWindow struct {
+	Weekdays []time.Weekday `json:"weekdays" validate:"required,min=1,max=7,dive,min=0,max=6"`
+	Start    string         `json:"start" validate:"required,datetime=15:04"`
+	End      string         `json:"end" validate:"required,datetime=15:04"`
 }
 
 // DiscordNotificationConfig describes the stored config for a Discord notification channel.
@@ -22,8 +69,12 @@ type DiscordNotificationConfig struct {
 }
 
 // SlackNotificationConfig describes the stored config for a Slack notification channel.
+// Either an incoming webhook or a bot token with a channel ID is required; bot mode enables
+// Block Kit messages with interactive buttons that are edited in place.
 type SlackNotificationConfig struct {
-	WebhookURL string `json:"webhook_url" validate:"required,url"`
+	WebhookURL string `json:"webhook_url,omitempty" validate:"required_without=BotToken,omitempty,url"`
+	BotToken   string `json:"bot_token,omitempty" validate:"required_without=WebhookURL,omitempty,max=500"`
+	ChannelID  string `json:"channel_id,omitempty" validate:"required_with=BotToken,omitempty,max=100"`
 }
 
 // TelegramNotificationConfig describes the stored config for a Telegram notification channel.
@@ -37,9 +88,68 @@ type EmailNotificationConfig struct {
 	EmailAddress []string `json:"email_address" validate:"required,min=1,dive,required,email"`
 }
 
+// OnCallNotificationConfig describes a channel that pages whoever is currently on call for a schedule.
+type OnCallNotificationConfig struct {
+	ScheduleID int64 `json:"schedule_id,string" validate:"required"`
+}
+
 // MonitorNotification links monitors to notification channels.
 type MonitorNotification struct {
 	ID             int64 `json:"id,string" db:"id"`
 	MonitorID      int64 `json:"monitor_id,string" db:"monitor_id"`
 	NotificationID int64 `json:"notification_id,string" db:"notification_id"`
 }
+
+// NotificationDeliveryStatus represents the outcome of a notification delivery attempt.
+type NotificationDeliveryStatus string
+
+// NotificationDeliveryStatus values.
+const (
+	NotificationDeliveryStatusSucceeded  NotificationDeliveryStatus = "succeeded"
+	NotificationDeliveryStatusFailed     NotificationDeliveryStatus = "failed"
+	NotificationDeliveryStatusDeadLetter NotificationDeliveryStatus = "dead_letter"
+)
+
+// NotificationDelivery records a single attempt to deliver a notification.
+type NotificationDelivery struct {
+	ID             int64                      `json:"id,string" db:"id"`
+	NotificationID int64                      `json:"notification_id,string" db:"notification_id"`
+	MonitorID      *int64                     `json:"monitor_id,string,omitempty" db:"monitor_id"`
+	IncidentID     *int64                     `json:"incident_id,string,omitempty" db:"incident_id"`
+	TaskID         string                     `json:"task_id" db:"task_id"`
+	Attempt        int                        `json:"attempt" db:"attempt"`
+	MaxAttempts    int                        `json:"max_attempts" db:"max_attempts"`
+	Status         NotificationDeliveryStatus `json:"status" db:"status"`
+	HTTPStatus     *int                       `json:"http_status,omitempty" db:"http_status"`
+	Response       *string                    `json:"response,omitempty" db:"response"`
+	Error          *string                    `json:"error,omitempty" db:"error"`
+	DurationMs     int                        `json:"duration_ms" db:"duration_ms"`
+	Payload        json.RawMessage            `json:"payload" db:"payload"`
+	CreatedAt      time.Time                  `json:"created_at" db:"created_at"`
+}
+
+// NotificationEvent records an event counted against a channel's rate limit.
+// Held events were not sent individually and are waiting for the next digest.
+type NotificationEvent struct {
+	ID             int64                 `json:"id,string" db:"id"`
+	NotificationID int64                 `json:"notification_id,string" db:"notification_id"`
+	MonitorID      int64                 `json:"monitor_id,string" db:"monitor_id"`
+	MonitorName    string                `json:"monitor_name" db:"monitor_name"`
+	EventType      NotificationEventType `json:"event_type" db:"event_type"`
+	Severity       IncidentSeverity      `json:"severity,omitempty" db:"severity"`
+	Status         PingStatus            `json:"status" db:"status"`
+	Held           bool                  `json:"held" db:"held"`
+	FlushedAt      *time.Time            `json:"flushed_at,omitempty" db:"flushed_at"`
+	CreatedAt      time.Time             `json:"created_at" db:"created_at"`
+}
+
+// NotificationMessage remembers the provider message posted for an incident so later updates can edit it in place.
+type NotificationMessage struct {
+	ID             int64     `json:"id,string" db:"id"`
+	NotificationID int64     `json:"notification_id,string" db:"notification_id"`
+	IncidentID     int64     `json:"incident_id,string" db:"incident_id"`
+	MessageID      string    `json:"message_id" db:"message_id"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	// MutedAt is set once follow-ups for the incident were muted from the chat; they are then skipped for this channel.
+	MutedAt *time.Time `json:"muted_at,omitempty" db:"muted_at"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OnCallSchedule groups rotation layers and overrides that decide who is on call for a team.
+type OnCallSchedule struct {
+	ID          int64         `json:"id,string" db:"id"`
+	TeamID      int64         `json:"team_id,string" db:"team_id"`
+	Name        string        `json:"name" db:"name"`
+	Description *string       `json:"description,omitempty" db:"description"`
+	Timezone    string        `json:"timezone" db:"timezone"`
+	Layers      []OnCallLayer `json:"layers" db:"-"`
+	CreatedAt   time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// OnCallLayer rotates through members, handing off every RotationDays at HandoffTime in the schedule timezone.
+// Higher positions take precedence over lower ones once they have started.
+type OnCallLayer struct {
+	ID           int64     `json:"id,string" db:"id"`
+	ScheduleID   int64     `json:"schedule_id,string" db:"schedule_id"`
+	Position     int       `json:"position" db:"position"`
+	Name         string    `json:"name" db:"name"`
+	StartDate    time.Time `json:"start_date" db:"start_date"`
+	HandoffTime  string    `json:"handoff_time" db:"handoff_time"`
+	RotationDays int       `json:"rotation_days" db:"rotation_days"`
+	UserIDs      []int64   `json:"user_ids" db:"user_ids"`
+}
+
+// OnCallOverride temporarily puts a user on call, replacing every layer of the schedule.
+type OnCallOverride struct {
+	ID         int64     `json:"id,string" db:"id"`
+	ScheduleID int64     `json:"schedule_id,string" db:"schedule_id"`
+	UserID     int64     `json:"user_id,string" db:"user_id"`
+	StartsAt   time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt     time.Time `json:"ends_at" db:"ends_at"`
+	CreatedBy  *int64    `json:"created_by,string,omitempty" db:"created_by"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// UserContactMethod is a personal delivery channel used when a notification targets a person.
+// Type and Config share the formats of team notification channels.
+type UserContactMethod struct {
+	ID        int64            `json:"id,string" db:"id"`
+	UserID    int64            `json:"user_id,string" db:"user_id"`
+	Type      NotificationType `json:"type" db:"type"`
+	Name      string           `json:"name" db:"name"`
+	Config    json.RawMessage  `json:"config" db:"config"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// SLOIndicator selects how an SLO counts good checks.
+type SLOIndicator string
+
+// SLOIndicator values.
+const (
+	// SLOIndicatorAvailability counts the good checks of the monitor rollups (successful within 5s).
+	SLOIndicatorAvailability SLOIndicator = "availability"
+	// SLOIndicatorLatency counts the checks of rollup buckets whose latency percentile stays within the threshold.
+	SLOIndicatorLatency SLOIndicator = "latency"
+)
+
+// SLOWindowType selects whether an SLO window trails now or follows the calendar.
+type SLOWindowType string
+
+// SLOWindowType values.
+const (
+	SLOWindowRolling  SLOWindowType = "rolling"
+	SLOWindowCalendar SLOWindowType = "calendar"
+)
+
+// SLOCalendarPeriod is the period of a calendar SLO window, in UTC. Weeks start on Monday.
+type SLOCalendarPeriod string
+
+// SLOCalendarPeriod values.
+const (
+	SLOCalendarWeek    SLOCalendarPeriod = "week"
+	SLOCalendarMonth   SLOCalendarPeriod = "month"
+	SLOCalendarQuarter SLOCalendarPeriod = "quarter"
+)
+
+// SLO is a service level objective over the combined checks of one or more monitors of a team.
+type SLO struct {
+	ID          int64        `json:"id,string" db:"id"`
+	TeamID      int64        `json:"team_id,string" db:"team_id"`
+	Name        string       `json:"name" db:"name"`
+	Description string       `json:"description" db:"description"`
+	MonitorIDs  []int64      `json:"monitor_ids" db:"monitor_ids"`
+	Indicator   SLOIndicator `json:"indicator" db:"indicator"`
+	// LatencyThresholdMs and LatencyPercentile (50, 75, 90, 95 or 99) are set for latency SLOs only.
+	LatencyThresholdMs *int `json:"latency_threshold_ms,omitempty" db:"latency_threshold_ms"`
+	LatencyPercentile  *int `json:"latency_percentile,omitempty" db:"latency_percentile"`
+	// TargetPct is the share of good checks to reach, e.g. 99.9.
+	TargetPct  float64       `json:"target_pct" db:"target_pct"`
+	WindowType SLOWindowType `json:"window_type" db:"window_type"`
+	// WindowDays is set for rolling windows, CalendarPeriod for calendar windows.
+	WindowDays     *int               `json:"window_days,omitempty" db:"window_days"`
+	CalendarPeriod *SLOCalendarPeriod `json:"calendar_period,omitempty" db:"calendar_period"`
+	CreatedAt      time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// SLOBucket holds the total and good checks of all monitors of an SLO in one rollup bucket.
+type SLOBucket struct {
+	Bucket     time.Time `json:"bucket" db:"bucket"`
+	TotalCount int64     `json:"total_count" db:"total_count"`
+	GoodCount  int64     `json:"good_count" db:"good_count"`
+}
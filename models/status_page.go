@@ -9,8 +9,56 @@ type StatusPageElementType string
 const (
 	StatusPageElementTypeHistoricalTimeline     StatusPageElementType = "historical_timeline"
 	StatusPageElementTypeCurrentStatusIndicator StatusPageElementType = "current_status_indicator"
+	StatusPageElementTypeResponseTimeChart      StatusPageElementType = "response_time_chart"
+	StatusPageElementTypeUptimeBars             StatusPageElementType = "uptime_bars"
 )
 
+// StatusPageVisibility controls who can view a status page.
+type StatusPageVisibility string
+
+// StatusPageVisibility values.
+const (
+	StatusPageVisibilityPublic   StatusPageVisibility = "public"
+	StatusPageVisibilityPassword StatusPageVisibility = "password"
+	StatusPageVisibilityTeam     StatusPageVisibility = "team"
+)
+
+// StatusPageTheme is the default colour scheme of a status page.
+type StatusPageTheme string
+
+// StatusPageTheme values.
+const (
+	StatusPageThemeLight StatusPageTheme = "light"
+	StatusPageThemeDark  StatusPageTheme = "dark"
+	StatusPageThemeAuto  StatusPageTheme = "auto"
+)
+
+// StatusPageStatusColors overrides the colours of the up, degraded and down states.
+type StatusPageStatusColors struct {
+	Up       string `json:"up,omitempty" validate:"omitempty,hexcolor"`
+	Degraded string `json:"degraded,omitempty" validate:"omitempty,hexcolor"`
+	Down     string `json:"down,omitempty" validate:"omitempty,hexcolor"`
+}
+
+// StatusPageHeaderLink is a link shown in the header of a status page.
+type StatusPageHeaderLink struct {
+	Label string `json:"label" validate:"required,min=1,max=64"`
+	URL   string `json:"url" validate:"required,url,max=2048"`
+}
+
+// StatusPageBranding customizes how a status page looks. It is stored as JSONB.
+type StatusPageBranding struct {
+	Theme        StatusPageTheme        `json:"theme" validate:"omitempty,oneof=light dark auto"`
+	PrimaryColor string                 `json:"primary_color,omitempty" validate:"omitempty,hexcolor"`
+	StatusColors StatusPageStatusColors `json:"status_colors"`
+	HeaderLinks  []StatusPageHeaderLink `json:"header_links" validate:"max=10,dive"`
+	FooterText   string                 `json:"footer_text,omitempty" validate:"max=1000"`
+	CustomCSS    string                 `json:"custom_css,omitempty" validate:"max=20000"`
+}
+
+// Translations maps a locale (e.g. "ja", "zh-TW") to a translated text. It is stored as JSONB.
+type Translations map[string]string
+
 // StatusPage represents a public status page for a team.
 type StatusPage struct {
 	ID        int64     `json:"id,string" db:"id"`
@@ -20,6 +68,26 @@ type StatusPage struct {
 	Icon      []byte    `json:"icon,omitempty" db:"icon"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// CustomDomain serves the page on its own hostname once DomainVerifiedAt is set. Unverified claims are
+	// not exclusive and expire some time after DomainClaimedAt.
+	CustomDomain            *string    `json:"custom_domain,omitempty" db:"custom_domain"`
+	DomainVerificationToken *string    `json:"-" db:"domain_verification_token"`
+	DomainVerifiedAt        *time.Time `json:"domain_verified_at,omitempty" db:"domain_verified_at"`
+	DomainClaimedAt         *time.Time `json:"domain_claimed_at,omitempty" db:"domain_claimed_at"`
+
+	// Visibility restricts the public routes; PasswordHash is the argon2id hash for password pages.
+	Visibility   StatusPageVisibility `json:"visibility" db:"visibility"`
+	PasswordHash *string              `json:"-" db:"password_hash"`
+
+	Logo     []byte             `json:"logo,omitempty" db:"logo"`
+	Favicon  []byte             `json:"favicon,omitempty" db:"favicon"`
+	Branding StatusPageBranding `json:"branding" db:"branding"`
+
+	// DefaultLocale is the language Title and element names are written in; TitleTranslations
+	// overrides Title for other locales.
+	DefaultLocale     string       `json:"default_locale" db:"default_locale"`
+	TitleTranslations Translations `json:"title_translations" db:"title_translations"`
 }
 
 // StatusPageGroup groups monitors or elements within a status page.
@@ -29,6 +97,11 @@ type StatusPageGroup struct {
 	Name         string                `json:"name" db:"name"`
 	Type         StatusPageElementType `json:"type" db:"type"`
 	SortOrder    int                   `json:"sort_order" db:"sort_order"`
+	// Days is the window of uptime_bars and response_time_chart elements; PerRegion splits their data by region.
+	Days      *int `json:"days,omitempty" db:"days"`
+	PerRegion bool `json:"per_region" db:"per_region"`
+	// NameTranslations overrides Name for locales other than the page's default.
+	NameTranslations Translations `json:"name_translations" db:"name_translations"`
 }
 
 // StatusPageMonitor defines how a monitor appears on a status page.
@@ -40,4 +113,33 @@ type StatusPageMonitor struct {
 	Name         string                `json:"name" db:"name"`
 	Type         StatusPageElementType `json:"type" db:"type"`
 	SortOrder    int                   `json:"sort_order" db:"sort_order"`
+	// Days is the window of uptime_bars and response_time_chart elements; PerRegion splits their data by region.
+	Days      *int `json:"days,omitempty" db:"days"`
+	PerRegion bool `json:"per_region" db:"per_region"`
+	// NameTranslations overrides Name for locales other than the page's default.
+	NameTranslations Translations `json:"name_translations" db:"name_translations"`
+}
+
+// StatusPageSubscriberType describes how a status page subscriber is notified.
+type StatusPageSubscriberType string
+
+// StatusPageSubscriberType values.
+const (
+	StatusPageSubscriberTypeEmail   StatusPageSubscriberType = "email"
+	StatusPageSubscriberTypeWebhook StatusPageSubscriberType = "webhook"
+)
+
+// StatusPageSubscriber receives public incident updates of a status page.
+// An empty MonitorIDs subscribes to the whole page; email subscribers only receive updates once confirmed.
+type StatusPageSubscriber struct {
+	ID                 int64                    `json:"id,string" db:"id"`
+	StatusPageID       int64                    `json:"status_page_id,string" db:"status_page_id"`
+	Type               StatusPageSubscriberType `json:"type" db:"type"`
+	Target             string                   `json:"target" db:"target"`
+	MonitorIDs         []int64                  `json:"monitor_ids" db:"monitor_ids"`
+	Token              string                   `json:"-" db:"token"`
+	ConfirmedAt        *time.Time               `json:"confirmed_at,omitempty" db:"confirmed_at"`
+	ConfirmationSentAt *time.Time               `json:"-" db:"confirmation_sent_at"`
+	CreatedAt          time.Time                `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at" db:"updated_at"`
 }
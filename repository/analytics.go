@@ -75,6 +75,95 @@ func (r *PGRepository) ListMonitorDailySummaryByMonitorIDs(ctx context.Context,
 	return summaries, nil
 }
 
+// ListMonitorRegionDailySummaryByMonitorIDs returns daily totals per monitor and region within a window.
+func (r *PGRepository) ListMonitorRegionDailySummaryByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorRegionDailySummary, error) {
+	if len(monitorIDs) == 0 {
+		return []models.MonitorRegionDailySummary{}, nil
+	}
+
+	const query = `
+		SELECT
+			monitor_id,
+			region_id,
+			time_bucket('1 day', bucket) AS day,
+			SUM(total_count) AS total_count,
+			SUM(good_count) AS good_count
+		FROM monitor_30min_summary
+		WHERE monitor_id = ANY($1)
+		  AND bucket >= $2
+		  AND bucket < $3
+		GROUP BY monitor_id, region_id, day
+		ORDER BY monitor_id, region_id, day
+	`
+
+	var summaries []models.MonitorRegionDailySummary
+	if err := pgxscan.Select(ctx, tx, &summaries, query, monitorIDs, start, end); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// ListMonitorLatencyBuckets returns the check-weighted median latency per monitor and region, re-bucketed
+// to the given size. Buckets under 30 minutes read monitor_10min_summary (kept 7 days), larger ones
+// monitor_30min_summary.
+func (r *PGRepository) ListMonitorLatencyBuckets(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time, size time.Duration) ([]models.MonitorLatencyBucket, error) {
+	if len(monitorIDs) == 0 {
+		return []models.MonitorLatencyBucket{}, nil
+	}
+
+	view := "monitor_30min_summary"
+	if size < 30*time.Minute {
+		view = "monitor_10min_summary"
+	}
+
+	query := `
+		SELECT
+			monitor_id,
+			region_id,
+			time_bucket(make_interval(secs => $4), bucket) AS bucket,
+			SUM(total_count) AS total_count,
+			SUM(p50_ms * total_count) / SUM(total_count) AS latency_ms
+		FROM ` + view + `
+		WHERE monitor_id = ANY($1)
+		  AND bucket >= $2
+		  AND bucket < $3
+		  AND total_count > 0
+		GROUP BY monitor_id, region_id, 3
+		ORDER BY monitor_id, region_id, 3
+	`
+
+	var buckets []models.MonitorLatencyBucket
+	if err := pgxscan.Select(ctx, tx, &buckets, query, monitorIDs, start, end, size.Seconds()); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// GetAverageLatencyByMonitorIDs returns the check-weighted average of the median latency of monitors
+// within a window, or nil when there were no checks.
+func (r *PGRepository) GetAverageLatencyByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) (*float64, error) {
+	if len(monitorIDs) == 0 {
+		return nil, nil
+	}
+
+	const query = `
+		SELECT SUM(p50_ms * total_count) / NULLIF(SUM(total_count), 0)
+		FROM monitor_30min_summary
+		WHERE monitor_id = ANY($1)
+		  AND bucket >= $2
+		  AND bucket < $3
+	`
+
+	var latency *float64
+	if err := tx.QueryRow(ctx, query, monitorIDs, start, end).Scan(&latency); err != nil {
+		return nil, err
+	}
+
+	return latency, nil
+}
+
 // ListIncidentsByMonitorIDWithinRange returns incidents overlapping the provided window for a monitor.
 func (r *PGRepository) ListIncidentsByMonitorIDWithinRange(ctx context.Context, tx pgx.Tx, monitorID int64, start time.Time, end time.Time) ([]models.Incident, error) {
 	const query = `
@@ -93,3 +182,40 @@ func (r *PGRepository) ListIncidentsByMonitorIDWithinRange(ctx context.Context,
 
 	return incidents, nil
 }
+
+// ListMonitorMaintenanceFailures counts failed checks per monitor, region and day that fell inside a
+// maintenance window covering the monitor. Overlapping windows count a check once.
+func (r *PGRepository) ListMonitorMaintenanceFailures(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorMaintenanceFailures, error) {
+	if len(monitorIDs) == 0 {
+		return []models.MonitorMaintenanceFailures{}, nil
+	}
+
+	const query = `
+		SELECT
+			p.monitor_id,
+			p.region_id,
+			time_bucket('1 day', p.time) AS day,
+			COUNT(*) AS fail_count
+		FROM pings p
+		WHERE p.monitor_id = ANY($1)
+		  AND p.time >= $2
+		  AND p.time < $3
+		  AND p.status <> 'successful'
+		  AND EXISTS (
+			SELECT 1
+			FROM maintenances m
+			WHERE p.monitor_id = ANY(m.monitor_ids)
+			  AND p.time >= m.starts_at
+			  AND p.time < m.ends_at
+		  )
+		GROUP BY p.monitor_id, p.region_id, day
+		ORDER BY p.monitor_id, p.region_id, day
+	`
+
+	var failures []models.MonitorMaintenanceFailures
+	if err := pgxscan.Select(ctx, tx, &failures, query, monitorIDs, start, end); err != nil {
+		return nil, err
+	}
+
+	return failures, nil
+}
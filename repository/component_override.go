@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+const componentOverrideColumns = `id, status_page_id, group_id, monitor_id, status, message, expires_at, created_by, created_at, cleared_at, cleared_by`
+
+// CreateComponentOverride inserts a component override.
+func (r *PGRepository) CreateComponentOverride(ctx context.Context, tx pgx.Tx, override models.ComponentOverride) error {
+	query := `
+		INSERT INTO component_overrides (` + componentOverrideColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		override.ID,
+		override.StatusPageID,
+		override.GroupID,
+		override.MonitorID,
+		override.Status,
+		override.Message,
+		override.ExpiresAt,
+		override.CreatedBy,
+		override.CreatedAt,
+		override.ClearedAt,
+		override.ClearedBy,
+	)
+
+	return err
+}
+
+// ClearActiveComponentOverrides clears the overrides of one group or monitor of a page that apply at clearedAt.
+func (r *PGRepository) ClearActiveComponentOverrides(ctx context.Context, tx pgx.Tx, statusPageID int64, groupID, monitorID *int64, clearedBy int64, clearedAt time.Time) error {
+	query := `
+		UPDATE component_overrides
+		SET cleared_at = $1, cleared_by = $2
+		WHERE status_page_id = $3
+		  AND group_id IS NOT DISTINCT FROM $4
+		  AND monitor_id IS NOT DISTINCT FROM $5
+		  AND cleared_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > $1)
+	`
+
+	_, err := tx.Exec(ctx, query, clearedAt, clearedBy, statusPageID, groupID, monitorID)
+	return err
+}
+
+// ClearComponentOverride clears an override of a page that applies at clearedAt and returns it, or nil
+// when there is no such override.
+func (r *PGRepository) ClearComponentOverride(ctx context.Context, tx pgx.Tx, statusPageID, overrideID, clearedBy int64, clearedAt time.Time) (*models.ComponentOverride, error) {
+	query := `
+		UPDATE component_overrides
+		SET cleared_at = $1, cleared_by = $2
+		WHERE id = $3
+		  AND status_page_id = $4
+		  AND cleared_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > $1)
+		RETURNING ` + componentOverrideColumns
+
+	var override models.ComponentOverride
+	if err := pgxscan.Get(ctx, tx, &override, query, clearedAt, clearedBy, overrideID, statusPageID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &override, nil
+}
+
+// ListComponentOverridesByStatusPageID returns every override of a page, cleared and expired ones
+// included, newest first.
+func (r *PGRepository) ListComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.ComponentOverride, error) {
+	query := `
+		SELECT ` + componentOverrideColumns + `
+		FROM component_overrides
+		WHERE status_page_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	var overrides []models.ComponentOverride
+	if err := pgxscan.Select(ctx, tx, &overrides, query, statusPageID); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// ListActiveComponentOverridesByStatusPageID returns the overrides of a page that apply at now, newest first.
+func (r *PGRepository) ListActiveComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64, now time.Time) ([]models.ComponentOverride, error) {
+	query := `
+		SELECT ` + componentOverrideColumns + `
+		FROM component_overrides
+		WHERE status_page_id = $1
+		  AND cleared_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY created_at DESC, id DESC
+	`
+
+	var overrides []models.ComponentOverride
+	if err := pgxscan.Select(ctx, tx, &overrides, query, statusPageID, now); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// CreateEscalationPolicy inserts an escalation policy record.
+func (r *PGRepository) CreateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) error {
+	query := `
+		INSERT INTO escalation_policies (id, team_id, name, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		policy.ID,
+		policy.TeamID,
+		policy.Name,
+		policy.Description,
+		policy.CreatedAt,
+		policy.UpdatedAt,
+	)
+	return err
+}
+
+// ListEscalationPoliciesByTeamID returns escalation policies belonging to a team.
+func (r *PGRepository) ListEscalationPoliciesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.EscalationPolicy, error) {
+	query := `
+		SELECT id, team_id, name, description, created_at, updated_at
+		FROM escalation_policies
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var policies []models.EscalationPolicy
+	if err := pgxscan.Select(ctx, tx, &policies, query, teamID); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetEscalationPolicyByID fetches an escalation policy ensuring it belongs to the provided team.
+func (r *PGRepository) GetEscalationPolicyByID(ctx context.Context, tx pgx.Tx, teamID, policyID int64) (*models.EscalationPolicy, error) {
+	query := `
+		SELECT id, team_id, name, description, created_at, updated_at
+		FROM escalation_policies
+		WHERE id = $1 AND team_id = $2
+	`
+
+	var policy models.EscalationPolicy
+	if err := pgxscan.Get(ctx, tx, &policy, query, policyID, teamID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// UpdateEscalationPolicy updates an escalation policy and returns the persisted record.
+func (r *PGRepository) UpdateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) (*models.EscalationPolicy, error) {
+	query := `
+		UPDATE escalation_policies
+		SET name = $1, description = $2, updated_at = $3
+		WHERE id = $4 AND team_id = $5
+		RETURNING id, team_id, name, description, created_at, updated_at
+	`
+
+	var updated models.EscalationPolicy
+	if err := tx.QueryRow(ctx, query,
+		policy.Name,
+		policy.Description,
+		policy.UpdatedAt,
+		policy.ID,
+		policy.TeamID,
+	).Scan(
+		&updated.ID,
+		&updated.TeamID,
+		&updated.Name,
+		&updated.Description,
+		&updated.CreatedAt,
+		&updated.UpdatedAt,
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteEscalationPolicy removes an escalation policy belonging to a team.
+func (r *PGRepository) DeleteEscalationPolicy(ctx context.Context, tx pgx.Tx, teamID, policyID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM escalation_policies WHERE id = $1 AND team_id = $2`, policyID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateEscalationPolicyLevels bulk inserts levels for an escalation policy.
+func (r *PGRepository) CreateEscalationPolicyLevels(ctx context.Context, tx pgx.Tx, levels []models.EscalationPolicyLevel) error {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO escalation_policy_levels (id, policy_id, position, delay_minutes, targets)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	for _, level := range levels {
+		if _, err := tx.Exec(ctx, query,
+			level.ID,
+			level.PolicyID,
+			level.Position,
+			level.DelayMinutes,
+			level.Targets,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListEscalationPolicyLevelsByPolicyIDs returns the levels of the given policies ordered by position.
+func (r *PGRepository) ListEscalationPolicyLevelsByPolicyIDs(ctx context.Context, tx pgx.Tx, policyIDs []int64) ([]models.EscalationPolicyLevel, error) {
+	if len(policyIDs) == 0 {
+		return []models.EscalationPolicyLevel{}, nil
+	}
+
+	query := `
+		SELECT id, policy_id, position, delay_minutes, targets
+		FROM escalation_policy_levels
+		WHERE policy_id = ANY($1)
+		ORDER BY policy_id, position
+	`
+
+	var levels []models.EscalationPolicyLevel
+	if err := pgxscan.Select(ctx, tx, &levels, query, policyIDs); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
+
+// DeleteEscalationPolicyLevelsByPolicyID removes all levels of an escalation policy.
+func (r *PGRepository) DeleteEscalationPolicyLevelsByPolicyID(ctx context.Context, tx pgx.Tx, policyID int64) error {
+	_, err := tx.Exec(ctx, `DELETE FROM escalation_policy_levels WHERE policy_id = $1`, policyID)
+	return err
+}
@@ -14,7 +14,8 @@ import (
 // GetOpenIncidentByMonitorID fetches the latest non-resolved incident for a monitor, if any.
 func (r *PGRepository) GetOpenIncidentByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) (*models.Incident, error) {
 	const query = `
-		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       i.acknowledged_at, i.acknowledged_by, i.acknowledgement_note
 		FROM incidents i
 		INNER JOIN incident_monitors im ON im.incident_id = i.id
 		WHERE im.monitor_id = $1
@@ -68,6 +69,81 @@ func (r *PGRepository) ListPublicIncidentsByMonitorIDs(ctx context.Context, tx p
 	return incidents, nil
 }
 
+// ListPublicIncidentsByMonitorIDsBetween returns public incidents for the provided monitors that started in [start, end).
+func (r *PGRepository) ListPublicIncidentsByMonitorIDsBetween(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start, end time.Time) ([]models.IncidentWithMonitorID, error) {
+	if len(monitorIDs) == 0 {
+		return []models.IncidentWithMonitorID{}, nil
+	}
+
+	const query = `
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       im.monitor_id
+		FROM incidents i
+		INNER JOIN incident_monitors im ON im.incident_id = i.id
+		WHERE im.monitor_id = ANY($1)
+		  AND i.is_public = true
+		  AND i.started_at >= $2
+		  AND i.started_at < $3
+		ORDER BY i.started_at DESC, i.id DESC
+	`
+
+	var incidents []models.IncidentWithMonitorID
+	if err := pgxscan.Select(ctx, tx, &incidents, query, monitorIDs, start, end); err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// GetPublicIncidentByMonitorIDs returns one row per provided monitor affected by a public incident.
+// It returns no rows when the incident is not public or affects none of the monitors.
+func (r *PGRepository) GetPublicIncidentByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, incidentID int64) ([]models.IncidentWithMonitorID, error) {
+	if len(monitorIDs) == 0 {
+		return []models.IncidentWithMonitorID{}, nil
+	}
+
+	const query = `
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       im.monitor_id
+		FROM incidents i
+		INNER JOIN incident_monitors im ON im.incident_id = i.id
+		WHERE i.id = $1
+		  AND im.monitor_id = ANY($2)
+		  AND i.is_public = true
+		ORDER BY im.monitor_id
+	`
+
+	var incidents []models.IncidentWithMonitorID
+	if err := pgxscan.Select(ctx, tx, &incidents, query, incidentID, monitorIDs); err != nil {
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// GetEarliestPublicIncidentStartByMonitorIDs returns when the oldest public incident of the provided
+// monitors started, or nil when there is none.
+func (r *PGRepository) GetEarliestPublicIncidentStartByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) (*time.Time, error) {
+	if len(monitorIDs) == 0 {
+		return nil, nil
+	}
+
+	const query = `
+		SELECT MIN(i.started_at)
+		FROM incidents i
+		INNER JOIN incident_monitors im ON im.incident_id = i.id
+		WHERE im.monitor_id = ANY($1)
+		  AND i.is_public = true
+	`
+
+	var startedAt *time.Time
+	if err := tx.QueryRow(ctx, query, monitorIDs).Scan(&startedAt); err != nil {
+		return nil, err
+	}
+
+	return startedAt, nil
+}
+
 // ListPublicEventTimelinesByIncidentIDs returns human-authored timeline events for incidents.
 func (r *PGRepository) ListPublicEventTimelinesByIncidentIDs(ctx context.Context, tx pgx.Tx, incidentIDs []int64) ([]models.EventTimeline, error) {
 	if len(incidentIDs) == 0 {
@@ -75,11 +151,12 @@ func (r *PGRepository) ListPublicEventTimelinesByIncidentIDs(ctx context.Context
 	}
 
 	const query = `
-		SELECT id, event_id, created_by, message, event_type, created_at, updated_at
+		SELECT id, event_id, created_by, message, event_type, created_at, updated_at, message_translations
 		FROM event_timelines
 		WHERE event_id = ANY($1)
 		  AND created_by IS NOT NULL
 		  AND message <> ''
+		  AND event_type <> 'acknowledged'
 		ORDER BY created_at ASC, id ASC
 	`
 
@@ -157,8 +234,8 @@ func (r *PGRepository) MarkIncidentResolved(ctx context.Context, tx pgx.Tx, inci
 // CreateEventTimeline inserts an event timeline entry.
 func (r *PGRepository) CreateEventTimeline(ctx context.Context, tx pgx.Tx, timeline models.EventTimeline) error {
 	const query = `
-		INSERT INTO event_timelines (id, event_id, created_by, message, event_type, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO event_timelines (id, event_id, created_by, message, event_type, created_at, updated_at, message_translations)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE($8, '{}'::jsonb))
 	`
 
 	idVal := timeline.ID
@@ -180,6 +257,7 @@ func (r *PGRepository) CreateEventTimeline(ctx context.Context, tx pgx.Tx, timel
 		timeline.EventType,
 		timeline.CreatedAt,
 		timeline.UpdatedAt,
+		timeline.MessageTranslations,
 	)
 	return err
 }
@@ -187,7 +265,7 @@ func (r *PGRepository) CreateEventTimeline(ctx context.Context, tx pgx.Tx, timel
 // GetLastEventTimeline returns the most recent timeline entry for an incident.
 func (r *PGRepository) GetLastEventTimeline(ctx context.Context, tx pgx.Tx, incidentID int64) (*models.EventTimeline, error) {
 	const query = `
-		SELECT id, event_id, created_by, message, event_type, created_at, updated_at
+		SELECT id, event_id, created_by, message, event_type, created_at, updated_at, message_translations
 		FROM event_timelines
 		WHERE event_id = $1
 		ORDER BY created_at DESC, id DESC
@@ -208,7 +286,8 @@ func (r *PGRepository) GetLastEventTimeline(ctx context.Context, tx pgx.Tx, inci
 // ListIncidentsByMonitorID returns all incidents for a monitor.
 func (r *PGRepository) ListIncidentsByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) ([]models.Incident, error) {
 	const query = `
-		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       i.acknowledged_at, i.acknowledged_by, i.acknowledgement_note
 		FROM incidents i
 		INNER JOIN incident_monitors im ON im.incident_id = i.id
 		WHERE im.monitor_id = $1
@@ -226,7 +305,8 @@ func (r *PGRepository) ListIncidentsByMonitorID(ctx context.Context, tx pgx.Tx,
 // GetIncidentByID fetches an incident scoped to the given monitor.
 func (r *PGRepository) GetIncidentByID(ctx context.Context, tx pgx.Tx, monitorID, incidentID int64) (*models.Incident, error) {
 	const query = `
-		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       i.acknowledged_at, i.acknowledged_by, i.acknowledgement_note
 		FROM incidents i
 		INNER JOIN incident_monitors im ON im.incident_id = i.id
 		WHERE i.id = $1 AND im.monitor_id = $2
@@ -246,7 +326,8 @@ func (r *PGRepository) GetIncidentByID(ctx context.Context, tx pgx.Tx, monitorID
 // ListIncidentsByTeamID returns all incidents for a team via monitor membership.
 func (r *PGRepository) ListIncidentsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.Incident, error) {
 	const query = `
-		SELECT DISTINCT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at
+		SELECT DISTINCT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       i.acknowledged_at, i.acknowledged_by, i.acknowledgement_note
 		FROM incidents i
 		INNER JOIN incident_monitors im ON im.incident_id = i.id
 		INNER JOIN monitors m ON m.id = im.monitor_id
@@ -264,7 +345,8 @@ func (r *PGRepository) ListIncidentsByTeamID(ctx context.Context, tx pgx.Tx, tea
 // GetIncidentByIDForTeam fetches an incident ensuring it belongs to the team via monitor association.
 func (r *PGRepository) GetIncidentByIDForTeam(ctx context.Context, tx pgx.Tx, teamID, incidentID int64) (*models.Incident, error) {
 	const query = `
-		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at
+		SELECT i.id, i.title, i.status, i.severity, i.is_public, i.auto_resolve, i.started_at, i.resolved_at, i.created_at, i.updated_at,
+		       i.acknowledged_at, i.acknowledged_by, i.acknowledgement_note
 		FROM incidents i
 		INNER JOIN incident_monitors im ON im.incident_id = i.id
 		INNER JOIN monitors m ON m.id = im.monitor_id
@@ -285,7 +367,7 @@ func (r *PGRepository) GetIncidentByIDForTeam(ctx context.Context, tx pgx.Tx, te
 // ListEventTimelinesByIncidentID fetches all events for an incident in chronological order.
 func (r *PGRepository) ListEventTimelinesByIncidentID(ctx context.Context, tx pgx.Tx, incidentID int64) ([]models.EventTimeline, error) {
 	const query = `
-		SELECT id, event_id, created_by, message, event_type, created_at, updated_at
+		SELECT id, event_id, created_by, message, event_type, created_at, updated_at, message_translations
 		FROM event_timelines
 		WHERE event_id = $1
 		ORDER BY created_at ASC, id ASC
@@ -307,7 +389,8 @@ func (r *PGRepository) UpdateIncidentStatus(ctx context.Context, tx pgx.Tx, inci
 		    resolved_at = $3,
 		    updated_at = $4
 		WHERE id = $1
-		RETURNING id, title, status, severity, is_public, auto_resolve, started_at, resolved_at, created_at, updated_at
+		RETURNING id, title, status, severity, is_public, auto_resolve, started_at, resolved_at, created_at, updated_at,
+		          acknowledged_at, acknowledged_by, acknowledgement_note
 	`
 
 	var incident models.Incident
@@ -330,7 +413,8 @@ func (r *PGRepository) UpdateIncidentSettings(ctx context.Context, tx pgx.Tx, in
 		    title = $4,
 		    updated_at = $5
 		WHERE id = $1
-		RETURNING id, title, status, severity, is_public, auto_resolve, started_at, resolved_at, created_at, updated_at
+		RETURNING id, title, status, severity, is_public, auto_resolve, started_at, resolved_at, created_at, updated_at,
+		          acknowledged_at, acknowledged_by, acknowledgement_note
 	`
 
 	var incident models.Incident
@@ -343,3 +427,47 @@ func (r *PGRepository) UpdateIncidentSettings(ctx context.Context, tx pgx.Tx, in
 
 	return &incident, nil
 }
+
+// AcknowledgeIncident marks an open, unacknowledged incident as acknowledged and returns the updated row.
+// It returns nil when the incident is already acknowledged or resolved.
+func (r *PGRepository) AcknowledgeIncident(ctx context.Context, tx pgx.Tx, incidentID int64, acknowledgedBy *int64, note *string, acknowledgedAt time.Time) (*models.Incident, error) {
+	const query = `
+		UPDATE incidents
+		SET acknowledged_at = $2,
+		    acknowledged_by = $3,
+		    acknowledgement_note = $4,
+		    updated_at = $2
+		WHERE id = $1
+		  AND acknowledged_at IS NULL
+		  AND status <> 'resolved'
+		RETURNING id, title, status, severity, is_public, auto_resolve, started_at, resolved_at, created_at, updated_at,
+		          acknowledged_at, acknowledged_by, acknowledgement_note
+	`
+
+	var incident models.Incident
+	if err := pgxscan.Get(ctx, tx, &incident, query, incidentID, acknowledgedAt, acknowledgedBy, note); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+// ListMonitorIDsByIncidentID returns the monitors linked to an incident.
+func (r *PGRepository) ListMonitorIDsByIncidentID(ctx context.Context, tx pgx.Tx, incidentID int64) ([]int64, error) {
+	const query = `
+		SELECT monitor_id
+		FROM incident_monitors
+		WHERE incident_id = $1
+		ORDER BY monitor_id
+	`
+
+	var monitorIDs []int64
+	if err := pgxscan.Select(ctx, tx, &monitorIDs, query, incidentID); err != nil {
+		return nil, err
+	}
+
+	return monitorIDs, nil
+}
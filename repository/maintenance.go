@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+const maintenanceColumns = `id, team_id, status_page_id, title, description, status, monitor_ids, starts_at, ends_at, created_at, updated_at`
+
+// CreateMaintenance inserts a maintenance window.
+func (r *PGRepository) CreateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) error {
+	query := `
+		INSERT INTO maintenances (` + maintenanceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		maintenance.ID,
+		maintenance.TeamID,
+		maintenance.StatusPageID,
+		maintenance.Title,
+		maintenance.Description,
+		maintenance.Status,
+		maintenance.MonitorIDs,
+		maintenance.StartsAt,
+		maintenance.EndsAt,
+		maintenance.CreatedAt,
+		maintenance.UpdatedAt,
+	)
+
+	return err
+}
+
+// UpdateMaintenance updates the announcement, monitors, window and status of a maintenance and returns the row.
+func (r *PGRepository) UpdateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) (*models.Maintenance, error) {
+	query := `
+		UPDATE maintenances
+		SET title = $1, description = $2, status = $3, monitor_ids = $4, starts_at = $5, ends_at = $6, updated_at = $7
+		WHERE id = $8 AND status_page_id = $9
+		RETURNING ` + maintenanceColumns
+
+	var updated models.Maintenance
+	if err := pgxscan.Get(ctx, tx, &updated, query,
+		maintenance.Title,
+		maintenance.Description,
+		maintenance.Status,
+		maintenance.MonitorIDs,
+		maintenance.StartsAt,
+		maintenance.EndsAt,
+		maintenance.UpdatedAt,
+		maintenance.ID,
+		maintenance.StatusPageID,
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// UpdateMaintenanceStatus moves a maintenance to a new status.
+func (r *PGRepository) UpdateMaintenanceStatus(ctx context.Context, tx pgx.Tx, maintenanceID int64, status models.MaintenanceStatus, updatedAt time.Time) error {
+	_, err := tx.Exec(ctx, `UPDATE maintenances SET status = $1, updated_at = $2 WHERE id = $3`, status, updatedAt, maintenanceID)
+	return err
+}
+
+// GetMaintenanceByID fetches a maintenance of a status page.
+func (r *PGRepository) GetMaintenanceByID(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) (*models.Maintenance, error) {
+	query := `
+		SELECT ` + maintenanceColumns + `
+		FROM maintenances
+		WHERE id = $1 AND status_page_id = $2
+	`
+
+	var maintenance models.Maintenance
+	if err := pgxscan.Get(ctx, tx, &maintenance, query, maintenanceID, statusPageID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &maintenance, nil
+}
+
+// ListMaintenancesByStatusPageID returns every maintenance of a status page, latest window first.
+func (r *PGRepository) ListMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error) {
+	query := `
+		SELECT ` + maintenanceColumns + `
+		FROM maintenances
+		WHERE status_page_id = $1
+		ORDER BY starts_at DESC
+	`
+
+	var maintenances []models.Maintenance
+	if err := pgxscan.Select(ctx, tx, &maintenances, query, statusPageID); err != nil {
+		return nil, err
+	}
+
+	return maintenances, nil
+}
+
+// ListOpenMaintenancesByStatusPageID returns the scheduled and in-progress maintenances of a status page, soonest first.
+func (r *PGRepository) ListOpenMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error) {
+	query := `
+		SELECT ` + maintenanceColumns + `
+		FROM maintenances
+		WHERE status_page_id = $1 AND status <> 'completed'
+		ORDER BY starts_at ASC
+	`
+
+	var maintenances []models.Maintenance
+	if err := pgxscan.Select(ctx, tx, &maintenances, query, statusPageID); err != nil {
+		return nil, err
+	}
+
+	return maintenances, nil
+}
+
+// ListMaintenancesDueForTransition locks the maintenances whose window started or ended without their
+// status following. Rows locked by another scheduler are skipped.
+func (r *PGRepository) ListMaintenancesDueForTransition(ctx context.Context, tx pgx.Tx, now time.Time) ([]models.Maintenance, error) {
+	query := `
+		SELECT ` + maintenanceColumns + `
+		FROM maintenances
+		WHERE (status = 'scheduled' AND starts_at <= $1)
+		   OR (status = 'in_progress' AND ends_at <= $1)
+		ORDER BY starts_at ASC
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var maintenances []models.Maintenance
+	if err := pgxscan.Select(ctx, tx, &maintenances, query, now); err != nil {
+		return nil, err
+	}
+
+	return maintenances, nil
+}
+
+// IsMonitorUnderMaintenance reports whether a maintenance window covering the monitor includes at.
+func (r *PGRepository) IsMonitorUnderMaintenance(ctx context.Context, tx pgx.Tx, monitorID int64, at time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM maintenances
+			WHERE $1 = ANY(monitor_ids) AND starts_at <= $2 AND ends_at > $2
+		)
+	`
+
+	var exists bool
+	if err := tx.QueryRow(ctx, query, monitorID, at).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// DeleteMaintenance removes a maintenance and its updates.
+func (r *PGRepository) DeleteMaintenance(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM maintenances WHERE id = $1 AND status_page_id = $2`, maintenanceID, statusPageID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateMaintenanceUpdate appends an entry to the timeline of a maintenance.
+func (r *PGRepository) CreateMaintenanceUpdate(ctx context.Context, tx pgx.Tx, update models.MaintenanceUpdate) error {
+	query := `
+		INSERT INTO maintenance_updates (id, maintenance_id, status, message, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := tx.Exec(ctx, query, update.ID, update.MaintenanceID, update.Status, update.Message, update.CreatedAt)
+	return err
+}
+
+// GetMaintenanceUpdateByID fetches a timeline entry of a maintenance.
+func (r *PGRepository) GetMaintenanceUpdateByID(ctx context.Context, tx pgx.Tx, maintenanceID, updateID int64) (*models.MaintenanceUpdate, error) {
+	query := `
+		SELECT id, maintenance_id, status, message, created_at
+		FROM maintenance_updates
+		WHERE id = $1 AND maintenance_id = $2
+	`
+
+	var update models.MaintenanceUpdate
+	if err := pgxscan.Get(ctx, tx, &update, query, updateID, maintenanceID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &update, nil
+}
+
+// ListMaintenanceUpdatesByMaintenanceIDs returns the timelines of maintenances, oldest entry first.
+func (r *PGRepository) ListMaintenanceUpdatesByMaintenanceIDs(ctx context.Context, tx pgx.Tx, maintenanceIDs []int64) ([]models.MaintenanceUpdate, error) {
+	if len(maintenanceIDs) == 0 {
+		return []models.MaintenanceUpdate{}, nil
+	}
+
+	query := `
+		SELECT id, maintenance_id, status, message, created_at
+		FROM maintenance_updates
+		WHERE maintenance_id = ANY($1)
+		ORDER BY created_at ASC, id ASC
+	`
+
+	var updates []models.MaintenanceUpdate
+	if err := pgxscan.Select(ctx, tx, &updates, query, maintenanceIDs); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
@@ -110,6 +110,105 @@ func (m *MockRepository) DeleteStatusPageGroupsByStatusPageID(ctx context.Contex
 	return args.Error(0)
 }
 
+// ListStatusPageMonitorsByMonitorIDs mocks Repository.ListStatusPageMonitorsByMonitorIDs.
+func (m *MockRepository) ListStatusPageMonitorsByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) ([]models.StatusPageMonitor, error) {
+	args := m.Called(ctx, tx, monitorIDs)
+	monitors, _ := args.Get(0).([]models.StatusPageMonitor)
+	return monitors, args.Error(1)
+}
+
+// GetStatusPageByCustomDomain mocks Repository.GetStatusPageByCustomDomain.
+func (m *MockRepository) GetStatusPageByCustomDomain(ctx context.Context, tx pgx.Tx, domain string) (*models.StatusPage, error) {
+	args := m.Called(ctx, tx, domain)
+	page, _ := args.Get(0).(*models.StatusPage)
+	return page, args.Error(1)
+}
+
+// UpdateStatusPageDomain mocks Repository.UpdateStatusPageDomain.
+func (m *MockRepository) UpdateStatusPageDomain(ctx context.Context, tx pgx.Tx, teamID, statusPageID int64, domain, verificationToken *string, updatedAt time.Time) (*models.StatusPage, error) {
+	args := m.Called(ctx, tx, teamID, statusPageID, domain, verificationToken, updatedAt)
+	page, _ := args.Get(0).(*models.StatusPage)
+	return page, args.Error(1)
+}
+
+// MarkStatusPageDomainVerified mocks Repository.MarkStatusPageDomainVerified.
+func (m *MockRepository) MarkStatusPageDomainVerified(ctx context.Context, tx pgx.Tx, statusPageID int64, verificationToken string, verifiedAt time.Time) (*models.StatusPage, error) {
+	args := m.Called(ctx, tx, statusPageID, verificationToken, verifiedAt)
+	page, _ := args.Get(0).(*models.StatusPage)
+	return page, args.Error(1)
+}
+
+// ReleaseStatusPageDomainClaims mocks Repository.ReleaseStatusPageDomainClaims.
+func (m *MockRepository) ReleaseStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, domain string, statusPageID int64, updatedAt time.Time) error {
+	args := m.Called(ctx, tx, domain, statusPageID, updatedAt)
+	return args.Error(0)
+}
+
+// ExpireStatusPageDomainClaims mocks Repository.ExpireStatusPageDomainClaims.
+func (m *MockRepository) ExpireStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, claimedBefore, updatedAt time.Time) (int64, error) {
+	args := m.Called(ctx, tx, claimedBefore, updatedAt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// ListenStatusPageChanges mocks Repository.ListenStatusPageChanges.
+func (m *MockRepository) ListenStatusPageChanges(ctx context.Context, onChange func(statusPageID int64)) error {
+	args := m.Called(ctx, onChange)
+	return args.Error(0)
+}
+
+// UpsertStatusPageSubscriber mocks Repository.UpsertStatusPageSubscriber.
+func (m *MockRepository) UpsertStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriber models.StatusPageSubscriber) (*models.StatusPageSubscriber, error) {
+	args := m.Called(ctx, tx, subscriber)
+	stored, _ := args.Get(0).(*models.StatusPageSubscriber)
+	return stored, args.Error(1)
+}
+
+// GetStatusPageSubscriberByToken mocks Repository.GetStatusPageSubscriberByToken.
+func (m *MockRepository) GetStatusPageSubscriberByToken(ctx context.Context, tx pgx.Tx, statusPageID int64, token string) (*models.StatusPageSubscriber, error) {
+	args := m.Called(ctx, tx, statusPageID, token)
+	subscriber, _ := args.Get(0).(*models.StatusPageSubscriber)
+	return subscriber, args.Error(1)
+}
+
+// GetStatusPageSubscriberByID mocks Repository.GetStatusPageSubscriberByID.
+func (m *MockRepository) GetStatusPageSubscriberByID(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) (*models.StatusPageSubscriber, error) {
+	args := m.Called(ctx, tx, statusPageID, subscriberID)
+	subscriber, _ := args.Get(0).(*models.StatusPageSubscriber)
+	return subscriber, args.Error(1)
+}
+
+// ListStatusPageSubscribers mocks Repository.ListStatusPageSubscribers.
+func (m *MockRepository) ListStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error) {
+	args := m.Called(ctx, tx, statusPageID)
+	subscribers, _ := args.Get(0).([]models.StatusPageSubscriber)
+	return subscribers, args.Error(1)
+}
+
+// ListConfirmedStatusPageSubscribers mocks Repository.ListConfirmedStatusPageSubscribers.
+func (m *MockRepository) ListConfirmedStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error) {
+	args := m.Called(ctx, tx, statusPageID)
+	subscribers, _ := args.Get(0).([]models.StatusPageSubscriber)
+	return subscribers, args.Error(1)
+}
+
+// ConfirmStatusPageSubscriber mocks Repository.ConfirmStatusPageSubscriber.
+func (m *MockRepository) ConfirmStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriberID int64, confirmedAt time.Time) error {
+	args := m.Called(ctx, tx, subscriberID, confirmedAt)
+	return args.Error(0)
+}
+
+// MarkStatusPageSubscriberConfirmationSent mocks Repository.MarkStatusPageSubscriberConfirmationSent.
+func (m *MockRepository) MarkStatusPageSubscriberConfirmationSent(ctx context.Context, tx pgx.Tx, subscriberID int64, now, since time.Time) (bool, error) {
+	args := m.Called(ctx, tx, subscriberID, now, since)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteStatusPageSubscriber mocks Repository.DeleteStatusPageSubscriber.
+func (m *MockRepository) DeleteStatusPageSubscriber(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) error {
+	args := m.Called(ctx, tx, statusPageID, subscriberID)
+	return args.Error(0)
+}
+
 // GetUserByEmail mocks Repository.GetUserByEmail.
 func (m *MockRepository) GetUserByEmail(ctx context.Context, tx pgx.Tx, email string) (*models.User, error) {
 	args := m.Called(ctx, tx, email)
@@ -341,6 +440,13 @@ func (m *MockRepository) GetNotificationByID(ctx context.Context, tx pgx.Tx, tea
 	return notification, args.Error(1)
 }
 
+// ListNotificationsByMonitorID mocks Repository.ListNotificationsByMonitorID.
+func (m *MockRepository) ListNotificationsByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) ([]models.Notification, error) {
+	args := m.Called(ctx, tx, monitorID)
+	notifications, _ := args.Get(0).([]models.Notification)
+	return notifications, args.Error(1)
+}
+
 // CreateNotification mocks Repository.CreateNotification.
 func (m *MockRepository) CreateNotification(ctx context.Context, tx pgx.Tx, notification models.Notification) error {
 	args := m.Called(ctx, tx, notification)
@@ -360,6 +466,231 @@ func (m *MockRepository) DeleteNotification(ctx context.Context, tx pgx.Tx, team
 	return args.Error(0)
 }
 
+// CreateNotificationDelivery mocks Repository.CreateNotificationDelivery.
+func (m *MockRepository) CreateNotificationDelivery(ctx context.Context, tx pgx.Tx, delivery models.NotificationDelivery) error {
+	args := m.Called(ctx, tx, delivery)
+	return args.Error(0)
+}
+
+// ListNotificationDeliveriesByNotificationID mocks Repository.ListNotificationDeliveriesByNotificationID.
+func (m *MockRepository) ListNotificationDeliveriesByNotificationID(ctx context.Context, tx pgx.Tx, notificationID int64, limit int) ([]models.NotificationDelivery, error) {
+	args := m.Called(ctx, tx, notificationID, limit)
+	deliveries, _ := args.Get(0).([]models.NotificationDelivery)
+	return deliveries, args.Error(1)
+}
+
+// GetNotificationDeliveryByID mocks Repository.GetNotificationDeliveryByID.
+func (m *MockRepository) GetNotificationDeliveryByID(ctx context.Context, tx pgx.Tx, notificationID, deliveryID int64) (*models.NotificationDelivery, error) {
+	args := m.Called(ctx, tx, notificationID, deliveryID)
+	delivery, _ := args.Get(0).(*models.NotificationDelivery)
+	return delivery, args.Error(1)
+}
+
+// LockNotification mocks Repository.LockNotification.
+func (m *MockRepository) LockNotification(ctx context.Context, tx pgx.Tx, notificationID int64) error {
+	args := m.Called(ctx, tx, notificationID)
+	return args.Error(0)
+}
+
+// CreateNotificationEvent mocks Repository.CreateNotificationEvent.
+func (m *MockRepository) CreateNotificationEvent(ctx context.Context, tx pgx.Tx, event models.NotificationEvent) error {
+	args := m.Called(ctx, tx, event)
+	return args.Error(0)
+}
+
+// CountNotificationEventsSince mocks Repository.CountNotificationEventsSince.
+func (m *MockRepository) CountNotificationEventsSince(ctx context.Context, tx pgx.Tx, notificationID int64, since time.Time) (int, error) {
+	args := m.Called(ctx, tx, notificationID, since)
+	return args.Int(0), args.Error(1)
+}
+
+// ListPendingNotificationEvents mocks Repository.ListPendingNotificationEvents.
+func (m *MockRepository) ListPendingNotificationEvents(ctx context.Context, tx pgx.Tx, notificationID int64) ([]models.NotificationEvent, error) {
+	args := m.Called(ctx, tx, notificationID)
+	events, _ := args.Get(0).([]models.NotificationEvent)
+	return events, args.Error(1)
+}
+
+// MarkNotificationEventsFlushed mocks Repository.MarkNotificationEventsFlushed.
+func (m *MockRepository) MarkNotificationEventsFlushed(ctx context.Context, tx pgx.Tx, notificationID int64, eventIDs []int64, flushedAt time.Time) error {
+	args := m.Called(ctx, tx, notificationID, eventIDs, flushedAt)
+	return args.Error(0)
+}
+
+// DeleteNotificationEventsBefore mocks Repository.DeleteNotificationEventsBefore.
+func (m *MockRepository) DeleteNotificationEventsBefore(ctx context.Context, tx pgx.Tx, notificationID int64, before time.Time) error {
+	args := m.Called(ctx, tx, notificationID, before)
+	return args.Error(0)
+}
+
+// UpsertNotificationMessage mocks Repository.UpsertNotificationMessage.
+func (m *MockRepository) UpsertNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error {
+	args := m.Called(ctx, tx, message)
+	return args.Error(0)
+}
+
+// GetNotificationMessage mocks Repository.GetNotificationMessage.
+func (m *MockRepository) GetNotificationMessage(ctx context.Context, tx pgx.Tx, notificationID, incidentID int64) (*models.NotificationMessage, error) {
+	args := m.Called(ctx, tx, notificationID, incidentID)
+	message, _ := args.Get(0).(*models.NotificationMessage)
+	return message, args.Error(1)
+}
+
+// MuteNotificationMessage mocks Repository.MuteNotificationMessage.
+func (m *MockRepository) MuteNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error {
+	args := m.Called(ctx, tx, message)
+	return args.Error(0)
+}
+
+// CreateEscalationPolicy mocks Repository.CreateEscalationPolicy.
+func (m *MockRepository) CreateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) error {
+	args := m.Called(ctx, tx, policy)
+	return args.Error(0)
+}
+
+// ListEscalationPoliciesByTeamID mocks Repository.ListEscalationPoliciesByTeamID.
+func (m *MockRepository) ListEscalationPoliciesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.EscalationPolicy, error) {
+	args := m.Called(ctx, tx, teamID)
+	policies, _ := args.Get(0).([]models.EscalationPolicy)
+	return policies, args.Error(1)
+}
+
+// GetEscalationPolicyByID mocks Repository.GetEscalationPolicyByID.
+func (m *MockRepository) GetEscalationPolicyByID(ctx context.Context, tx pgx.Tx, teamID, policyID int64) (*models.EscalationPolicy, error) {
+	args := m.Called(ctx, tx, teamID, policyID)
+	policy, _ := args.Get(0).(*models.EscalationPolicy)
+	return policy, args.Error(1)
+}
+
+// UpdateEscalationPolicy mocks Repository.UpdateEscalationPolicy.
+func (m *MockRepository) UpdateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) (*models.EscalationPolicy, error) {
+	args := m.Called(ctx, tx, policy)
+	updated, _ := args.Get(0).(*models.EscalationPolicy)
+	return updated, args.Error(1)
+}
+
+// DeleteEscalationPolicy mocks Repository.DeleteEscalationPolicy.
+func (m *MockRepository) DeleteEscalationPolicy(ctx context.Context, tx pgx.Tx, teamID, policyID int64) error {
+	args := m.Called(ctx, tx, teamID, policyID)
+	return args.Error(0)
+}
+
+// CreateEscalationPolicyLevels mocks Repository.CreateEscalationPolicyLevels.
+func (m *MockRepository) CreateEscalationPolicyLevels(ctx context.Context, tx pgx.Tx, levels []models.EscalationPolicyLevel) error {
+	args := m.Called(ctx, tx, levels)
+	return args.Error(0)
+}
+
+// ListEscalationPolicyLevelsByPolicyIDs mocks Repository.ListEscalationPolicyLevelsByPolicyIDs.
+func (m *MockRepository) ListEscalationPolicyLevelsByPolicyIDs(ctx context.Context, tx pgx.Tx, policyIDs []int64) ([]models.EscalationPolicyLevel, error) {
+	args := m.Called(ctx, tx, policyIDs)
+	levels, _ := args.Get(0).([]models.EscalationPolicyLevel)
+	return levels, args.Error(1)
+}
+
+// DeleteEscalationPolicyLevelsByPolicyID mocks Repository.DeleteEscalationPolicyLevelsByPolicyID.
+func (m *MockRepository) DeleteEscalationPolicyLevelsByPolicyID(ctx context.Context, tx pgx.Tx, policyID int64) error {
+	args := m.Called(ctx, tx, policyID)
+	return args.Error(0)
+}
+
+// CreateOnCallSchedule mocks Repository.CreateOnCallSchedule.
+func (m *MockRepository) CreateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) error {
+	args := m.Called(ctx, tx, schedule)
+	return args.Error(0)
+}
+
+// ListOnCallSchedulesByTeamID mocks Repository.ListOnCallSchedulesByTeamID.
+func (m *MockRepository) ListOnCallSchedulesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.OnCallSchedule, error) {
+	args := m.Called(ctx, tx, teamID)
+	schedules, _ := args.Get(0).([]models.OnCallSchedule)
+	return schedules, args.Error(1)
+}
+
+// GetOnCallScheduleByID mocks Repository.GetOnCallScheduleByID.
+func (m *MockRepository) GetOnCallScheduleByID(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) (*models.OnCallSchedule, error) {
+	args := m.Called(ctx, tx, teamID, scheduleID)
+	schedule, _ := args.Get(0).(*models.OnCallSchedule)
+	return schedule, args.Error(1)
+}
+
+// UpdateOnCallSchedule mocks Repository.UpdateOnCallSchedule.
+func (m *MockRepository) UpdateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) (*models.OnCallSchedule, error) {
+	args := m.Called(ctx, tx, schedule)
+	updated, _ := args.Get(0).(*models.OnCallSchedule)
+	return updated, args.Error(1)
+}
+
+// DeleteOnCallSchedule mocks Repository.DeleteOnCallSchedule.
+func (m *MockRepository) DeleteOnCallSchedule(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) error {
+	args := m.Called(ctx, tx, teamID, scheduleID)
+	return args.Error(0)
+}
+
+// CreateOnCallLayers mocks Repository.CreateOnCallLayers.
+func (m *MockRepository) CreateOnCallLayers(ctx context.Context, tx pgx.Tx, layers []models.OnCallLayer) error {
+	args := m.Called(ctx, tx, layers)
+	return args.Error(0)
+}
+
+// ListOnCallLayersByScheduleIDs mocks Repository.ListOnCallLayersByScheduleIDs.
+func (m *MockRepository) ListOnCallLayersByScheduleIDs(ctx context.Context, tx pgx.Tx, scheduleIDs []int64) ([]models.OnCallLayer, error) {
+	args := m.Called(ctx, tx, scheduleIDs)
+	layers, _ := args.Get(0).([]models.OnCallLayer)
+	return layers, args.Error(1)
+}
+
+// DeleteOnCallLayersByScheduleID mocks Repository.DeleteOnCallLayersByScheduleID.
+func (m *MockRepository) DeleteOnCallLayersByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64) error {
+	args := m.Called(ctx, tx, scheduleID)
+	return args.Error(0)
+}
+
+// CreateOnCallOverride mocks Repository.CreateOnCallOverride.
+func (m *MockRepository) CreateOnCallOverride(ctx context.Context, tx pgx.Tx, override models.OnCallOverride) error {
+	args := m.Called(ctx, tx, override)
+	return args.Error(0)
+}
+
+// ListOnCallOverridesByScheduleID mocks Repository.ListOnCallOverridesByScheduleID.
+func (m *MockRepository) ListOnCallOverridesByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64, endsAfter time.Time) ([]models.OnCallOverride, error) {
+	args := m.Called(ctx, tx, scheduleID, endsAfter)
+	overrides, _ := args.Get(0).([]models.OnCallOverride)
+	return overrides, args.Error(1)
+}
+
+// DeleteOnCallOverride mocks Repository.DeleteOnCallOverride.
+func (m *MockRepository) DeleteOnCallOverride(ctx context.Context, tx pgx.Tx, scheduleID, overrideID int64) error {
+	args := m.Called(ctx, tx, scheduleID, overrideID)
+	return args.Error(0)
+}
+
+// CreateUserContactMethod mocks Repository.CreateUserContactMethod.
+func (m *MockRepository) CreateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) error {
+	args := m.Called(ctx, tx, method)
+	return args.Error(0)
+}
+
+// ListUserContactMethodsByUserID mocks Repository.ListUserContactMethodsByUserID.
+func (m *MockRepository) ListUserContactMethodsByUserID(ctx context.Context, tx pgx.Tx, userID int64) ([]models.UserContactMethod, error) {
+	args := m.Called(ctx, tx, userID)
+	methods, _ := args.Get(0).([]models.UserContactMethod)
+	return methods, args.Error(1)
+}
+
+// UpdateUserContactMethod mocks Repository.UpdateUserContactMethod.
+func (m *MockRepository) UpdateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) (*models.UserContactMethod, error) {
+	args := m.Called(ctx, tx, method)
+	updated, _ := args.Get(0).(*models.UserContactMethod)
+	return updated, args.Error(1)
+}
+
+// DeleteUserContactMethod mocks Repository.DeleteUserContactMethod.
+func (m *MockRepository) DeleteUserContactMethod(ctx context.Context, tx pgx.Tx, userID, methodID int64) error {
+	args := m.Called(ctx, tx, userID, methodID)
+	return args.Error(0)
+}
+
 // CreateMonitor mocks Repository.CreateMonitor.
 func (m *MockRepository) CreateMonitor(ctx context.Context, tx pgx.Tx, monitor models.Monitor) error {
 	args := m.Called(ctx, tx, monitor)
@@ -516,6 +847,27 @@ func (m *MockRepository) ListPublicIncidentsByMonitorIDs(ctx context.Context, tx
 	return incidents, args.Error(1)
 }
 
+// ListPublicIncidentsByMonitorIDsBetween mocks Repository.ListPublicIncidentsByMonitorIDsBetween.
+func (m *MockRepository) ListPublicIncidentsByMonitorIDsBetween(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start, end time.Time) ([]models.IncidentWithMonitorID, error) {
+	args := m.Called(ctx, tx, monitorIDs, start, end)
+	incidents, _ := args.Get(0).([]models.IncidentWithMonitorID)
+	return incidents, args.Error(1)
+}
+
+// GetPublicIncidentByMonitorIDs mocks Repository.GetPublicIncidentByMonitorIDs.
+func (m *MockRepository) GetPublicIncidentByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, incidentID int64) ([]models.IncidentWithMonitorID, error) {
+	args := m.Called(ctx, tx, monitorIDs, incidentID)
+	incidents, _ := args.Get(0).([]models.IncidentWithMonitorID)
+	return incidents, args.Error(1)
+}
+
+// GetEarliestPublicIncidentStartByMonitorIDs mocks Repository.GetEarliestPublicIncidentStartByMonitorIDs.
+func (m *MockRepository) GetEarliestPublicIncidentStartByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) (*time.Time, error) {
+	args := m.Called(ctx, tx, monitorIDs)
+	startedAt, _ := args.Get(0).(*time.Time)
+	return startedAt, args.Error(1)
+}
+
 // ListPublicEventTimelinesByIncidentIDs mocks Repository.ListPublicEventTimelinesByIncidentIDs.
 func (m *MockRepository) ListPublicEventTimelinesByIncidentIDs(ctx context.Context, tx pgx.Tx, incidentIDs []int64) ([]models.EventTimeline, error) {
 	args := m.Called(ctx, tx, incidentIDs)
@@ -558,6 +910,20 @@ func (m *MockRepository) UpdateIncidentSettings(ctx context.Context, tx pgx.Tx,
 	return incident, args.Error(1)
 }
 
+// AcknowledgeIncident mocks Repository.AcknowledgeIncident.
+func (m *MockRepository) AcknowledgeIncident(ctx context.Context, tx pgx.Tx, incidentID int64, acknowledgedBy *int64, note *string, acknowledgedAt time.Time) (*models.Incident, error) {
+	args := m.Called(ctx, tx, incidentID, acknowledgedBy, note, acknowledgedAt)
+	incident, _ := args.Get(0).(*models.Incident)
+	return incident, args.Error(1)
+}
+
+// ListMonitorIDsByIncidentID mocks Repository.ListMonitorIDsByIncidentID.
+func (m *MockRepository) ListMonitorIDsByIncidentID(ctx context.Context, tx pgx.Tx, incidentID int64) ([]int64, error) {
+	args := m.Called(ctx, tx, incidentID)
+	monitorIDs, _ := args.Get(0).([]int64)
+	return monitorIDs, args.Error(1)
+}
+
 // ListRecentPingsByMonitorIDAndRegion mocks Repository.ListRecentPingsByMonitorIDAndRegion.
 func (m *MockRepository) ListRecentPingsByMonitorIDAndRegion(ctx context.Context, tx pgx.Tx, monitorID int64, regionID int64, limit int) ([]models.Ping, error) {
 	args := m.Called(ctx, tx, monitorID, regionID, limit)
@@ -571,6 +937,12 @@ func (m *MockRepository) UpdateMonitorStatus(ctx context.Context, tx pgx.Tx, mon
 	return args.Error(0)
 }
 
+// MarkMonitorCertExpiryNotified mocks Repository.MarkMonitorCertExpiryNotified.
+func (m *MockRepository) MarkMonitorCertExpiryNotified(ctx context.Context, tx pgx.Tx, monitorID int64, notAfter time.Time) (bool, error) {
+	args := m.Called(ctx, tx, monitorID, notAfter)
+	return args.Bool(0), args.Error(1)
+}
+
 // ListAllRegions mocks Repository.ListAllRegions.
 func (m *MockRepository) ListAllRegions(ctx context.Context, tx pgx.Tx) ([]models.Region, error) {
 	args := m.Called(ctx, tx)
@@ -599,6 +971,213 @@ func (m *MockRepository) ListIncidentsByMonitorIDWithinRange(ctx context.Context
 	return incidents, args.Error(1)
 }
 
+// ListMonitorRegionDailySummaryByMonitorIDs mocks Repository.ListMonitorRegionDailySummaryByMonitorIDs.
+func (m *MockRepository) ListMonitorRegionDailySummaryByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorRegionDailySummary, error) {
+	args := m.Called(ctx, tx, monitorIDs, start, end)
+	summaries, _ := args.Get(0).([]models.MonitorRegionDailySummary)
+	return summaries, args.Error(1)
+}
+
+// ListMonitorLatencyBuckets mocks Repository.ListMonitorLatencyBuckets.
+func (m *MockRepository) ListMonitorLatencyBuckets(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time, size time.Duration) ([]models.MonitorLatencyBucket, error) {
+	args := m.Called(ctx, tx, monitorIDs, start, end, size)
+	buckets, _ := args.Get(0).([]models.MonitorLatencyBucket)
+	return buckets, args.Error(1)
+}
+
+// GetAverageLatencyByMonitorIDs mocks Repository.GetAverageLatencyByMonitorIDs.
+func (m *MockRepository) GetAverageLatencyByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) (*float64, error) {
+	args := m.Called(ctx, tx, monitorIDs, start, end)
+	latency, _ := args.Get(0).(*float64)
+	return latency, args.Error(1)
+}
+
+// ListMonitorMaintenanceFailures mocks Repository.ListMonitorMaintenanceFailures.
+func (m *MockRepository) ListMonitorMaintenanceFailures(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorMaintenanceFailures, error) {
+	args := m.Called(ctx, tx, monitorIDs, start, end)
+	failures, _ := args.Get(0).([]models.MonitorMaintenanceFailures)
+	return failures, args.Error(1)
+}
+
+// GetMonitorBadgeByMonitorID mocks Repository.GetMonitorBadgeByMonitorID.
+func (m *MockRepository) GetMonitorBadgeByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) (*models.MonitorBadge, error) {
+	args := m.Called(ctx, tx, monitorID)
+	badge, _ := args.Get(0).(*models.MonitorBadge)
+	return badge, args.Error(1)
+}
+
+// GetMonitorBadgeByToken mocks Repository.GetMonitorBadgeByToken.
+func (m *MockRepository) GetMonitorBadgeByToken(ctx context.Context, tx pgx.Tx, token string) (*models.MonitorBadge, error) {
+	args := m.Called(ctx, tx, token)
+	badge, _ := args.Get(0).(*models.MonitorBadge)
+	return badge, args.Error(1)
+}
+
+// UpsertMonitorBadge mocks Repository.UpsertMonitorBadge.
+func (m *MockRepository) UpsertMonitorBadge(ctx context.Context, tx pgx.Tx, badge models.MonitorBadge) (*models.MonitorBadge, error) {
+	args := m.Called(ctx, tx, badge)
+	stored, _ := args.Get(0).(*models.MonitorBadge)
+	return stored, args.Error(1)
+}
+
+// DeleteMonitorBadge mocks Repository.DeleteMonitorBadge.
+func (m *MockRepository) DeleteMonitorBadge(ctx context.Context, tx pgx.Tx, monitorID int64) error {
+	args := m.Called(ctx, tx, monitorID)
+	return args.Error(0)
+}
+
+// CreateMaintenance mocks Repository.CreateMaintenance.
+func (m *MockRepository) CreateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) error {
+	args := m.Called(ctx, tx, maintenance)
+	return args.Error(0)
+}
+
+// UpdateMaintenance mocks Repository.UpdateMaintenance.
+func (m *MockRepository) UpdateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) (*models.Maintenance, error) {
+	args := m.Called(ctx, tx, maintenance)
+	updated, _ := args.Get(0).(*models.Maintenance)
+	return updated, args.Error(1)
+}
+
+// UpdateMaintenanceStatus mocks Repository.UpdateMaintenanceStatus.
+func (m *MockRepository) UpdateMaintenanceStatus(ctx context.Context, tx pgx.Tx, maintenanceID int64, status models.MaintenanceStatus, updatedAt time.Time) error {
+	args := m.Called(ctx, tx, maintenanceID, status, updatedAt)
+	return args.Error(0)
+}
+
+// GetMaintenanceByID mocks Repository.GetMaintenanceByID.
+func (m *MockRepository) GetMaintenanceByID(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) (*models.Maintenance, error) {
+	args := m.Called(ctx, tx, statusPageID, maintenanceID)
+	maintenance, _ := args.Get(0).(*models.Maintenance)
+	return maintenance, args.Error(1)
+}
+
+// ListMaintenancesByStatusPageID mocks Repository.ListMaintenancesByStatusPageID.
+func (m *MockRepository) ListMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error) {
+	args := m.Called(ctx, tx, statusPageID)
+	maintenances, _ := args.Get(0).([]models.Maintenance)
+	return maintenances, args.Error(1)
+}
+
+// ListOpenMaintenancesByStatusPageID mocks Repository.ListOpenMaintenancesByStatusPageID.
+func (m *MockRepository) ListOpenMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error) {
+	args := m.Called(ctx, tx, statusPageID)
+	maintenances, _ := args.Get(0).([]models.Maintenance)
+	return maintenances, args.Error(1)
+}
+
+// ListMaintenancesDueForTransition mocks Repository.ListMaintenancesDueForTransition.
+func (m *MockRepository) ListMaintenancesDueForTransition(ctx context.Context, tx pgx.Tx, now time.Time) ([]models.Maintenance, error) {
+	args := m.Called(ctx, tx, now)
+	maintenances, _ := args.Get(0).([]models.Maintenance)
+	return maintenances, args.Error(1)
+}
+
+// IsMonitorUnderMaintenance mocks Repository.IsMonitorUnderMaintenance.
+func (m *MockRepository) IsMonitorUnderMaintenance(ctx context.Context, tx pgx.Tx, monitorID int64, at time.Time) (bool, error) {
+	args := m.Called(ctx, tx, monitorID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+// DeleteMaintenance mocks Repository.DeleteMaintenance.
+func (m *MockRepository) DeleteMaintenance(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) error {
+	args := m.Called(ctx, tx, statusPageID, maintenanceID)
+	return args.Error(0)
+}
+
+// CreateMaintenanceUpdate mocks Repository.CreateMaintenanceUpdate.
+func (m *MockRepository) CreateMaintenanceUpdate(ctx context.Context, tx pgx.Tx, update models.MaintenanceUpdate) error {
+	args := m.Called(ctx, tx, update)
+	return args.Error(0)
+}
+
+// GetMaintenanceUpdateByID mocks Repository.GetMaintenanceUpdateByID.
+func (m *MockRepository) GetMaintenanceUpdateByID(ctx context.Context, tx pgx.Tx, maintenanceID, updateID int64) (*models.MaintenanceUpdate, error) {
+	args := m.Called(ctx, tx, maintenanceID, updateID)
+	update, _ := args.Get(0).(*models.MaintenanceUpdate)
+	return update, args.Error(1)
+}
+
+// ListMaintenanceUpdatesByMaintenanceIDs mocks Repository.ListMaintenanceUpdatesByMaintenanceIDs.
+func (m *MockRepository) ListMaintenanceUpdatesByMaintenanceIDs(ctx context.Context, tx pgx.Tx, maintenanceIDs []int64) ([]models.MaintenanceUpdate, error) {
+	args := m.Called(ctx, tx, maintenanceIDs)
+	updates, _ := args.Get(0).([]models.MaintenanceUpdate)
+	return updates, args.Error(1)
+}
+
+// CreateComponentOverride mocks Repository.CreateComponentOverride.
+func (m *MockRepository) CreateComponentOverride(ctx context.Context, tx pgx.Tx, override models.ComponentOverride) error {
+	args := m.Called(ctx, tx, override)
+	return args.Error(0)
+}
+
+// ClearActiveComponentOverrides mocks Repository.ClearActiveComponentOverrides.
+func (m *MockRepository) ClearActiveComponentOverrides(ctx context.Context, tx pgx.Tx, statusPageID int64, groupID, monitorID *int64, clearedBy int64, clearedAt time.Time) error {
+	args := m.Called(ctx, tx, statusPageID, groupID, monitorID, clearedBy, clearedAt)
+	return args.Error(0)
+}
+
+// ClearComponentOverride mocks Repository.ClearComponentOverride.
+func (m *MockRepository) ClearComponentOverride(ctx context.Context, tx pgx.Tx, statusPageID, overrideID, clearedBy int64, clearedAt time.Time) (*models.ComponentOverride, error) {
+	args := m.Called(ctx, tx, statusPageID, overrideID, clearedBy, clearedAt)
+	override, _ := args.Get(0).(*models.ComponentOverride)
+	return override, args.Error(1)
+}
+
+// ListComponentOverridesByStatusPageID mocks Repository.ListComponentOverridesByStatusPageID.
+func (m *MockRepository) ListComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.ComponentOverride, error) {
+	args := m.Called(ctx, tx, statusPageID)
+	overrides, _ := args.Get(0).([]models.ComponentOverride)
+	return overrides, args.Error(1)
+}
+
+// ListActiveComponentOverridesByStatusPageID mocks Repository.ListActiveComponentOverridesByStatusPageID.
+func (m *MockRepository) ListActiveComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64, now time.Time) ([]models.ComponentOverride, error) {
+	args := m.Called(ctx, tx, statusPageID, now)
+	overrides, _ := args.Get(0).([]models.ComponentOverride)
+	return overrides, args.Error(1)
+}
+
+// CreateSLO mocks Repository.CreateSLO.
+func (m *MockRepository) CreateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) error {
+	args := m.Called(ctx, tx, slo)
+	return args.Error(0)
+}
+
+// UpdateSLO mocks Repository.UpdateSLO.
+func (m *MockRepository) UpdateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) (*models.SLO, error) {
+	args := m.Called(ctx, tx, slo)
+	updated, _ := args.Get(0).(*models.SLO)
+	return updated, args.Error(1)
+}
+
+// GetSLOByID mocks Repository.GetSLOByID.
+func (m *MockRepository) GetSLOByID(ctx context.Context, tx pgx.Tx, teamID, sloID int64) (*models.SLO, error) {
+	args := m.Called(ctx, tx, teamID, sloID)
+	slo, _ := args.Get(0).(*models.SLO)
+	return slo, args.Error(1)
+}
+
+// ListSLOsByTeamID mocks Repository.ListSLOsByTeamID.
+func (m *MockRepository) ListSLOsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.SLO, error) {
+	args := m.Called(ctx, tx, teamID)
+	slos, _ := args.Get(0).([]models.SLO)
+	return slos, args.Error(1)
+}
+
+// DeleteSLO mocks Repository.DeleteSLO.
+func (m *MockRepository) DeleteSLO(ctx context.Context, tx pgx.Tx, teamID, sloID int64) error {
+	args := m.Called(ctx, tx, teamID, sloID)
+	return args.Error(0)
+}
+
+// ListSLOBuckets mocks Repository.ListSLOBuckets.
+func (m *MockRepository) ListSLOBuckets(ctx context.Context, tx pgx.Tx, slo models.SLO, start time.Time, end time.Time) ([]models.SLOBucket, error) {
+	args := m.Called(ctx, tx, slo, start, end)
+	buckets, _ := args.Get(0).([]models.SLOBucket)
+	return buckets, args.Error(1)
+}
+
 // CreateTeamInvite mocks Repository.CreateTeamInvite.
 func (m *MockRepository) CreateTeamInvite(ctx context.Context, tx pgx.Tx, invite models.TeamInvite) error {
 	args := m.Called(ctx, tx, invite)
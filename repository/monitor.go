@@ -14,8 +14,8 @@ import (
 // CreateMonitor inserts a monitor record.
 func (r *PGRepository) CreateMonitor(ctx context.Context, tx pgx.Tx, monitor models.Monitor) error {
 	query := `
-		INSERT INTO monitors (id, team_id, name, type, interval, config, last_checked, next_check, status, failure_threshold, recovery_threshold, updated_at, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		INSERT INTO monitors (id, team_id, name, type, interval, config, last_checked, next_check, status, failure_threshold, recovery_threshold, tags, reminder_interval, reminder_max_count, escalation_policy_id, updated_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
 	_, err := tx.Exec(ctx, query,
@@ -30,6 +30,10 @@ func (r *PGRepository) CreateMonitor(ctx context.Context, tx pgx.Tx, monitor mod
 		monitor.Status,
 		monitor.FailureThreshold,
 		monitor.RecoveryThreshold,
+		monitor.Tags,
+		monitor.ReminderInterval,
+		monitor.ReminderMaxCount,
+		monitor.EscalationPolicyID,
 		monitor.UpdatedAt,
 		monitor.CreatedAt,
 	)
@@ -51,6 +55,10 @@ func (r *PGRepository) ListMonitorsByTeamID(ctx context.Context, tx pgx.Tx, team
 			m.status,
 			m.failure_threshold,
 			m.recovery_threshold,
+			m.tags,
+			m.reminder_interval,
+			m.reminder_max_count,
+			m.escalation_policy_id,
 			m.updated_at,
 			m.created_at,
 			COALESCE((
@@ -95,6 +103,10 @@ func (r *PGRepository) ListMonitorsByIDs(ctx context.Context, tx pgx.Tx, teamID
 			m.status,
 			m.failure_threshold,
 			m.recovery_threshold,
+			m.tags,
+			m.reminder_interval,
+			m.reminder_max_count,
+			m.escalation_policy_id,
 			m.updated_at,
 			m.created_at,
 			COALESCE((
@@ -136,6 +148,10 @@ func (r *PGRepository) GetMonitorByID(ctx context.Context, tx pgx.Tx, teamID, mo
 			m.status,
 			m.failure_threshold,
 			m.recovery_threshold,
+			m.tags,
+			m.reminder_interval,
+			m.reminder_max_count,
+			m.escalation_policy_id,
 			m.updated_at,
 			m.created_at,
 			COALESCE((
@@ -167,9 +183,9 @@ func (r *PGRepository) GetMonitorByID(ctx context.Context, tx pgx.Tx, teamID, mo
 func (r *PGRepository) UpdateMonitor(ctx context.Context, tx pgx.Tx, monitor models.Monitor) (*models.Monitor, error) {
 	query := `
 		UPDATE monitors
-		SET name = $1, type = $2, interval = $3, config = $4, last_checked = $5, next_check = $6, status = $7, failure_threshold = $8, recovery_threshold = $9, updated_at = $10
-		WHERE id = $11 AND team_id = $12
-		RETURNING id, team_id, name, type, interval, config, last_checked, next_check, status, failure_threshold, recovery_threshold, updated_at, created_at
+		SET name = $1, type = $2, interval = $3, config = $4, last_checked = $5, next_check = $6, status = $7, failure_threshold = $8, recovery_threshold = $9, tags = $10, reminder_interval = $11, reminder_max_count = $12, escalation_policy_id = $13, updated_at = $14
+		WHERE id = $15 AND team_id = $16
+		RETURNING id, team_id, name, type, interval, config, last_checked, next_check, status, failure_threshold, recovery_threshold, tags, reminder_interval, reminder_max_count, escalation_policy_id, updated_at, created_at
 	`
 
 	var updated models.Monitor
@@ -183,6 +199,10 @@ func (r *PGRepository) UpdateMonitor(ctx context.Context, tx pgx.Tx, monitor mod
 		monitor.Status,
 		monitor.FailureThreshold,
 		monitor.RecoveryThreshold,
+		monitor.Tags,
+		monitor.ReminderInterval,
+		monitor.ReminderMaxCount,
+		monitor.EscalationPolicyID,
 		monitor.UpdatedAt,
 		monitor.ID,
 		monitor.TeamID,
@@ -198,6 +218,10 @@ func (r *PGRepository) UpdateMonitor(ctx context.Context, tx pgx.Tx, monitor mod
 		&updated.Status,
 		&updated.FailureThreshold,
 		&updated.RecoveryThreshold,
+		&updated.Tags,
+		&updated.ReminderInterval,
+		&updated.ReminderMaxCount,
+		&updated.EscalationPolicyID,
 		&updated.UpdatedAt,
 		&updated.CreatedAt,
 	); err != nil {
@@ -230,6 +254,22 @@ func (r *PGRepository) UpdateMonitorStatus(ctx context.Context, tx pgx.Tx, monit
 	return err
 }
 
+// MarkMonitorCertExpiryNotified records that the certificate expiring at notAfter was announced for the monitor.
+// It reports false when it already was, so regions checking the same certificate warn only once.
+func (r *PGRepository) MarkMonitorCertExpiryNotified(ctx context.Context, tx pgx.Tx, monitorID int64, notAfter time.Time) (bool, error) {
+	result, err := tx.Exec(ctx, `
+		UPDATE monitors
+		SET cert_expiry_notified_for = $1
+		WHERE id = $2
+		  AND cert_expiry_notified_for IS DISTINCT FROM $1
+	`, notAfter, monitorID)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
 // ListMonitorsDueForCheck fetches all monitors where next_check <= now
 func (r *PGRepository) ListMonitorsDueForCheck(ctx context.Context, tx pgx.Tx) ([]models.Monitor, error) {
 	query := `
@@ -245,6 +285,10 @@ func (r *PGRepository) ListMonitorsDueForCheck(ctx context.Context, tx pgx.Tx) (
 			m.status,
 			m.failure_threshold,
 			m.recovery_threshold,
+			m.tags,
+			m.reminder_interval,
+			m.reminder_max_count,
+			m.escalation_policy_id,
 			m.updated_at,
 			m.created_at,
 			COALESCE((
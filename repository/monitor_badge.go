@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// GetMonitorBadgeByMonitorID returns the public badge of a monitor, if enabled.
+func (r *PGRepository) GetMonitorBadgeByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) (*models.MonitorBadge, error) {
+	const query = `
+		SELECT monitor_id, team_id, token, created_at
+		FROM monitor_badges
+		WHERE monitor_id = $1
+	`
+
+	var badge models.MonitorBadge
+	if err := pgxscan.Get(ctx, tx, &badge, query, monitorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &badge, nil
+}
+
+// GetMonitorBadgeByToken returns the badge matching a public badge token.
+func (r *PGRepository) GetMonitorBadgeByToken(ctx context.Context, tx pgx.Tx, token string) (*models.MonitorBadge, error) {
+	const query = `
+		SELECT monitor_id, team_id, token, created_at
+		FROM monitor_badges
+		WHERE token = $1
+	`
+
+	var badge models.MonitorBadge
+	if err := pgxscan.Get(ctx, tx, &badge, query, token); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &badge, nil
+}
+
+// UpsertMonitorBadge enables the badge of a monitor or replaces its token.
+func (r *PGRepository) UpsertMonitorBadge(ctx context.Context, tx pgx.Tx, badge models.MonitorBadge) (*models.MonitorBadge, error) {
+	const query = `
+		INSERT INTO monitor_badges (monitor_id, team_id, token, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (monitor_id)
+		DO UPDATE SET token = EXCLUDED.token, created_at = EXCLUDED.created_at
+		RETURNING monitor_id, team_id, token, created_at
+	`
+
+	var stored models.MonitorBadge
+	if err := pgxscan.Get(ctx, tx, &stored, query, badge.MonitorID, badge.TeamID, badge.Token, badge.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// DeleteMonitorBadge disables the badge of a monitor.
+func (r *PGRepository) DeleteMonitorBadge(ctx context.Context, tx pgx.Tx, monitorID int64) error {
+	_, err := tx.Exec(ctx, `DELETE FROM monitor_badges WHERE monitor_id = $1`, monitorID)
+	return err
+}
@@ -11,8 +11,8 @@ import (
 // CreateNotification inserts a notification record.
 func (r *PGRepository) CreateNotification(ctx context.Context, tx pgx.Tx, notification models.Notification) error {
 	query := `
-		INSERT INTO notifications (id, team_id, type, name, config, updated_at, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO notifications (id, team_id, type, name, config, routing_rules, rate_limit, digest_interval, quiet_hours, updated_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
 	_, err := tx.Exec(ctx, query,
@@ -21,6 +21,10 @@ func (r *PGRepository) CreateNotification(ctx context.Context, tx pgx.Tx, notifi
 		notification.Type,
 		notification.Name,
 		notification.Config,
+		notification.RoutingRules,
+		notification.RateLimit,
+		notification.DigestInterval,
+		notification.QuietHours,
 		notification.UpdatedAt,
 		notification.CreatedAt,
 	)
@@ -30,7 +34,7 @@ func (r *PGRepository) CreateNotification(ctx context.Context, tx pgx.Tx, notifi
 // ListNotificationsByTeamID returns notifications belonging to a team.
 func (r *PGRepository) ListNotificationsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.Notification, error) {
 	query := `
-		SELECT id, team_id, type, name, config, updated_at, created_at
+		SELECT id, team_id, type, name, config, routing_rules, rate_limit, digest_interval, quiet_hours, updated_at, created_at
 		FROM notifications
 		WHERE team_id = $1
 		ORDER BY created_at DESC
@@ -44,10 +48,28 @@ func (r *PGRepository) ListNotificationsByTeamID(ctx context.Context, tx pgx.Tx,
 	return notifications, nil
 }
 
+// ListNotificationsByMonitorID returns the notification channels linked to a monitor.
+func (r *PGRepository) ListNotificationsByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) ([]models.Notification, error) {
+	query := `
+		SELECT n.id, n.team_id, n.type, n.name, n.config, n.routing_rules, n.rate_limit, n.digest_interval, n.quiet_hours, n.updated_at, n.created_at
+		FROM notifications n
+		INNER JOIN monitor_notifications mn ON mn.notification_id = n.id
+		WHERE mn.monitor_id = $1
+		ORDER BY mn.id
+	`
+
+	var notifications []models.Notification
+	if err := pgxscan.Select(ctx, tx, &notifications, query, monitorID); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
 // GetNotificationByID fetches a notification ensuring it belongs to the provided team.
 func (r *PGRepository) GetNotificationByID(ctx context.Context, tx pgx.Tx, teamID, notificationID int64) (*models.Notification, error) {
 	query := `
-		SELECT id, team_id, type, name, config, updated_at, created_at
+		SELECT id, team_id, type, name, config, routing_rules, rate_limit, digest_interval, quiet_hours, updated_at, created_at
 		FROM notifications
 		WHERE id = $1 AND team_id = $2
 	`
@@ -59,6 +81,10 @@ func (r *PGRepository) GetNotificationByID(ctx context.Context, tx pgx.Tx, teamI
 		&notification.Type,
 		&notification.Name,
 		&notification.Config,
+		&notification.RoutingRules,
+		&notification.RateLimit,
+		&notification.DigestInterval,
+		&notification.QuietHours,
 		&notification.UpdatedAt,
 		&notification.CreatedAt,
 	); err != nil {
@@ -75,9 +101,9 @@ func (r *PGRepository) GetNotificationByID(ctx context.Context, tx pgx.Tx, teamI
 func (r *PGRepository) UpdateNotification(ctx context.Context, tx pgx.Tx, notification models.Notification) (*models.Notification, error) {
 	query := `
 		UPDATE notifications
-		SET type = $1, name = $2, config = $3, updated_at = $4
-		WHERE id = $5 AND team_id = $6
-		RETURNING id, team_id, type, name, config, updated_at, created_at
+		SET type = $1, name = $2, config = $3, routing_rules = $4, rate_limit = $5, digest_interval = $6, quiet_hours = $7, updated_at = $8
+		WHERE id = $9 AND team_id = $10
+		RETURNING id, team_id, type, name, config, routing_rules, rate_limit, digest_interval, quiet_hours, updated_at, created_at
 	`
 
 	var updated models.Notification
@@ -85,6 +111,10 @@ func (r *PGRepository) UpdateNotification(ctx context.Context, tx pgx.Tx, notifi
 		notification.Type,
 		notification.Name,
 		notification.Config,
+		notification.RoutingRules,
+		notification.RateLimit,
+		notification.DigestInterval,
+		notification.QuietHours,
 		notification.UpdatedAt,
 		notification.ID,
 		notification.TeamID,
@@ -94,6 +124,10 @@ func (r *PGRepository) UpdateNotification(ctx context.Context, tx pgx.Tx, notifi
 		&updated.Type,
 		&updated.Name,
 		&updated.Config,
+		&updated.RoutingRules,
+		&updated.RateLimit,
+		&updated.DigestInterval,
+		&updated.QuietHours,
 		&updated.UpdatedAt,
 		&updated.CreatedAt,
 	); err != nil {
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// CreateNotificationDelivery inserts a notification delivery attempt.
+func (r *PGRepository) CreateNotificationDelivery(ctx context.Context, tx pgx.Tx, delivery models.NotificationDelivery) error {
+	query := `
+		INSERT INTO notification_deliveries (
+			id, notification_id, monitor_id, incident_id, task_id, attempt, max_attempts,
+			status, http_status, response, error, duration_ms, payload, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		delivery.ID,
+		delivery.NotificationID,
+		delivery.MonitorID,
+		delivery.IncidentID,
+		delivery.TaskID,
+		delivery.Attempt,
+		delivery.MaxAttempts,
+		delivery.Status,
+		delivery.HTTPStatus,
+		delivery.Response,
+		delivery.Error,
+		delivery.DurationMs,
+		delivery.Payload,
+		delivery.CreatedAt,
+	)
+	return err
+}
+
+// ListNotificationDeliveriesByNotificationID returns the most recent delivery attempts for a notification.
+func (r *PGRepository) ListNotificationDeliveriesByNotificationID(ctx context.Context, tx pgx.Tx, notificationID int64, limit int) ([]models.NotificationDelivery, error) {
+	query := `
+		SELECT id, notification_id, monitor_id, incident_id, task_id, attempt, max_attempts,
+		       status, http_status, response, error, duration_ms, payload, created_at
+		FROM notification_deliveries
+		WHERE notification_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	var deliveries []models.NotificationDelivery
+	if err := pgxscan.Select(ctx, tx, &deliveries, query, notificationID, limit); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetNotificationDeliveryByID fetches a delivery attempt belonging to a notification.
+func (r *PGRepository) GetNotificationDeliveryByID(ctx context.Context, tx pgx.Tx, notificationID, deliveryID int64) (*models.NotificationDelivery, error) {
+	query := `
+		SELECT id, notification_id, monitor_id, incident_id, task_id, attempt, max_attempts,
+		       status, http_status, response, error, duration_ms, payload, created_at
+		FROM notification_deliveries
+		WHERE id = $1 AND notification_id = $2
+	`
+
+	var delivery models.NotificationDelivery
+	if err := pgxscan.Get(ctx, tx, &delivery, query, deliveryID, notificationID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
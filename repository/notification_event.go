@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// LockNotification takes a row lock on a notification so rate limit decisions for the channel are serialized.
+func (r *PGRepository) LockNotification(ctx context.Context, tx pgx.Tx, notificationID int64) error {
+	_, err := tx.Exec(ctx, `SELECT id FROM notifications WHERE id = $1 FOR UPDATE`, notificationID)
+	return err
+}
+
+// CreateNotificationEvent inserts a notification event record.
+func (r *PGRepository) CreateNotificationEvent(ctx context.Context, tx pgx.Tx, event models.NotificationEvent) error {
+	query := `
+		INSERT INTO notification_events (id, notification_id, monitor_id, monitor_name, event_type, severity, status, held, flushed_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		event.ID,
+		event.NotificationID,
+		event.MonitorID,
+		event.MonitorName,
+		event.EventType,
+		event.Severity,
+		event.Status,
+		event.Held,
+		event.FlushedAt,
+		event.CreatedAt,
+	)
+	return err
+}
+
+// CountNotificationEventsSince returns how many events a notification received since the given time.
+func (r *PGRepository) CountNotificationEventsSince(ctx context.Context, tx pgx.Tx, notificationID int64, since time.Time) (int, error) {
+	var count int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM notification_events
+		WHERE notification_id = $1 AND created_at >= $2
+	`, notificationID, since).Scan(&count)
+	return count, err
+}
+
+// ListPendingNotificationEvents returns held events that have not been included in a digest yet, oldest first.
+func (r *PGRepository) ListPendingNotificationEvents(ctx context.Context, tx pgx.Tx, notificationID int64) ([]models.NotificationEvent, error) {
+	query := `
+		SELECT id, notification_id, monitor_id, monitor_name, event_type, severity, status, held, flushed_at, created_at
+		FROM notification_events
+		WHERE notification_id = $1 AND held AND flushed_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	var events []models.NotificationEvent
+	if err := pgxscan.Select(ctx, tx, &events, query, notificationID); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// MarkNotificationEventsFlushed records that the given held events were delivered in a digest.
+func (r *PGRepository) MarkNotificationEventsFlushed(ctx context.Context, tx pgx.Tx, notificationID int64, eventIDs []int64, flushedAt time.Time) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, `
+		UPDATE notification_events
+		SET flushed_at = $1
+		WHERE notification_id = $2 AND id = ANY($3)
+	`, flushedAt, notificationID, eventIDs)
+	return err
+}
+
+// DeleteNotificationEventsBefore prunes events older than the cutoff, keeping held events still waiting for a digest.
+func (r *PGRepository) DeleteNotificationEventsBefore(ctx context.Context, tx pgx.Tx, notificationID int64, before time.Time) error {
+	_, err := tx.Exec(ctx, `
+		DELETE FROM notification_events
+		WHERE notification_id = $1 AND created_at < $2 AND (NOT held OR flushed_at IS NOT NULL)
+	`, notificationID, before)
+	return err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// UpsertNotificationMessage stores the provider message for an incident, replacing any earlier one.
+func (r *PGRepository) UpsertNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error {
+	query := `
+		INSERT INTO notification_messages (id, notification_id, incident_id, message_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (notification_id, incident_id)
+		DO UPDATE SET message_id = EXCLUDED.message_id, created_at = EXCLUDED.created_at
+	`
+
+	_, err := tx.Exec(ctx, query,
+		message.ID,
+		message.NotificationID,
+		message.IncidentID,
+		message.MessageID,
+		message.CreatedAt,
+	)
+	return err
+}
+
+// GetNotificationMessage returns the provider message posted to a channel for an incident.
+func (r *PGRepository) GetNotificationMessage(ctx context.Context, tx pgx.Tx, notificationID, incidentID int64) (*models.NotificationMessage, error) {
+	query := `
+		SELECT id, notification_id, incident_id, message_id, created_at, muted_at
+		FROM notification_messages
+		WHERE notification_id = $1 AND incident_id = $2
+	`
+
+	var message models.NotificationMessage
+	if err := pgxscan.Get(ctx, tx, &message, query, notificationID, incidentID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+// MuteNotificationMessage marks follow-ups of an incident as muted for a channel, storing the message when it is not known yet.
+func (r *PGRepository) MuteNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error {
+	query := `
+		INSERT INTO notification_messages (id, notification_id, incident_id, message_id, created_at, muted_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (notification_id, incident_id)
+		DO UPDATE SET muted_at = EXCLUDED.muted_at
+	`
+
+	_, err := tx.Exec(ctx, query,
+		message.ID,
+		message.NotificationID,
+		message.IncidentID,
+		message.MessageID,
+		message.CreatedAt,
+		message.MutedAt,
+	)
+	return err
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// CreateOnCallSchedule inserts an on-call schedule record.
+func (r *PGRepository) CreateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) error {
+	query := `
+		INSERT INTO oncall_schedules (id, team_id, name, description, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		schedule.ID,
+		schedule.TeamID,
+		schedule.Name,
+		schedule.Description,
+		schedule.Timezone,
+		schedule.CreatedAt,
+		schedule.UpdatedAt,
+	)
+	return err
+}
+
+// ListOnCallSchedulesByTeamID returns on-call schedules belonging to a team.
+func (r *PGRepository) ListOnCallSchedulesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.OnCallSchedule, error) {
+	query := `
+		SELECT id, team_id, name, description, timezone, created_at, updated_at
+		FROM oncall_schedules
+		WHERE team_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var schedules []models.OnCallSchedule
+	if err := pgxscan.Select(ctx, tx, &schedules, query, teamID); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetOnCallScheduleByID fetches an on-call schedule ensuring it belongs to the provided team.
+func (r *PGRepository) GetOnCallScheduleByID(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) (*models.OnCallSchedule, error) {
+	query := `
+		SELECT id, team_id, name, description, timezone, created_at, updated_at
+		FROM oncall_schedules
+		WHERE id = $1 AND team_id = $2
+	`
+
+	var schedule models.OnCallSchedule
+	if err := pgxscan.Get(ctx, tx, &schedule, query, scheduleID, teamID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// UpdateOnCallSchedule updates an on-call schedule and returns the persisted record.
+func (r *PGRepository) UpdateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) (*models.OnCallSchedule, error) {
+	query := `
+		UPDATE oncall_schedules
+		SET name = $1, description = $2, timezone = $3, updated_at = $4
+		WHERE id = $5 AND team_id = $6
+		RETURNING id, team_id, name, description, timezone, created_at, updated_at
+	`
+
+	var updated models.OnCallSchedule
+	if err := tx.QueryRow(ctx, query,
+		schedule.Name,
+		schedule.Description,
+		schedule.Timezone,
+		schedule.UpdatedAt,
+		schedule.ID,
+		schedule.TeamID,
+	).Scan(
+		&updated.ID,
+		&updated.TeamID,
+		&updated.Name,
+		&updated.Description,
+		&updated.Timezone,
+		&updated.CreatedAt,
+		&updated.UpdatedAt,
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteOnCallSchedule removes an on-call schedule belonging to a team.
+func (r *PGRepository) DeleteOnCallSchedule(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM oncall_schedules WHERE id = $1 AND team_id = $2`, scheduleID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateOnCallLayers bulk inserts rotation layers for a schedule.
+func (r *PGRepository) CreateOnCallLayers(ctx context.Context, tx pgx.Tx, layers []models.OnCallLayer) error {
+	if len(layers) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO oncall_layers (id, schedule_id, position, name, start_date, handoff_time, rotation_days, user_ids)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	for _, layer := range layers {
+		if _, err := tx.Exec(ctx, query,
+			layer.ID,
+			layer.ScheduleID,
+			layer.Position,
+			layer.Name,
+			layer.StartDate,
+			layer.HandoffTime,
+			layer.RotationDays,
+			layer.UserIDs,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListOnCallLayersByScheduleIDs returns the layers of the given schedules ordered by position.
+func (r *PGRepository) ListOnCallLayersByScheduleIDs(ctx context.Context, tx pgx.Tx, scheduleIDs []int64) ([]models.OnCallLayer, error) {
+	if len(scheduleIDs) == 0 {
+		return []models.OnCallLayer{}, nil
+	}
+
+	query := `
+		SELECT id, schedule_id, position, name, start_date, handoff_time, rotation_days, user_ids
+		FROM oncall_layers
+		WHERE schedule_id = ANY($1)
+		ORDER BY schedule_id, position
+	`
+
+	var layers []models.OnCallLayer
+	if err := pgxscan.Select(ctx, tx, &layers, query, scheduleIDs); err != nil {
+		return nil, err
+	}
+
+	return layers, nil
+}
+
+// DeleteOnCallLayersByScheduleID removes all layers of a schedule.
+func (r *PGRepository) DeleteOnCallLayersByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64) error {
+	_, err := tx.Exec(ctx, `DELETE FROM oncall_layers WHERE schedule_id = $1`, scheduleID)
+	return err
+}
+
+// CreateOnCallOverride inserts a temporary on-call override.
+func (r *PGRepository) CreateOnCallOverride(ctx context.Context, tx pgx.Tx, override models.OnCallOverride) error {
+	query := `
+		INSERT INTO oncall_overrides (id, schedule_id, user_id, starts_at, ends_at, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		override.ID,
+		override.ScheduleID,
+		override.UserID,
+		override.StartsAt,
+		override.EndsAt,
+		override.CreatedBy,
+		override.CreatedAt,
+	)
+	return err
+}
+
+// ListOnCallOverridesByScheduleID returns overrides of a schedule that end after the given time.
+func (r *PGRepository) ListOnCallOverridesByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64, endsAfter time.Time) ([]models.OnCallOverride, error) {
+	query := `
+		SELECT id, schedule_id, user_id, starts_at, ends_at, created_by, created_at
+		FROM oncall_overrides
+		WHERE schedule_id = $1 AND ends_at > $2
+		ORDER BY starts_at
+	`
+
+	var overrides []models.OnCallOverride
+	if err := pgxscan.Select(ctx, tx, &overrides, query, scheduleID, endsAfter); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// DeleteOnCallOverride removes an override from a schedule.
+func (r *PGRepository) DeleteOnCallOverride(ctx context.Context, tx pgx.Tx, scheduleID, overrideID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM oncall_overrides WHERE id = $1 AND schedule_id = $2`, overrideID, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -30,6 +30,23 @@ type Repository interface {
 	DeleteStatusPage(ctx context.Context, tx pgx.Tx, teamID, statusPageID int64) error
 	DeleteStatusPageMonitorsByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) error
 	DeleteStatusPageGroupsByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) error
+	ListStatusPageMonitorsByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) ([]models.StatusPageMonitor, error)
+	GetStatusPageByCustomDomain(ctx context.Context, tx pgx.Tx, domain string) (*models.StatusPage, error)
+	UpdateStatusPageDomain(ctx context.Context, tx pgx.Tx, teamID, statusPageID int64, domain, verificationToken *string, updatedAt time.Time) (*models.StatusPage, error)
+	MarkStatusPageDomainVerified(ctx context.Context, tx pgx.Tx, statusPageID int64, verificationToken string, verifiedAt time.Time) (*models.StatusPage, error)
+	ReleaseStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, domain string, statusPageID int64, updatedAt time.Time) error
+	ExpireStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, claimedBefore, updatedAt time.Time) (int64, error)
+	ListenStatusPageChanges(ctx context.Context, onChange func(statusPageID int64)) error
+
+	// Status page subscribers
+	UpsertStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriber models.StatusPageSubscriber) (*models.StatusPageSubscriber, error)
+	GetStatusPageSubscriberByToken(ctx context.Context, tx pgx.Tx, statusPageID int64, token string) (*models.StatusPageSubscriber, error)
+	GetStatusPageSubscriberByID(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) (*models.StatusPageSubscriber, error)
+	ListStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error)
+	ListConfirmedStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error)
+	ConfirmStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriberID int64, confirmedAt time.Time) error
+	MarkStatusPageSubscriberConfirmationSent(ctx context.Context, tx pgx.Tx, subscriberID int64, now, since time.Time) (bool, error)
+	DeleteStatusPageSubscriber(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) error
 
 	// Auth
 	GetUserByEmail(ctx context.Context, tx pgx.Tx, email string) (*models.User, error)
@@ -76,10 +93,58 @@ type Repository interface {
 	// Notifications
 	ListNotificationsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.Notification, error)
 	GetNotificationByID(ctx context.Context, tx pgx.Tx, teamID, notificationID int64) (*models.Notification, error)
+	ListNotificationsByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) ([]models.Notification, error)
 	CreateNotification(ctx context.Context, tx pgx.Tx, notification models.Notification) error
 	UpdateNotification(ctx context.Context, tx pgx.Tx, notification models.Notification) (*models.Notification, error)
 	DeleteNotification(ctx context.Context, tx pgx.Tx, teamID, notificationID int64) error
 
+	// Notification deliveries
+	CreateNotificationDelivery(ctx context.Context, tx pgx.Tx, delivery models.NotificationDelivery) error
+	ListNotificationDeliveriesByNotificationID(ctx context.Context, tx pgx.Tx, notificationID int64, limit int) ([]models.NotificationDelivery, error)
+	GetNotificationDeliveryByID(ctx context.Context, tx pgx.Tx, notificationID, deliveryID int64) (*models.NotificationDelivery, error)
+
+	// Notification events (rate limiting and digests)
+	LockNotification(ctx context.Context, tx pgx.Tx, notificationID int64) error
+	CreateNotificationEvent(ctx context.Context, tx pgx.Tx, event models.NotificationEvent) error
+	CountNotificationEventsSince(ctx context.Context, tx pgx.Tx, notificationID int64, since time.Time) (int, error)
+	ListPendingNotificationEvents(ctx context.Context, tx pgx.Tx, notificationID int64) ([]models.NotificationEvent, error)
+	MarkNotificationEventsFlushed(ctx context.Context, tx pgx.Tx, notificationID int64, eventIDs []int64, flushedAt time.Time) error
+	DeleteNotificationEventsBefore(ctx context.Context, tx pgx.Tx, notificationID int64, before time.Time) error
+
+	// Notification messages (provider messages edited in place)
+	UpsertNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error
+	GetNotificationMessage(ctx context.Context, tx pgx.Tx, notificationID, incidentID int64) (*models.NotificationMessage, error)
+	MuteNotificationMessage(ctx context.Context, tx pgx.Tx, message models.NotificationMessage) error
+
+	// Escalation policies
+	CreateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) error
+	ListEscalationPoliciesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.EscalationPolicy, error)
+	GetEscalationPolicyByID(ctx context.Context, tx pgx.Tx, teamID, policyID int64) (*models.EscalationPolicy, error)
+	UpdateEscalationPolicy(ctx context.Context, tx pgx.Tx, policy models.EscalationPolicy) (*models.EscalationPolicy, error)
+	DeleteEscalationPolicy(ctx context.Context, tx pgx.Tx, teamID, policyID int64) error
+	CreateEscalationPolicyLevels(ctx context.Context, tx pgx.Tx, levels []models.EscalationPolicyLevel) error
+	ListEscalationPolicyLevelsByPolicyIDs(ctx context.Context, tx pgx.Tx, policyIDs []int64) ([]models.EscalationPolicyLevel, error)
+	DeleteEscalationPolicyLevelsByPolicyID(ctx context.Context, tx pgx.Tx, policyID int64) error
+
+	// On-call schedules
+	CreateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) error
+	ListOnCallSchedulesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.OnCallSchedule, error)
+	GetOnCallScheduleByID(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) (*models.OnCallSchedule, error)
+	UpdateOnCallSchedule(ctx context.Context, tx pgx.Tx, schedule models.OnCallSchedule) (*models.OnCallSchedule, error)
+	DeleteOnCallSchedule(ctx context.Context, tx pgx.Tx, teamID, scheduleID int64) error
+	CreateOnCallLayers(ctx context.Context, tx pgx.Tx, layers []models.OnCallLayer) error
+	ListOnCallLayersByScheduleIDs(ctx context.Context, tx pgx.Tx, scheduleIDs []int64) ([]models.OnCallLayer, error)
+	DeleteOnCallLayersByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64) error
+	CreateOnCallOverride(ctx context.Context, tx pgx.Tx, override models.OnCallOverride) error
+	ListOnCallOverridesByScheduleID(ctx context.Context, tx pgx.Tx, scheduleID int64, endsAfter time.Time) ([]models.OnCallOverride, error)
+	DeleteOnCallOverride(ctx context.Context, tx pgx.Tx, scheduleID, overrideID int64) error
+
+	// User contact methods
+	CreateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) error
+	ListUserContactMethodsByUserID(ctx context.Context, tx pgx.Tx, userID int64) ([]models.UserContactMethod, error)
+	UpdateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) (*models.UserContactMethod, error)
+	DeleteUserContactMethod(ctx context.Context, tx pgx.Tx, userID, methodID int64) error
+
 	// Monitors
 	CreateMonitor(ctx context.Context, tx pgx.Tx, monitor models.Monitor) error
 	ListMonitorsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.Monitor, error)
@@ -115,19 +180,64 @@ type Repository interface {
 	ListIncidentsByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) ([]models.Incident, error)
 	ListIncidentsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.Incident, error)
 	ListPublicIncidentsByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) ([]models.IncidentWithMonitorID, error)
+	ListPublicIncidentsByMonitorIDsBetween(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start, end time.Time) ([]models.IncidentWithMonitorID, error)
+	GetPublicIncidentByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, incidentID int64) ([]models.IncidentWithMonitorID, error)
+	GetEarliestPublicIncidentStartByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) (*time.Time, error)
 	ListPublicEventTimelinesByIncidentIDs(ctx context.Context, tx pgx.Tx, incidentIDs []int64) ([]models.EventTimeline, error)
 	GetIncidentByID(ctx context.Context, tx pgx.Tx, monitorID, incidentID int64) (*models.Incident, error)
 	GetIncidentByIDForTeam(ctx context.Context, tx pgx.Tx, teamID, incidentID int64) (*models.Incident, error)
 	ListEventTimelinesByIncidentID(ctx context.Context, tx pgx.Tx, incidentID int64) ([]models.EventTimeline, error)
 	UpdateIncidentStatus(ctx context.Context, tx pgx.Tx, incidentID int64, status models.IncidentStatus, resolvedAt *time.Time, updatedAt time.Time) (*models.Incident, error)
 	UpdateIncidentSettings(ctx context.Context, tx pgx.Tx, incidentID int64, isPublic bool, autoResolve bool, title *string, updatedAt time.Time) (*models.Incident, error)
+	AcknowledgeIncident(ctx context.Context, tx pgx.Tx, incidentID int64, acknowledgedBy *int64, note *string, acknowledgedAt time.Time) (*models.Incident, error)
+	ListMonitorIDsByIncidentID(ctx context.Context, tx pgx.Tx, incidentID int64) ([]int64, error)
 	ListRecentPingsByMonitorIDAndRegion(ctx context.Context, tx pgx.Tx, monitorID int64, regionID int64, limit int) ([]models.Ping, error)
 	UpdateMonitorStatus(ctx context.Context, tx pgx.Tx, monitorID int64, status models.MonitorStatus, updatedAt time.Time) error
+	MarkMonitorCertExpiryNotified(ctx context.Context, tx pgx.Tx, monitorID int64, notAfter time.Time) (bool, error)
 
 	// Analytics
 	GetMonitorAnalytics(ctx context.Context, tx pgx.Tx, monitorID int64, start time.Time, end time.Time, regionID *int64) ([]models.MonitorAnalyticsBucket, error)
 	ListMonitorDailySummaryByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorDailySummary, error)
 	ListIncidentsByMonitorIDWithinRange(ctx context.Context, tx pgx.Tx, monitorID int64, start time.Time, end time.Time) ([]models.Incident, error)
+	ListMonitorRegionDailySummaryByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorRegionDailySummary, error)
+	ListMonitorLatencyBuckets(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time, size time.Duration) ([]models.MonitorLatencyBucket, error)
+	GetAverageLatencyByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) (*float64, error)
+	ListMonitorMaintenanceFailures(ctx context.Context, tx pgx.Tx, monitorIDs []int64, start time.Time, end time.Time) ([]models.MonitorMaintenanceFailures, error)
+
+	// Monitor badges
+	GetMonitorBadgeByMonitorID(ctx context.Context, tx pgx.Tx, monitorID int64) (*models.MonitorBadge, error)
+	GetMonitorBadgeByToken(ctx context.Context, tx pgx.Tx, token string) (*models.MonitorBadge, error)
+	UpsertMonitorBadge(ctx context.Context, tx pgx.Tx, badge models.MonitorBadge) (*models.MonitorBadge, error)
+	DeleteMonitorBadge(ctx context.Context, tx pgx.Tx, monitorID int64) error
+
+	// Maintenances
+	CreateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) error
+	UpdateMaintenance(ctx context.Context, tx pgx.Tx, maintenance models.Maintenance) (*models.Maintenance, error)
+	UpdateMaintenanceStatus(ctx context.Context, tx pgx.Tx, maintenanceID int64, status models.MaintenanceStatus, updatedAt time.Time) error
+	GetMaintenanceByID(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) (*models.Maintenance, error)
+	ListMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error)
+	ListOpenMaintenancesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.Maintenance, error)
+	ListMaintenancesDueForTransition(ctx context.Context, tx pgx.Tx, now time.Time) ([]models.Maintenance, error)
+	IsMonitorUnderMaintenance(ctx context.Context, tx pgx.Tx, monitorID int64, at time.Time) (bool, error)
+	DeleteMaintenance(ctx context.Context, tx pgx.Tx, statusPageID, maintenanceID int64) error
+	CreateMaintenanceUpdate(ctx context.Context, tx pgx.Tx, update models.MaintenanceUpdate) error
+	GetMaintenanceUpdateByID(ctx context.Context, tx pgx.Tx, maintenanceID, updateID int64) (*models.MaintenanceUpdate, error)
+	ListMaintenanceUpdatesByMaintenanceIDs(ctx context.Context, tx pgx.Tx, maintenanceIDs []int64) ([]models.MaintenanceUpdate, error)
+
+	// Component overrides
+	CreateComponentOverride(ctx context.Context, tx pgx.Tx, override models.ComponentOverride) error
+	ClearActiveComponentOverrides(ctx context.Context, tx pgx.Tx, statusPageID int64, groupID, monitorID *int64, clearedBy int64, clearedAt time.Time) error
+	ClearComponentOverride(ctx context.Context, tx pgx.Tx, statusPageID, overrideID, clearedBy int64, clearedAt time.Time) (*models.ComponentOverride, error)
+	ListComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.ComponentOverride, error)
+	ListActiveComponentOverridesByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64, now time.Time) ([]models.ComponentOverride, error)
+
+	// SLOs
+	CreateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) error
+	UpdateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) (*models.SLO, error)
+	GetSLOByID(ctx context.Context, tx pgx.Tx, teamID, sloID int64) (*models.SLO, error)
+	ListSLOsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.SLO, error)
+	DeleteSLO(ctx context.Context, tx pgx.Tx, teamID, sloID int64) error
+	ListSLOBuckets(ctx context.Context, tx pgx.Tx, slo models.SLO, start time.Time, end time.Time) ([]models.SLOBucket, error)
 }
 
 // PGRepository is the production repository backed by pgx.
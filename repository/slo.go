@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+const sloColumns = `id, team_id, name, description, monitor_ids, indicator, latency_threshold_ms, latency_percentile, target_pct, window_type, window_days, calendar_period, created_at, updated_at`
+
+// sloLatencyColumns maps the latency percentiles an SLO can use to their rollup columns.
+var sloLatencyColumns = map[int]string{
+	50: "p50_ms",
+	75: "p75_ms",
+	90: "p90_ms",
+	95: "p95_ms",
+	99: "p99_ms",
+}
+
+// CreateSLO inserts an SLO.
+func (r *PGRepository) CreateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) error {
+	query := `
+		INSERT INTO slos (` + sloColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		slo.ID,
+		slo.TeamID,
+		slo.Name,
+		slo.Description,
+		slo.MonitorIDs,
+		slo.Indicator,
+		slo.LatencyThresholdMs,
+		slo.LatencyPercentile,
+		slo.TargetPct,
+		slo.WindowType,
+		slo.WindowDays,
+		slo.CalendarPeriod,
+		slo.CreatedAt,
+		slo.UpdatedAt,
+	)
+
+	return err
+}
+
+// UpdateSLO updates the definition of an SLO and returns the row.
+func (r *PGRepository) UpdateSLO(ctx context.Context, tx pgx.Tx, slo models.SLO) (*models.SLO, error) {
+	query := `
+		UPDATE slos
+		SET name = $1, description = $2, monitor_ids = $3, indicator = $4, latency_threshold_ms = $5,
+			latency_percentile = $6, target_pct = $7, window_type = $8, window_days = $9, calendar_period = $10,
+			updated_at = $11
+		WHERE id = $12 AND team_id = $13
+		RETURNING ` + sloColumns
+
+	var updated models.SLO
+	if err := pgxscan.Get(ctx, tx, &updated, query,
+		slo.Name,
+		slo.Description,
+		slo.MonitorIDs,
+		slo.Indicator,
+		slo.LatencyThresholdMs,
+		slo.LatencyPercentile,
+		slo.TargetPct,
+		slo.WindowType,
+		slo.WindowDays,
+		slo.CalendarPeriod,
+		slo.UpdatedAt,
+		slo.ID,
+		slo.TeamID,
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// GetSLOByID fetches an SLO of a team.
+func (r *PGRepository) GetSLOByID(ctx context.Context, tx pgx.Tx, teamID, sloID int64) (*models.SLO, error) {
+	query := `
+		SELECT ` + sloColumns + `
+		FROM slos
+		WHERE id = $1 AND team_id = $2
+	`
+
+	var slo models.SLO
+	if err := pgxscan.Get(ctx, tx, &slo, query, sloID, teamID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &slo, nil
+}
+
+// ListSLOsByTeamID returns the SLOs of a team by name.
+func (r *PGRepository) ListSLOsByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.SLO, error) {
+	query := `
+		SELECT ` + sloColumns + `
+		FROM slos
+		WHERE team_id = $1
+		ORDER BY name, id
+	`
+
+	var slos []models.SLO
+	if err := pgxscan.Select(ctx, tx, &slos, query, teamID); err != nil {
+		return nil, err
+	}
+
+	return slos, nil
+}
+
+// DeleteSLO deletes an SLO of a team, returning pgx.ErrNoRows when there is none.
+func (r *PGRepository) DeleteSLO(ctx context.Context, tx pgx.Tx, teamID, sloID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM slos WHERE id = $1 AND team_id = $2`, sloID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListSLOBuckets returns the total and good checks of the monitors of an SLO per bucket of the
+// continuous aggregate monitor_30min_summary, summed over monitors and regions. Latency SLOs count
+// every check of a monitor and region bucket as good when the bucket's percentile is within the threshold.
+func (r *PGRepository) ListSLOBuckets(ctx context.Context, tx pgx.Tx, slo models.SLO, start time.Time, end time.Time) ([]models.SLOBucket, error) {
+	if len(slo.MonitorIDs) == 0 {
+		return []models.SLOBucket{}, nil
+	}
+
+	good := "SUM(good_count)"
+	args := []any{slo.MonitorIDs, start, end}
+	if slo.Indicator == models.SLOIndicatorLatency {
+		if slo.LatencyPercentile == nil || slo.LatencyThresholdMs == nil {
+			return nil, fmt.Errorf("latency SLO %d has no percentile or threshold", slo.ID)
+		}
+		column, ok := sloLatencyColumns[*slo.LatencyPercentile]
+		if !ok {
+			return nil, fmt.Errorf("unsupported latency percentile %d", *slo.LatencyPercentile)
+		}
+		good = "COALESCE(SUM(total_count) FILTER (WHERE " + column + " <= $4), 0)"
+		args = append(args, *slo.LatencyThresholdMs)
+	}
+
+	query := `
+		SELECT
+			bucket,
+			SUM(total_count) AS total_count,
+			` + good + ` AS good_count
+		FROM monitor_30min_summary
+		WHERE monitor_id = ANY($1)
+		  AND bucket >= $2
+		  AND bucket < $3
+		GROUP BY bucket
+		ORDER BY bucket
+	`
+
+	var buckets []models.SLOBucket
+	if err := pgxscan.Select(ctx, tx, &buckets, query, args...); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
@@ -3,17 +3,21 @@ package repository
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
 	"github.com/yorukot/kymarium/models"
 )
 
+const statusPageColumns = `id, team_id, title, slug, icon, created_at, updated_at, custom_domain, domain_verification_token, domain_verified_at, domain_claimed_at, visibility, password_hash, logo, favicon, branding, default_locale, title_translations`
+
 // CreateStatusPage inserts a new status page.
 func (r *PGRepository) CreateStatusPage(ctx context.Context, tx pgx.Tx, statusPage models.StatusPage) error {
 	query := `
-		INSERT INTO status_pages (id, team_id, title, slug, icon, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO status_pages (id, team_id, title, slug, icon, visibility, password_hash, logo, favicon, branding, default_locale, title_translations, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, COALESCE($12, '{}'::jsonb), $13, $14)
 	`
 
 	_, err := tx.Exec(ctx, query,
@@ -22,6 +26,13 @@ func (r *PGRepository) CreateStatusPage(ctx context.Context, tx pgx.Tx, statusPa
 		statusPage.Title,
 		statusPage.Slug,
 		statusPage.Icon,
+		statusPage.Visibility,
+		statusPage.PasswordHash,
+		statusPage.Logo,
+		statusPage.Favicon,
+		statusPage.Branding,
+		statusPage.DefaultLocale,
+		statusPage.TitleTranslations,
 		statusPage.CreatedAt,
 		statusPage.UpdatedAt,
 	)
@@ -29,33 +40,32 @@ func (r *PGRepository) CreateStatusPage(ctx context.Context, tx pgx.Tx, statusPa
 	return err
 }
 
-// UpdateStatusPage updates slug/icon for a status page and returns the row.
+// UpdateStatusPage updates title, slug, icon, visibility, branding and locale settings for a status page and returns the row.
 func (r *PGRepository) UpdateStatusPage(ctx context.Context, tx pgx.Tx, statusPage models.StatusPage) (*models.StatusPage, error) {
 	query := `
 		UPDATE status_pages
-		SET title = $1, slug = $2, icon = $3, updated_at = $4
-		WHERE id = $5 AND team_id = $6
-		RETURNING id, team_id, title, slug, icon, created_at, updated_at
-	`
+		SET title = $1, slug = $2, icon = $3, visibility = $4, password_hash = $5, logo = $6, favicon = $7, branding = $8,
+			default_locale = $9, title_translations = COALESCE($10, '{}'::jsonb), updated_at = $11
+		WHERE id = $12 AND team_id = $13
+		RETURNING ` + statusPageColumns
 
 	var updated models.StatusPage
-	if err := tx.QueryRow(ctx, query,
+	if err := pgxscan.Get(ctx, tx, &updated, query,
 		statusPage.Title,
 		statusPage.Slug,
 		statusPage.Icon,
+		statusPage.Visibility,
+		statusPage.PasswordHash,
+		statusPage.Logo,
+		statusPage.Favicon,
+		statusPage.Branding,
+		statusPage.DefaultLocale,
+		statusPage.TitleTranslations,
 		statusPage.UpdatedAt,
 		statusPage.ID,
 		statusPage.TeamID,
-	).Scan(
-		&updated.ID,
-		&updated.TeamID,
-		&updated.Title,
-		&updated.Slug,
-		&updated.Icon,
-		&updated.CreatedAt,
-		&updated.UpdatedAt,
 	); err != nil {
-		if err == pgx.ErrNoRows {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
@@ -67,7 +77,7 @@ func (r *PGRepository) UpdateStatusPage(ctx context.Context, tx pgx.Tx, statusPa
 // GetStatusPageByID fetches a status page ensuring it belongs to the team.
 func (r *PGRepository) GetStatusPageByID(ctx context.Context, tx pgx.Tx, teamID, statusPageID int64) (*models.StatusPage, error) {
 	query := `
-		SELECT id, team_id, title, slug, icon, created_at, updated_at
+		SELECT ` + statusPageColumns + `
 		FROM status_pages
 		WHERE id = $1 AND team_id = $2
 	`
@@ -86,7 +96,7 @@ func (r *PGRepository) GetStatusPageByID(ctx context.Context, tx pgx.Tx, teamID,
 // GetStatusPageBySlug returns a status page matching the slug.
 func (r *PGRepository) GetStatusPageBySlug(ctx context.Context, tx pgx.Tx, slug string) (*models.StatusPage, error) {
 	query := `
-		SELECT id, team_id, title, slug, icon, created_at, updated_at
+		SELECT ` + statusPageColumns + `
 		FROM status_pages
 		WHERE slug = $1
 	`
@@ -105,7 +115,7 @@ func (r *PGRepository) GetStatusPageBySlug(ctx context.Context, tx pgx.Tx, slug
 // ListStatusPagesByTeamID returns status pages belonging to a team.
 func (r *PGRepository) ListStatusPagesByTeamID(ctx context.Context, tx pgx.Tx, teamID int64) ([]models.StatusPage, error) {
 	query := `
-		SELECT id, team_id, title, slug, icon, created_at, updated_at
+		SELECT ` + statusPageColumns + `
 		FROM status_pages
 		WHERE team_id = $1
 		ORDER BY created_at DESC
@@ -122,7 +132,7 @@ func (r *PGRepository) ListStatusPagesByTeamID(ctx context.Context, tx pgx.Tx, t
 // ListStatusPageGroupsByStatusPageID returns groups for a status page.
 func (r *PGRepository) ListStatusPageGroupsByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageGroup, error) {
 	query := `
-		SELECT id, status_page_id, name, type, sort_order
+		SELECT id, status_page_id, name, type, sort_order, days, per_region, name_translations
 		FROM status_page_groups
 		WHERE status_page_id = $1
 		ORDER BY sort_order ASC
@@ -139,7 +149,7 @@ func (r *PGRepository) ListStatusPageGroupsByStatusPageID(ctx context.Context, t
 // ListStatusPageMonitorsByStatusPageID returns monitors for a status page.
 func (r *PGRepository) ListStatusPageMonitorsByStatusPageID(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageMonitor, error) {
 	query := `
-		SELECT id, status_page_id, monitor_id, group_id, name, type, sort_order
+		SELECT id, status_page_id, monitor_id, group_id, name, type, sort_order, days, per_region, name_translations
 		FROM status_page_monitors
 		WHERE status_page_id = $1
 		ORDER BY group_id NULLS FIRST, sort_order ASC
@@ -160,8 +170,8 @@ func (r *PGRepository) CreateStatusPageGroups(ctx context.Context, tx pgx.Tx, gr
 	}
 
 	query := `
-		INSERT INTO status_page_groups (id, status_page_id, name, type, sort_order)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO status_page_groups (id, status_page_id, name, type, sort_order, days, per_region, name_translations)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE($8, '{}'::jsonb))
 	`
 
 	for _, group := range groups {
@@ -171,6 +181,9 @@ func (r *PGRepository) CreateStatusPageGroups(ctx context.Context, tx pgx.Tx, gr
 			group.Name,
 			group.Type,
 			group.SortOrder,
+			group.Days,
+			group.PerRegion,
+			group.NameTranslations,
 		); err != nil {
 			return err
 		}
@@ -186,8 +199,8 @@ func (r *PGRepository) CreateStatusPageMonitors(ctx context.Context, tx pgx.Tx,
 	}
 
 	query := `
-		INSERT INTO status_page_monitors (id, status_page_id, monitor_id, group_id, name, type, sort_order)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO status_page_monitors (id, status_page_id, monitor_id, group_id, name, type, sort_order, days, per_region, name_translations)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, '{}'::jsonb))
 	`
 
 	for _, monitor := range monitors {
@@ -199,6 +212,9 @@ func (r *PGRepository) CreateStatusPageMonitors(ctx context.Context, tx pgx.Tx,
 			monitor.Name,
 			monitor.Type,
 			monitor.SortOrder,
+			monitor.Days,
+			monitor.PerRegion,
+			monitor.NameTranslations,
 		); err != nil {
 			return err
 		}
@@ -232,3 +248,142 @@ func (r *PGRepository) DeleteStatusPageGroupsByStatusPageID(ctx context.Context,
 	_, err := tx.Exec(ctx, `DELETE FROM status_page_groups WHERE status_page_id = $1`, statusPageID)
 	return err
 }
+
+// ListStatusPageMonitorsByMonitorIDs returns the status page entries showing any of the given monitors.
+func (r *PGRepository) ListStatusPageMonitorsByMonitorIDs(ctx context.Context, tx pgx.Tx, monitorIDs []int64) ([]models.StatusPageMonitor, error) {
+	if len(monitorIDs) == 0 {
+		return []models.StatusPageMonitor{}, nil
+	}
+
+	query := `
+		SELECT id, status_page_id, monitor_id, group_id, name, type, sort_order, days, per_region, name_translations
+		FROM status_page_monitors
+		WHERE monitor_id = ANY($1)
+		ORDER BY status_page_id, sort_order ASC
+	`
+
+	var monitors []models.StatusPageMonitor
+	if err := pgxscan.Select(ctx, tx, &monitors, query, monitorIDs); err != nil {
+		return nil, err
+	}
+
+	return monitors, nil
+}
+
+// GetStatusPageByCustomDomain returns the status page that verified a custom domain. Unverified claims are
+// not exclusive and never match.
+func (r *PGRepository) GetStatusPageByCustomDomain(ctx context.Context, tx pgx.Tx, domain string) (*models.StatusPage, error) {
+	query := `
+		SELECT ` + statusPageColumns + `
+		FROM status_pages
+		WHERE custom_domain = $1 AND domain_verified_at IS NOT NULL
+	`
+
+	var statusPage models.StatusPage
+	if err := pgxscan.Get(ctx, tx, &statusPage, query, domain); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &statusPage, nil
+}
+
+// UpdateStatusPageDomain sets or clears the custom domain of a status page and resets its verification.
+// A new domain counts as claimed at updatedAt.
+func (r *PGRepository) UpdateStatusPageDomain(ctx context.Context, tx pgx.Tx, teamID, statusPageID int64, domain, verificationToken *string, updatedAt time.Time) (*models.StatusPage, error) {
+	query := `
+		UPDATE status_pages
+		SET custom_domain = $1, domain_verification_token = $2, domain_verified_at = NULL,
+		    domain_claimed_at = CASE WHEN $1::text IS NULL THEN NULL ELSE $3 END, updated_at = $3
+		WHERE id = $4 AND team_id = $5
+		RETURNING ` + statusPageColumns
+
+	var updated models.StatusPage
+	if err := pgxscan.Get(ctx, tx, &updated, query, domain, verificationToken, updatedAt, statusPageID, teamID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// MarkStatusPageDomainVerified records that the custom domain of a status page passed verification.
+// Nothing changes when the domain was replaced meanwhile, since that rotates the token.
+func (r *PGRepository) MarkStatusPageDomainVerified(ctx context.Context, tx pgx.Tx, statusPageID int64, verificationToken string, verifiedAt time.Time) (*models.StatusPage, error) {
+	query := `
+		UPDATE status_pages
+		SET domain_verified_at = $1
+		WHERE id = $2 AND domain_verification_token = $3
+		RETURNING ` + statusPageColumns
+
+	var updated models.StatusPage
+	if err := pgxscan.Get(ctx, tx, &updated, query, verifiedAt, statusPageID, verificationToken); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// ReleaseStatusPageDomainClaims removes an unverified domain from every status page except statusPageID,
+// once that page has verified it.
+func (r *PGRepository) ReleaseStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, domain string, statusPageID int64, updatedAt time.Time) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE status_pages
+		SET custom_domain = NULL, domain_verification_token = NULL, domain_claimed_at = NULL, updated_at = $1
+		WHERE custom_domain = $2 AND id <> $3 AND domain_verified_at IS NULL
+	`, updatedAt, domain, statusPageID)
+	return err
+}
+
+// ExpireStatusPageDomainClaims removes custom domains that were claimed before claimedBefore and never
+// verified. It returns the number of released claims.
+func (r *PGRepository) ExpireStatusPageDomainClaims(ctx context.Context, tx pgx.Tx, claimedBefore, updatedAt time.Time) (int64, error) {
+	result, err := tx.Exec(ctx, `
+		UPDATE status_pages
+		SET custom_domain = NULL, domain_verification_token = NULL, domain_claimed_at = NULL, updated_at = $1
+		WHERE custom_domain IS NOT NULL AND domain_verified_at IS NULL AND domain_claimed_at < $2
+	`, updatedAt, claimedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// statusPageChangesChannel is notified with the id of a status page whenever something shown on it
+// changes; see migration 23.
+const statusPageChangesChannel = "status_page_changes"
+
+// ListenStatusPageChanges calls onChange with the id of every status page changed by a committed
+// transaction, until ctx is done or the connection fails. It holds a pool connection meanwhile.
+func (r *PGRepository) ListenStatusPageChanges(ctx context.Context, onChange func(statusPageID int64)) error {
+	conn, err := r.DB.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+statusPageChangesChannel); err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		statusPageID, err := strconv.ParseInt(notification.Payload, 10, 64)
+		if err != nil {
+			continue
+		}
+		onChange(statusPageID)
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+const statusPageSubscriberColumns = `id, status_page_id, type, target, monitor_ids, token, confirmed_at, confirmation_sent_at, created_at, updated_at`
+
+// UpsertStatusPageSubscriber creates a subscriber or refreshes the monitors of an existing one. The token is
+// kept so that earlier confirmation links stay valid; the stored row is returned.
+func (r *PGRepository) UpsertStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriber models.StatusPageSubscriber) (*models.StatusPageSubscriber, error) {
+	query := `
+		INSERT INTO status_page_subscribers (` + statusPageSubscriberColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (status_page_id, type, target)
+		DO UPDATE SET monitor_ids = EXCLUDED.monitor_ids,
+		              updated_at = EXCLUDED.updated_at
+		RETURNING ` + statusPageSubscriberColumns
+
+	var stored models.StatusPageSubscriber
+	if err := pgxscan.Get(ctx, tx, &stored, query,
+		subscriber.ID,
+		subscriber.StatusPageID,
+		subscriber.Type,
+		subscriber.Target,
+		subscriber.MonitorIDs,
+		subscriber.Token,
+		subscriber.ConfirmedAt,
+		subscriber.ConfirmationSentAt,
+		subscriber.CreatedAt,
+		subscriber.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// GetStatusPageSubscriberByToken fetches a subscriber of a status page by its confirmation/unsubscribe token.
+func (r *PGRepository) GetStatusPageSubscriberByToken(ctx context.Context, tx pgx.Tx, statusPageID int64, token string) (*models.StatusPageSubscriber, error) {
+	query := `
+		SELECT ` + statusPageSubscriberColumns + `
+		FROM status_page_subscribers
+		WHERE status_page_id = $1 AND token = $2
+	`
+
+	var subscriber models.StatusPageSubscriber
+	if err := pgxscan.Get(ctx, tx, &subscriber, query, statusPageID, token); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &subscriber, nil
+}
+
+// GetStatusPageSubscriberByID fetches a subscriber of a status page.
+func (r *PGRepository) GetStatusPageSubscriberByID(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) (*models.StatusPageSubscriber, error) {
+	query := `
+		SELECT ` + statusPageSubscriberColumns + `
+		FROM status_page_subscribers
+		WHERE id = $1 AND status_page_id = $2
+	`
+
+	var subscriber models.StatusPageSubscriber
+	if err := pgxscan.Get(ctx, tx, &subscriber, query, subscriberID, statusPageID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &subscriber, nil
+}
+
+// ListStatusPageSubscribers returns all subscribers of a status page, newest first.
+func (r *PGRepository) ListStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error) {
+	query := `
+		SELECT ` + statusPageSubscriberColumns + `
+		FROM status_page_subscribers
+		WHERE status_page_id = $1
+		ORDER BY created_at DESC
+	`
+
+	var subscribers []models.StatusPageSubscriber
+	if err := pgxscan.Select(ctx, tx, &subscribers, query, statusPageID); err != nil {
+		return nil, err
+	}
+
+	return subscribers, nil
+}
+
+// ListConfirmedStatusPageSubscribers returns the subscribers of a status page that receive updates.
+func (r *PGRepository) ListConfirmedStatusPageSubscribers(ctx context.Context, tx pgx.Tx, statusPageID int64) ([]models.StatusPageSubscriber, error) {
+	query := `
+		SELECT ` + statusPageSubscriberColumns + `
+		FROM status_page_subscribers
+		WHERE status_page_id = $1 AND confirmed_at IS NOT NULL
+		ORDER BY created_at ASC
+	`
+
+	var subscribers []models.StatusPageSubscriber
+	if err := pgxscan.Select(ctx, tx, &subscribers, query, statusPageID); err != nil {
+		return nil, err
+	}
+
+	return subscribers, nil
+}
+
+// ConfirmStatusPageSubscriber marks a subscriber as confirmed.
+func (r *PGRepository) ConfirmStatusPageSubscriber(ctx context.Context, tx pgx.Tx, subscriberID int64, confirmedAt time.Time) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE status_page_subscribers
+		SET confirmed_at = COALESCE(confirmed_at, $1), updated_at = $1
+		WHERE id = $2
+	`, confirmedAt, subscriberID)
+	return err
+}
+
+// MarkStatusPageSubscriberConfirmationSent records that a confirmation goes out to an unconfirmed subscriber now,
+// unless one was already sent after since. It reports whether the caller may send it.
+func (r *PGRepository) MarkStatusPageSubscriberConfirmationSent(ctx context.Context, tx pgx.Tx, subscriberID int64, now, since time.Time) (bool, error) {
+	result, err := tx.Exec(ctx, `
+		UPDATE status_page_subscribers
+		SET confirmation_sent_at = $1
+		WHERE id = $2
+		  AND confirmed_at IS NULL
+		  AND (confirmation_sent_at IS NULL OR confirmation_sent_at <= $3)
+	`, now, subscriberID, since)
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() == 1, nil
+}
+
+// DeleteStatusPageSubscriber removes a subscriber from a status page.
+func (r *PGRepository) DeleteStatusPageSubscriber(ctx context.Context, tx pgx.Tx, statusPageID, subscriberID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM status_page_subscribers WHERE id = $1 AND status_page_id = $2`, subscriberID, statusPageID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/yorukot/kymarium/models"
+)
+
+// CreateUserContactMethod inserts a personal contact method.
+func (r *PGRepository) CreateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) error {
+	query := `
+		INSERT INTO user_contact_methods (id, user_id, type, name, config, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := tx.Exec(ctx, query,
+		method.ID,
+		method.UserID,
+		method.Type,
+		method.Name,
+		method.Config,
+		method.CreatedAt,
+		method.UpdatedAt,
+	)
+	return err
+}
+
+// ListUserContactMethodsByUserID returns the contact methods of a user.
+func (r *PGRepository) ListUserContactMethodsByUserID(ctx context.Context, tx pgx.Tx, userID int64) ([]models.UserContactMethod, error) {
+	query := `
+		SELECT id, user_id, type, name, config, created_at, updated_at
+		FROM user_contact_methods
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	var methods []models.UserContactMethod
+	if err := pgxscan.Select(ctx, tx, &methods, query, userID); err != nil {
+		return nil, err
+	}
+
+	return methods, nil
+}
+
+// UpdateUserContactMethod updates a contact method owned by the user and returns the persisted record.
+func (r *PGRepository) UpdateUserContactMethod(ctx context.Context, tx pgx.Tx, method models.UserContactMethod) (*models.UserContactMethod, error) {
+	query := `
+		UPDATE user_contact_methods
+		SET type = $1, name = $2, config = $3, updated_at = $4
+		WHERE id = $5 AND user_id = $6
+		RETURNING id, user_id, type, name, config, created_at, updated_at
+	`
+
+	var updated models.UserContactMethod
+	if err := tx.QueryRow(ctx, query,
+		method.Type,
+		method.Name,
+		method.Config,
+		method.UpdatedAt,
+		method.ID,
+		method.UserID,
+	).Scan(
+		&updated.ID,
+		&updated.UserID,
+		&updated.Type,
+		&updated.Name,
+		&updated.Config,
+		&updated.CreatedAt,
+		&updated.UpdatedAt,
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteUserContactMethod removes a contact method owned by the user.
+func (r *PGRepository) DeleteUserContactMethod(ctx context.Context, tx pgx.Tx, userID, methodID int64) error {
+	result, err := tx.Exec(ctx, `DELETE FROM user_contact_methods WHERE id = $1 AND user_id = $2`, methodID, userID)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package schedular
+
+import (
+	"context"
+	"time"
+
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/repository"
+	"go.uber.org/zap"
+)
+
+const domainClaimExpiryInterval = time.Hour
+
+// runDomainClaimExpiry periodically releases custom domains that were claimed but never verified.
+func runDomainClaimExpiry(repo repository.Repository) {
+	ticker := time.NewTicker(domainClaimExpiryInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		expireDomainClaims(repo)
+	}
+}
+
+// expireDomainClaims releases unverified custom domains claimed longer than statuspagecore.DomainClaimTTL ago.
+func expireDomainClaims(repo repository.Repository) {
+	ctx := context.Background()
+
+	tx, err := repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to start transaction for domain claim expiry", zap.Error(err))
+		return
+	}
+	defer repo.DeferRollback(ctx, tx)
+
+	now := time.Now().UTC()
+	released, err := repo.ExpireStatusPageDomainClaims(ctx, tx, now.Add(-statuspagecore.DomainClaimTTL), now)
+	if err != nil {
+		zap.L().Error("Failed to expire status page domain claims", zap.Error(err))
+		return
+	}
+
+	if err := repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("Failed to commit domain claim expiry", zap.Error(err))
+		return
+	}
+
+	if released > 0 {
+		zap.L().Info("Released unverified status page domains", zap.Int64("count", released))
+	}
+}
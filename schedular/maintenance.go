@@ -0,0 +1,115 @@
+package schedular
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+const maintenanceInterval = 30 * time.Second
+
+// runMaintenanceTransitions moves maintenances to in progress and completed as their windows start and end.
+func runMaintenanceTransitions(repo repository.Repository, asynqClient *asynq.Client) {
+	ticker := time.NewTicker(maintenanceInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		advanceMaintenances(repo, asynqClient)
+	}
+}
+
+// advanceMaintenances records a timeline entry for every maintenance whose status is behind its window
+// and queues the entries for status page subscribers once committed.
+func advanceMaintenances(repo repository.Repository, asynqClient *asynq.Client) {
+	ctx := context.Background()
+
+	tx, err := repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("Failed to start transaction for maintenance transitions", zap.Error(err))
+		return
+	}
+	defer repo.DeferRollback(ctx, tx)
+
+	now := time.Now().UTC()
+	maintenances, err := repo.ListMaintenancesDueForTransition(ctx, tx, now)
+	if err != nil {
+		zap.L().Error("Failed to fetch maintenances due for transition", zap.Error(err))
+		return
+	}
+
+	if len(maintenances) == 0 {
+		return
+	}
+
+	payloads := make([]tasks.MaintenanceUpdatePayload, 0, len(maintenances))
+	for _, maintenance := range maintenances {
+		status := statuspagecore.MaintenanceStatusAt(maintenance, now)
+		if status == maintenance.Status {
+			continue
+		}
+
+		if err := repo.UpdateMaintenanceStatus(ctx, tx, maintenance.ID, status, now); err != nil {
+			zap.L().Error("Failed to update maintenance status",
+				zap.Int64("maintenance_id", maintenance.ID),
+				zap.String("status", string(status)),
+				zap.Error(err))
+			return
+		}
+
+		updateID, err := id.GetID()
+		if err != nil {
+			zap.L().Error("Failed to generate maintenance update ID", zap.Error(err))
+			return
+		}
+
+		if err := repo.CreateMaintenanceUpdate(ctx, tx, models.MaintenanceUpdate{
+			ID:            updateID,
+			MaintenanceID: maintenance.ID,
+			Status:        status,
+			Message:       statuspagecore.MaintenanceStatusMessage(status),
+			CreatedAt:     now,
+		}); err != nil {
+			zap.L().Error("Failed to create maintenance update",
+				zap.Int64("maintenance_id", maintenance.ID),
+				zap.Error(err))
+			return
+		}
+
+		payloads = append(payloads, tasks.MaintenanceUpdatePayload{
+			TeamID:        maintenance.TeamID,
+			StatusPageID:  maintenance.StatusPageID,
+			MaintenanceID: maintenance.ID,
+			UpdateID:      updateID,
+		})
+	}
+
+	if err := repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("Failed to commit maintenance transitions", zap.Error(err))
+		return
+	}
+
+	for _, payload := range payloads {
+		task, err := tasks.NewMaintenanceUpdate(payload)
+		if err != nil {
+			zap.L().Error("Failed to create maintenance update task", zap.Error(err))
+			continue
+		}
+
+		if _, err := asynqClient.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			zap.L().Error("Failed to enqueue maintenance update task",
+				zap.Int64("maintenance_id", payload.MaintenanceID),
+				zap.Int64("update_id", payload.UpdateID),
+				zap.Error(err))
+		}
+	}
+
+	zap.L().Info("Advanced maintenances", zap.Int("count", len(payloads)))
+}
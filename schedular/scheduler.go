@@ -28,6 +28,9 @@ func Run(pgsql *pgxpool.Pool) {
 	repo := repository.New(pgsql)
 	zap.L().Info("Starting scheduler")
 
+	go runMaintenanceTransitions(repo, asynqClient)
+	go runDomainClaimExpiry(repo)
+
 	// TODO: Implementing graceful shutdown
 	// Create ticker to run every 2 seconds
 	ticker := time.NewTicker(schedulerInterval)
@@ -61,6 +61,13 @@ type EnvConfig struct {
 	SMTPUsername string `env:"SMTP_USERNAME"`
 	SMTPPassword string `env:"SMTP_PASSWORD"`
 	SMTPFrom     string `env:"SMTP_FROM"`
+	SMTPTLSMode  string `env:"SMTP_TLS_MODE" envDefault:"auto"` // auto, implicit, starttls or none
+	SMTPPoolSize int    `env:"SMTP_POOL_SIZE" envDefault:"4"`
+
+	// SlackSigningSecret verifies Slack interactivity requests; the endpoint is disabled when empty.
+	SlackSigningSecret string `env:"SLACK_SIGNING_SECRET"`
+	// TelegramWebhookSecret is the secret_token registered with setWebhook; the endpoint is disabled when empty.
+	TelegramWebhookSecret string `env:"TELEGRAM_WEBHOOK_SECRET"`
 
 	GoogleClientID     string `env:"GOOGLE_CLIENT_ID,required"`
 	GoogleClientSecret string `env:"GOOGLE_CLIENT_SECRET,required"`
@@ -219,3 +219,158 @@ func (j *JWTSecret) ValidateEmailVerificationToken(token string) (bool, EmailVer
 		ExpiresAt: int64(expiresAt),
 	}, nil
 }
+
+// Audiences of the single-purpose tokens. They share the HS256 secret with every other token, so each
+// kind is signed with its own audience and only accepted where that audience is expected.
+const (
+	IncidentActionAudience   = "kymarium:incident-action"
+	StatusPageAccessAudience = "kymarium:status-page-access"
+)
+
+// IncidentActionClaims is the claims for signed one-click incident action links.
+type IncidentActionClaims struct {
+	Subject   string `json:"sub"`
+	TeamID    string `json:"team_id"`
+	Action    string `json:"action"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// GenerateIncidentActionToken generates a token authorizing a single action (e.g. acknowledge) on an incident.
+func (j *JWTSecret) GenerateIncidentActionToken(teamID, incidentID int64, action string, expiresAt time.Time) (string, error) {
+	claims := IncidentActionClaims{
+		Subject:   strconv.FormatInt(incidentID, 10),
+		TeamID:    strconv.FormatInt(teamID, 10),
+		Action:    action,
+		ExpiresAt: expiresAt.Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":     claims.Subject,
+		"team_id": claims.TeamID,
+		"action":  claims.Action,
+		"exp":     claims.ExpiresAt,
+		"aud":     IncidentActionAudience,
+	})
+
+	return token.SignedString([]byte(j.Secret))
+}
+
+// ValidateIncidentActionToken validates an incident action token and extracts claims.
+func (j *JWTSecret) ValidateIncidentActionToken(token string) (bool, IncidentActionClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+		return []byte(j.Secret), nil
+	}, jwt.WithAudience(IncidentActionAudience))
+
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenInvalidClaims) || errors.Is(err, jwt.ErrTokenExpired) ||
+			errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenMalformed) {
+			return false, IncidentActionClaims{}, nil
+		}
+		return false, IncidentActionClaims{}, err
+	}
+
+	subject, ok := claims["sub"].(string)
+	if !ok || subject == "" {
+		return false, IncidentActionClaims{}, nil
+	}
+
+	teamID, ok := claims["team_id"].(string)
+	if !ok || teamID == "" {
+		return false, IncidentActionClaims{}, nil
+	}
+
+	action, ok := claims["action"].(string)
+	if !ok || action == "" {
+		return false, IncidentActionClaims{}, nil
+	}
+
+	expiresAt, ok := claims["exp"].(float64)
+	if !ok {
+		return false, IncidentActionClaims{}, nil
+	}
+
+	if time.Now().Unix() > int64(expiresAt) {
+		return false, IncidentActionClaims{}, nil
+	}
+
+	return true, IncidentActionClaims{
+		Subject:   subject,
+		TeamID:    teamID,
+		Action:    action,
+		ExpiresAt: int64(expiresAt),
+	}, nil
+}
+
+// StatusPageAccessClaims is the claims for the access cookie of a password-protected status page.
+type StatusPageAccessClaims struct {
+	Subject   string `json:"sub"`
+	Password  string `json:"pwd"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// GenerateStatusPageAccessToken generates a token granting access to a password-protected status page.
+// passwordFingerprint ties the token to the current password so changing it revokes existing tokens.
+func (j *JWTSecret) GenerateStatusPageAccessToken(statusPageID int64, passwordFingerprint string, expiresAt time.Time) (string, error) {
+	claims := StatusPageAccessClaims{
+		Subject:   strconv.FormatInt(statusPageID, 10),
+		Password:  passwordFingerprint,
+		ExpiresAt: expiresAt.Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": claims.Subject,
+		"pwd": claims.Password,
+		"exp": claims.ExpiresAt,
+		"aud": StatusPageAccessAudience,
+	})
+
+	return token.SignedString([]byte(j.Secret))
+}
+
+// ValidateStatusPageAccessToken validates a status page access token and extracts claims.
+func (j *JWTSecret) ValidateStatusPageAccessToken(token string) (bool, StatusPageAccessClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+		return []byte(j.Secret), nil
+	}, jwt.WithAudience(StatusPageAccessAudience))
+
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenInvalidClaims) || errors.Is(err, jwt.ErrTokenExpired) ||
+			errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenMalformed) {
+			return false, StatusPageAccessClaims{}, nil
+		}
+		return false, StatusPageAccessClaims{}, err
+	}
+
+	subject, ok := claims["sub"].(string)
+	if !ok || subject == "" {
+		return false, StatusPageAccessClaims{}, nil
+	}
+
+	password, ok := claims["pwd"].(string)
+	if !ok || password == "" {
+		return false, StatusPageAccessClaims{}, nil
+	}
+
+	expiresAt, ok := claims["exp"].(float64)
+	if !ok {
+		return false, StatusPageAccessClaims{}, nil
+	}
+
+	if time.Now().Unix() > int64(expiresAt) {
+		return false, StatusPageAccessClaims{}, nil
+	}
+
+	return true, StatusPageAccessClaims{
+		Subject:   subject,
+		Password:  password,
+		ExpiresAt: int64(expiresAt),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package encrypt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSinglePurposeTokensAreNotInterchangeable(t *testing.T) {
+	secret := &JWTSecret{Secret: "test-secret"}
+	expiresAt := time.Now().Add(time.Hour)
+
+	actionToken, err := secret.GenerateIncidentActionToken(2, 1, "acknowledge", expiresAt)
+	require.NoError(t, err)
+	accessToken, err := secret.GenerateStatusPageAccessToken(1, "fingerprint", expiresAt)
+	require.NoError(t, err)
+
+	valid, _, err := secret.ValidateIncidentActionToken(actionToken)
+	require.NoError(t, err)
+	require.True(t, valid)
+	valid, _, err = secret.ValidateStatusPageAccessToken(accessToken)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, _, err = secret.ValidateStatusPageAccessToken(actionToken)
+	require.NoError(t, err)
+	require.False(t, valid)
+	valid, _, err = secret.ValidateIncidentActionToken(accessToken)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	// Tokens issued before audiences were added carry none and are refused.
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "1",
+		"pwd": "fingerprint",
+		"exp": expiresAt.Unix(),
+	}).SignedString([]byte(secret.Secret))
+	require.NoError(t, err)
+	valid, _, err = secret.ValidateStatusPageAccessToken(legacy)
+	require.NoError(t, err)
+	require.False(t, valid)
+}
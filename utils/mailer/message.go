@@ -0,0 +1,123 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Message is a multipart text/HTML email. Bcc recipients receive the message but are not listed in the headers.
+type Message struct {
+	From       string
+	To         []string
+	Bcc        []string
+	Subject    string
+	Text       string
+	HTML       string
+	MessageID  string
+	InReplyTo  string
+	References []string
+	Date       time.Time
+	// ListUnsubscribe is an HTTPS unsubscribe link advertised with RFC 8058 one-click unsubscribe headers.
+	ListUnsubscribe string
+}
+
+// Recipients returns the unique envelope recipients of the message.
+func (m Message) Recipients() []string {
+	seen := make(map[string]struct{})
+	var out []string
+	for _, addr := range append(append([]string{}, m.To...), m.Bcc...) {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		out = append(out, addr)
+	}
+	return out
+}
+
+// Bytes renders the message as multipart/alternative with quoted-printable parts.
+// The HTML part is omitted when empty.
+func (m Message) Bytes() ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if err := writePart(writer, "text/plain", m.Text); err != nil {
+		return nil, err
+	}
+	if m.HTML != "" {
+		if err := writePart(writer, "text/html", m.HTML); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	date := m.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", m.From)
+	if to := strings.Join(m.To, ", "); to != "" {
+		writeHeader(&buf, "To", to)
+	}
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	writeHeader(&buf, "Date", date.Format(time.RFC1123Z))
+	if m.MessageID != "" {
+		writeHeader(&buf, "Message-ID", m.MessageID)
+	}
+	if m.InReplyTo != "" {
+		writeHeader(&buf, "In-Reply-To", m.InReplyTo)
+	}
+	if len(m.References) > 0 {
+		writeHeader(&buf, "References", strings.Join(m.References, " "))
+	}
+	if m.ListUnsubscribe != "" {
+		writeHeader(&buf, "List-Unsubscribe", "<"+m.ListUnsubscribe+">")
+		writeHeader(&buf, "List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	writeHeader(&buf, "MIME-Version", "1.0")
+	writeHeader(&buf, "Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", writer.Boundary()))
+	buf.WriteString("\r\n")
+	buf.Write(body.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+func writePart(writer *multipart.Writer, contentType, content string) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType+`; charset="UTF-8"`)
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeHeader(buf *bytes.Buffer, key, value string) {
+	value = strings.ReplaceAll(value, "\r", "")
+	value = strings.ReplaceAll(value, "\n", "")
+	buf.WriteString(key)
+	buf.WriteString(": ")
+	buf.WriteString(value)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,308 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"sync"
+	"time"
+)
+
+// TLSMode selects how connections to the SMTP server are secured.
+type TLSMode string
+
+// TLSMode values.
+const (
+	// TLSModeAuto uses implicit TLS on port 465 and STARTTLS whenever the server offers it otherwise.
+	TLSModeAuto TLSMode = "auto"
+	// TLSModeImplicit wraps the connection in TLS before the SMTP greeting (SMTPS).
+	TLSModeImplicit TLSMode = "implicit"
+	// TLSModeStartTLS requires the server to upgrade the connection with STARTTLS.
+	TLSModeStartTLS TLSMode = "starttls"
+	// TLSModeNone never upgrades the connection; only meant for local relays.
+	TLSModeNone TLSMode = "none"
+)
+
+// Default pool settings.
+const (
+	DefaultPoolSize    = 4
+	DefaultIdleTimeout = 30 * time.Second
+	DefaultTimeout     = 30 * time.Second
+)
+
+// Config describes the SMTP server and how the pool talks to it.
+type Config struct {
+	Host        string
+	Port        string
+	Username    string
+	Password    string
+	TLSMode     TLSMode
+	TLSConfig   *tls.Config
+	PoolSize    int
+	IdleTimeout time.Duration
+	Timeout     time.Duration
+}
+
+// Pool keeps authenticated SMTP connections open so consecutive messages skip the handshake.
+// It is safe for concurrent use; each send holds one connection exclusively.
+type Pool struct {
+	cfg Config
+
+	mu     sync.Mutex
+	idle   []*conn
+	closed bool
+}
+
+type conn struct {
+	raw      net.Conn
+	client   *smtp.Client
+	lastUsed time.Time
+}
+
+// NewPool creates a pool for the given server. Connections are dialed lazily.
+func NewPool(cfg Config) *Pool {
+	if cfg.TLSMode == "" {
+		cfg.TLSMode = TLSModeAuto
+	}
+	if cfg.PoolSize <= 0 {
+		cfg.PoolSize = DefaultPoolSize
+	}
+	if cfg.IdleTimeout <= 0 {
+		cfg.IdleTimeout = DefaultIdleTimeout
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	return &Pool{cfg: cfg}
+}
+
+// SendMessage renders and sends a message to its To and Bcc recipients.
+func (p *Pool) SendMessage(ctx context.Context, msg Message) error {
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+	return p.Send(ctx, msg.From, msg.Recipients(), body)
+}
+
+// Send delivers a raw RFC 5322 message. A pooled connection the server has dropped is retried once on a fresh one.
+func (p *Pool) Send(ctx context.Context, from string, recipients []string, msg []byte) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("no recipients")
+	}
+
+	c, reused, err := p.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = p.deliver(ctx, c, from, recipients, msg)
+	if err != nil && reused && !isPermanent(err) {
+		c.close()
+		if c, err = p.dial(ctx); err != nil {
+			return err
+		}
+		err = p.deliver(ctx, c, from, recipients, msg)
+	}
+
+	if err != nil {
+		c.close()
+		return err
+	}
+
+	p.put(c)
+	return nil
+}
+
+// Close quits all idle connections. Sends after Close dial fresh connections that are not pooled.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	idle := p.idle
+	p.idle = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, c := range idle {
+		_ = c.client.Quit()
+		c.close()
+	}
+	return nil
+}
+
+func (p *Pool) get(ctx context.Context) (*conn, bool, error) {
+	for {
+		p.mu.Lock()
+		if len(p.idle) == 0 {
+			p.mu.Unlock()
+			c, err := p.dial(ctx)
+			return c, false, err
+		}
+		c := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		p.mu.Unlock()
+
+		if time.Since(c.lastUsed) > p.cfg.IdleTimeout {
+			_ = c.client.Quit()
+			c.close()
+			continue
+		}
+
+		// RSET clears any leftover transaction state and doubles as a liveness check.
+		p.setDeadline(ctx, c)
+		if err := c.client.Reset(); err != nil {
+			c.close()
+			continue
+		}
+		return c, true, nil
+	}
+}
+
+func (p *Pool) put(c *conn) {
+	c.lastUsed = time.Now()
+	_ = c.raw.SetDeadline(time.Time{})
+
+	p.mu.Lock()
+	if p.closed || len(p.idle) >= p.cfg.PoolSize {
+		p.mu.Unlock()
+		_ = c.client.Quit()
+		c.close()
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.mu.Unlock()
+}
+
+func (p *Pool) dial(ctx context.Context) (*conn, error) {
+	addr := net.JoinHostPort(p.cfg.Host, p.cfg.Port)
+	dialer := &net.Dialer{Timeout: p.cfg.Timeout}
+	mode := p.mode()
+
+	var raw net.Conn
+	var err error
+	if mode == TLSModeImplicit {
+		raw, err = (&tls.Dialer{NetDialer: dialer, Config: p.tlsConfig()}).DialContext(ctx, "tcp", addr)
+	} else {
+		raw, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("smtp dial failed: %w", err)
+	}
+
+	c := &conn{raw: raw}
+	p.setDeadline(ctx, c)
+
+	c.client, err = smtp.NewClient(raw, p.cfg.Host)
+	if err != nil {
+		_ = raw.Close()
+		return nil, fmt.Errorf("new smtp client failed: %w", err)
+	}
+
+	if err := p.secure(c.client, mode); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	if err := p.authenticate(c.client); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (p *Pool) secure(client *smtp.Client, mode TLSMode) error {
+	if mode == TLSModeImplicit || mode == TLSModeNone {
+		return nil
+	}
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(p.tlsConfig()); err != nil {
+			return fmt.Errorf("starttls failed: %w", err)
+		}
+		return nil
+	}
+
+	if mode == TLSModeStartTLS {
+		return fmt.Errorf("server does not support STARTTLS")
+	}
+	if p.cfg.Username != "" {
+		return fmt.Errorf("server does not support STARTTLS; refusing to auth over insecure connection")
+	}
+	return nil
+}
+
+func (p *Pool) authenticate(client *smtp.Client) error {
+	if p.cfg.Username == "" {
+		return nil
+	}
+	// Sending unauthenticated would hand the message to a relay that may accept and then drop it.
+	if ok, _ := client.Extension("AUTH"); !ok {
+		return fmt.Errorf("server does not support AUTH; refusing to send without the configured credentials")
+	}
+	if err := client.Auth(smtp.PlainAuth("", p.cfg.Username, p.cfg.Password, p.cfg.Host)); err != nil {
+		return fmt.Errorf("smtp auth failed: %w", err)
+	}
+	return nil
+}
+
+func (p *Pool) deliver(ctx context.Context, c *conn, from string, recipients []string, msg []byte) error {
+	p.setDeadline(ctx, c)
+
+	if err := c.client.Mail(from); err != nil {
+		return fmt.Errorf("MAIL FROM failed: %w", err)
+	}
+	for _, rcpt := range recipients {
+		if err := c.client.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("RCPT TO failed (%s): %w", rcpt, err)
+		}
+	}
+
+	w, err := c.client.Data()
+	if err != nil {
+		return fmt.Errorf("DATA failed: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		_ = w.Close()
+		return fmt.Errorf("write message failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("close DATA failed: %w", err)
+	}
+	return nil
+}
+
+func (p *Pool) mode() TLSMode {
+	if p.cfg.TLSMode == TLSModeAuto && p.cfg.Port == "465" {
+		return TLSModeImplicit
+	}
+	return p.cfg.TLSMode
+}
+
+func (p *Pool) tlsConfig() *tls.Config {
+	if p.cfg.TLSConfig != nil {
+		return p.cfg.TLSConfig
+	}
+	return &tls.Config{ServerName: p.cfg.Host}
+}
+
+func (p *Pool) setDeadline(ctx context.Context, c *conn) {
+	deadline := time.Now().Add(p.cfg.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = c.raw.SetDeadline(deadline)
+}
+
+func (c *conn) close() {
+	_ = c.raw.Close()
+}
+
+// isPermanent reports whether the server rejected the message itself, which a fresh connection would not fix.
+func isPermanent(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code >= 500
+}
@@ -0,0 +1,238 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// smtpServer is a minimal in-process SMTP server recording accepted messages.
+type smtpServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	startTLS  bool
+
+	mu       sync.Mutex
+	conns    int
+	messages []string
+	rcpts    [][]string
+}
+
+func newSMTPServer(t *testing.T, implicitTLS, startTLS bool) (*smtpServer, *tls.Config) {
+	t.Helper()
+
+	// Reuse the httptest certificate, which is valid for 127.0.0.1.
+	ts := httptest.NewUnstartedServer(http.NotFoundHandler())
+	ts.StartTLS()
+	serverTLS := ts.TLS.Clone()
+	roots := x509.NewCertPool()
+	roots.AddCert(ts.Certificate())
+	ts.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if implicitTLS {
+		listener = tls.NewListener(listener, serverTLS)
+	}
+
+	server := &smtpServer{listener: listener, tlsConfig: serverTLS, startTLS: startTLS}
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return server, &tls.Config{RootCAs: roots, ServerName: "127.0.0.1"}
+}
+
+func (s *smtpServer) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *smtpServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+	reply("220 localhost ESMTP")
+
+	var rcpts []string
+	upgraded := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			if s.startTLS && !upgraded {
+				reply("250-localhost")
+				reply("250 STARTTLS")
+			} else {
+				reply("250 localhost")
+			}
+		case cmd == "STARTTLS":
+			reply("220 ready")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			reader = bufio.NewReader(conn)
+			upgraded = true
+		case strings.HasPrefix(cmd, "MAIL FROM"):
+			rcpts = nil
+			reply("250 ok")
+		case strings.HasPrefix(cmd, "RCPT TO"):
+			rcpts = append(rcpts, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			reply("250 ok")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data.String())
+			s.rcpts = append(s.rcpts, rcpts)
+			s.mu.Unlock()
+			reply("250 queued")
+		case cmd == "RSET" || cmd == "NOOP":
+			reply("250 ok")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func testMessage() Message {
+	return Message{
+		From:      "alerts@example.com",
+		To:        []string{"ops@example.com"},
+		Bcc:       []string{"oncall@example.com"},
+		Subject:   "api is FAILED",
+		Text:      "Monitor: api",
+		HTML:      "<p>Monitor: <b>api</b></p>",
+		MessageID: "<incident-1@example.com>",
+	}
+}
+
+func TestPool_ReusesConnection(t *testing.T) {
+	server, _ := newSMTPServer(t, false, false)
+	pool := NewPool(Config{Host: "127.0.0.1", Port: server.port(), TLSMode: TLSModeNone})
+	defer pool.Close()
+
+	require.NoError(t, pool.SendMessage(context.Background(), testMessage()))
+	require.NoError(t, pool.SendMessage(context.Background(), testMessage()))
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Equal(t, 1, server.conns)
+	require.Len(t, server.messages, 2)
+	require.Equal(t, []string{"ops@example.com", "oncall@example.com"}, server.rcpts[0])
+}
+
+func TestPool_ImplicitTLS(t *testing.T) {
+	server, clientTLS := newSMTPServer(t, true, false)
+	pool := NewPool(Config{Host: "127.0.0.1", Port: server.port(), TLSMode: TLSModeImplicit, TLSConfig: clientTLS})
+	defer pool.Close()
+
+	require.NoError(t, pool.SendMessage(context.Background(), testMessage()))
+}
+
+func TestPool_StartTLS(t *testing.T) {
+	server, clientTLS := newSMTPServer(t, false, true)
+	pool := NewPool(Config{Host: "127.0.0.1", Port: server.port(), TLSMode: TLSModeStartTLS, TLSConfig: clientTLS})
+	defer pool.Close()
+
+	require.NoError(t, pool.SendMessage(context.Background(), testMessage()))
+}
+
+func TestPool_StartTLSRequired(t *testing.T) {
+	server, _ := newSMTPServer(t, false, false)
+	pool := NewPool(Config{Host: "127.0.0.1", Port: server.port(), TLSMode: TLSModeStartTLS})
+	defer pool.Close()
+
+	require.ErrorContains(t, pool.SendMessage(context.Background(), testMessage()), "STARTTLS")
+}
+
+func TestPool_AuthRequired(t *testing.T) {
+	server, _ := newSMTPServer(t, false, false)
+	pool := NewPool(Config{Host: "127.0.0.1", Port: server.port(), Username: "alerts", Password: "secret", TLSMode: TLSModeNone})
+	defer pool.Close()
+
+	require.ErrorContains(t, pool.SendMessage(context.Background(), testMessage()), "AUTH")
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Empty(t, server.messages)
+}
+
+func TestMessage_Bytes(t *testing.T) {
+	msg := testMessage()
+	msg.InReplyTo = "<incident-1@example.com>"
+	msg.References = []string{"<incident-1@example.com>"}
+	msg.ListUnsubscribe = "https://status.example.com/unsubscribe?token=abc"
+
+	raw, err := msg.Bytes()
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+	require.Equal(t, "<incident-1@example.com>", parsed.Header.Get("In-Reply-To"))
+	require.Empty(t, parsed.Header.Get("Bcc"))
+	require.Equal(t, "<https://status.example.com/unsubscribe?token=abc>", parsed.Header.Get("List-Unsubscribe"))
+	require.Equal(t, "List-Unsubscribe=One-Click", parsed.Header.Get("List-Unsubscribe-Post"))
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/alternative", mediaType)
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	var types []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		types = append(types, strings.Split(part.Header.Get("Content-Type"), ";")[0])
+	}
+	require.Equal(t, []string{"text/plain", "text/html"}, types)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	monitorcore "github.com/yorukot/kymarium/core/monitor"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// checkCertExpiry sends a cert_expiring event once per certificate when an HTTP monitor with certificate
+// expiry notifications sees a certificate within monitorcore.CertExpiryWarning of expiring.
+func (h *Handler) checkCertExpiry(ctx context.Context, monitor models.Monitor, ping models.Ping, regionID int64, notAfter time.Time) {
+	if monitor.Type != models.MonitorTypeHTTP {
+		return
+	}
+
+	cfg, err := monitor.HTTPConfig()
+	if err != nil || !cfg.CertificateExpiryNotification {
+		return
+	}
+
+	now := time.Now().UTC()
+	if !monitorcore.CertExpiresSoon(notAfter, now) {
+		return
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("failed to start certificate expiry transaction",
+			zap.Int64("monitor_id", monitor.ID),
+			zap.Error(err))
+		return
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	// Every region sees the same certificate, so the first one to mark it sends the warning.
+	marked, err := h.repo.MarkMonitorCertExpiryNotified(ctx, tx, monitor.ID, notAfter.UTC())
+	if err != nil {
+		zap.L().Error("failed to mark certificate expiry notified",
+			zap.Int64("monitor_id", monitor.ID),
+			zap.Error(err))
+		return
+	}
+
+	if !marked {
+		return
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("failed to commit certificate expiry transaction",
+			zap.Int64("monitor_id", monitor.ID),
+			zap.Error(err))
+		return
+	}
+
+	h.enqueueNotificationTasks(monitor, models.Incident{}, models.NotificationEventTypeCertExpiring, ping, regionID, certExpiryDetail(notAfter, now), false)
+}
+
+// certExpiryDetail describes when a certificate expires relative to now.
+func certExpiryDetail(notAfter, now time.Time) string {
+	expiresAt := notAfter.UTC().Format("2006-01-02 15:04 UTC")
+	if !notAfter.After(now) {
+		return fmt.Sprintf("The TLS certificate expired on %s.", expiresAt)
+	}
+
+	days := int(math.Ceil(notAfter.Sub(now).Hours() / 24))
+	if days == 1 {
+		return fmt.Sprintf("The TLS certificate expires on %s, in 1 day.", expiresAt)
+	}
+	return fmt.Sprintf("The TLS certificate expires on %s, in %d days.", expiresAt, days)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/models/monitorm"
+	"github.com/yorukot/kymarium/repository"
+)
+
+func TestCheckCertExpiry(t *testing.T) {
+	enabled, err := json.Marshal(monitorm.HTTPMonitorConfig{URL: "https://example.com", Method: monitorm.MethodGet, CertificateExpiryNotification: true})
+	require.NoError(t, err)
+	disabled, err := json.Marshal(monitorm.HTTPMonitorConfig{URL: "https://example.com", Method: monitorm.MethodGet})
+	require.NoError(t, err)
+
+	soon := time.Now().UTC().Add(3 * 24 * time.Hour).Truncate(time.Second)
+	later := time.Now().UTC().Add(60 * 24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		config   json.RawMessage
+		notAfter time.Time
+		marked   bool
+		wantMark bool
+	}{
+		{name: "notifications off", config: disabled, notAfter: soon},
+		{name: "no certificate", config: enabled},
+		{name: "not expiring yet", config: enabled, notAfter: later},
+		{name: "first region to see it", config: enabled, notAfter: soon, marked: true, wantMark: true},
+		{name: "already announced", config: enabled, notAfter: soon, wantMark: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repository.MockRepository{}
+			mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+			mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+			mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("MarkMonitorCertExpiryNotified", mock.Anything, mock.Anything, int64(10), tt.notAfter).Return(tt.marked, nil)
+
+			monitor := models.Monitor{ID: 10, TeamID: 1, Type: models.MonitorTypeHTTP, Config: tt.config}
+			h := &Handler{repo: mockRepo}
+			h.checkCertExpiry(context.Background(), monitor, models.Ping{Status: models.PingStatusSuccessful}, 1, tt.notAfter)
+
+			if tt.wantMark {
+				mockRepo.AssertCalled(t, "MarkMonitorCertExpiryNotified", mock.Anything, mock.Anything, int64(10), tt.notAfter)
+			} else {
+				mockRepo.AssertNotCalled(t, "MarkMonitorCertExpiryNotified", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tt.marked {
+				mockRepo.AssertCalled(t, "CommitTransaction", mock.Anything, mock.Anything)
+			} else {
+				mockRepo.AssertNotCalled(t, "CommitTransaction", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCertExpiryDetail(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "The TLS certificate expires on 2026-03-04 12:00 UTC, in 3 days.", certExpiryDetail(now.Add(72*time.Hour), now))
+	require.Equal(t, "The TLS certificate expires on 2026-03-01 18:00 UTC, in 1 day.", certExpiryDetail(now.Add(6*time.Hour), now))
+	require.Equal(t, "The TLS certificate expired on 2026-02-28 12:00 UTC.", certExpiryDetail(now.Add(-24*time.Hour), now))
+}
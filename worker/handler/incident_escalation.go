@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	escalationcore "github.com/yorukot/kymarium/core/escalation"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// HandleIncidentEscalation notifies the targets of one escalation level and schedules the next.
+// Each step re-reads the incident, so acknowledging or resolving it stops the chain.
+func (h *Handler) HandleIncidentEscalation(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.IncidentEscalationPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid incident escalation payload", zap.Error(err))
+		return err
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	incident, err := h.repo.GetIncidentByIDForTeam(ctx, tx, payload.TeamID, payload.IncidentID)
+	if err != nil {
+		return err
+	}
+
+	monitor, err := h.repo.GetMonitorByID(ctx, tx, payload.TeamID, payload.MonitorID)
+	if err != nil {
+		return err
+	}
+
+	// Levels of a policy that was detached or swapped meanwhile are not loaded; Decide stops on it.
+	var levels []models.EscalationPolicyLevel
+	if monitor != nil && monitor.EscalationPolicyID != nil && *monitor.EscalationPolicyID == payload.PolicyID {
+		levels, err = h.repo.ListEscalationPolicyLevelsByPolicyIDs(ctx, tx, []int64{payload.PolicyID})
+		if err != nil {
+			return err
+		}
+	}
+
+	step := escalationcore.Decide(incident, monitor, payload.PolicyID, payload.Level, levels)
+	if step.Stop != "" {
+		zap.L().Debug("incident escalation stopped",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Int("level", payload.Level),
+			zap.String("reason", string(step.Stop)))
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if err := h.repo.CreateEventTimeline(ctx, tx, models.EventTimeline{
+		IncidentID: incident.ID,
+		Message:    escalationMessage(payload.Level, step.Total, step.Level.Targets),
+		EventType:  models.IncidentEventTypeNotificationSent,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}); err != nil {
+		return err
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	h.notifyEscalationTargets(ctx, *monitor, incident.Severity, payload, step.Level.Targets)
+
+	if step.Next != 0 {
+		next := payload
+		next.Level = step.Next
+		h.enqueueEscalation(next, step.NextDelay)
+	}
+
+	return nil
+}
+
+// startEscalation schedules the first level of the monitor's escalation policy, if any.
+func (h *Handler) startEscalation(monitor models.Monitor, incidentID int64, ping models.Ping, regionID int64, detail string) {
+	if monitor.EscalationPolicyID == nil {
+		return
+	}
+
+	h.enqueueEscalation(tasks.IncidentEscalationPayload{
+		TeamID:     monitor.TeamID,
+		MonitorID:  monitor.ID,
+		IncidentID: incidentID,
+		PolicyID:   *monitor.EscalationPolicyID,
+		Level:      1,
+		RegionID:   regionID,
+		Ping:       ping,
+		Detail:     detail,
+	}, 0)
+}
+
+func (h *Handler) enqueueEscalation(payload tasks.IncidentEscalationPayload, delay time.Duration) {
+	if h.notifier == nil {
+		return
+	}
+
+	task, err := tasks.NewIncidentEscalation(payload, delay)
+	if err != nil {
+		zap.L().Error("failed to create incident escalation task",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := h.notifier.Enqueue(task); err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return
+		}
+		zap.L().Error("failed to enqueue incident escalation task",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Int("level", payload.Level),
+			zap.Error(err))
+	}
+}
+
+// notifyEscalationTargets fans out to notification channels via dispatch tasks and pages users directly.
+// Routing rules are bypassed: an escalation target has explicitly asked to be paged.
+func (h *Handler) notifyEscalationTargets(ctx context.Context, monitor models.Monitor, severity models.IncidentSeverity, payload tasks.IncidentEscalationPayload, targets []models.EscalationTarget) {
+	detail := fmt.Sprintf("Escalation level %d", payload.Level)
+	if trimmed := strings.TrimSpace(payload.Detail); trimmed != "" {
+		detail = fmt.Sprintf("%s: %s", detail, trimmed)
+	}
+
+	dispatch := tasks.NotificationPayload{
+		TeamID:     payload.TeamID,
+		MonitorID:  payload.MonitorID,
+		IncidentID: payload.IncidentID,
+		RegionID:   payload.RegionID,
+		EventType:  models.NotificationEventTypeDown,
+		Severity:   severity,
+		FollowUp:   true,
+		Ping:       payload.Ping,
+		Detail:     detail,
+	}
+
+	for _, target := range targets {
+		switch target.Type {
+		case models.EscalationTargetTypeNotification:
+			if h.notifier == nil {
+				continue
+			}
+
+			dispatch.NotificationID = target.ID
+			task, err := tasks.NewNotificationDispatch(dispatch)
+			if err != nil {
+				zap.L().Error("failed to create escalation notification task",
+					zap.Int64("incident_id", payload.IncidentID),
+					zap.Int64("notification_id", target.ID),
+					zap.Error(err))
+				continue
+			}
+
+			if _, err := h.notifier.Enqueue(task); err != nil {
+				zap.L().Error("failed to enqueue escalation notification task",
+					zap.Int64("incident_id", payload.IncidentID),
+					zap.Int64("notification_id", target.ID),
+					zap.Error(err))
+			}
+		case models.EscalationTargetTypeUser:
+			h.pageEscalationUser(ctx, monitor, dispatch, target.ID)
+		case models.EscalationTargetTypeSchedule:
+			userID, err := h.currentOnCall(ctx, monitor.TeamID, target.ID, time.Now().UTC())
+			if err != nil {
+				zap.L().Error("failed to resolve on-call user for escalation",
+					zap.Int64("incident_id", payload.IncidentID),
+					zap.Int64("schedule_id", target.ID),
+					zap.Error(err))
+				continue
+			}
+
+			if userID == 0 {
+				zap.L().Warn("nobody on call for escalation schedule",
+					zap.Int64("incident_id", payload.IncidentID),
+					zap.Int64("schedule_id", target.ID))
+				continue
+			}
+
+			h.pageEscalationUser(ctx, monitor, dispatch, userID)
+		}
+	}
+}
+
+// pageEscalationUser formats the escalation like a channel message and pages the user directly.
+func (h *Handler) pageEscalationUser(ctx context.Context, monitor models.Monitor, dispatch tasks.NotificationPayload, userID int64) {
+	region := config.RegionByID(dispatch.RegionID)
+	title, description := notificationcore.FormatMessage(notificationcore.MessageInput{
+		MonitorName: monitor.Name,
+		Status:      dispatch.Ping.Status,
+		RegionName:  region.Name,
+		LatencyMs:   dispatch.Ping.Latency,
+		CheckedAt:   dispatch.Ping.Time,
+		Detail:      dispatch.Detail,
+		AckURL:      ackURL(dispatch),
+	})
+
+	if _, err := h.pageUser(ctx, monitor.TeamID, userID, dispatchMessage(monitor, dispatch, title, description)); err != nil {
+		zap.L().Error("failed to page escalation user",
+			zap.Int64("incident_id", dispatch.IncidentID),
+			zap.Int64("user_id", userID),
+			zap.Error(err))
+	}
+}
+
+func escalationMessage(level, total int, targets []models.EscalationTarget) string {
+	return fmt.Sprintf("Escalated to level %d of %d (%d target(s))", level, total, len(targets))
+}
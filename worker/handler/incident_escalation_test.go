@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/worker/tasks"
+)
+
+func TestHandleIncidentEscalation_NotifiesLevel(t *testing.T) {
+	policyID := int64(5)
+	mockRepo := &repository.MockRepository{}
+	mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+	mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+	mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(20)).Return(&models.Incident{ID: 20, Status: models.IncidentStatusDetected}, nil)
+	mockRepo.On("GetMonitorByID", mock.Anything, mock.Anything, int64(1), int64(10)).Return(&models.Monitor{ID: 10, TeamID: 1, EscalationPolicyID: &policyID}, nil)
+	mockRepo.On("ListEscalationPolicyLevelsByPolicyIDs", mock.Anything, mock.Anything, []int64{5}).Return([]models.EscalationPolicyLevel{
+		{ID: 101, PolicyID: 5, Position: 1, DelayMinutes: 5, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeNotification, ID: 1}}},
+		{ID: 102, PolicyID: 5, Position: 2, DelayMinutes: 10, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeNotification, ID: 2}}},
+	}, nil)
+	mockRepo.On("CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	body, err := json.Marshal(tasks.IncidentEscalationPayload{TeamID: 1, MonitorID: 10, IncidentID: 20, PolicyID: 5, Level: 2})
+	require.NoError(t, err)
+
+	h := &Handler{repo: mockRepo}
+	require.NoError(t, h.HandleIncidentEscalation(context.Background(), asynq.NewTask(tasks.TypeIncidentEscalation, body)))
+
+	mockRepo.AssertCalled(t, "CreateEventTimeline", mock.Anything, mock.Anything, mock.MatchedBy(func(event models.EventTimeline) bool {
+		return event.IncidentID == 20 && event.EventType == models.IncidentEventTypeNotificationSent &&
+			event.Message == "Escalated to level 2 of 2 (1 target(s))"
+	}))
+}
+
+func TestHandleIncidentEscalation_Stops(t *testing.T) {
+	policyID := int64(5)
+	otherPolicyID := int64(6)
+	acknowledgedAt := time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		incident *models.Incident
+		monitor  *models.Monitor
+		level    int
+	}{
+		{"acknowledged", &models.Incident{ID: 20, AcknowledgedAt: &acknowledgedAt}, &models.Monitor{ID: 10, EscalationPolicyID: &policyID}, 2},
+		{"resolved", &models.Incident{ID: 20, Status: models.IncidentStatusResolved}, &models.Monitor{ID: 10, EscalationPolicyID: &policyID}, 2},
+		{"policy swapped", &models.Incident{ID: 20}, &models.Monitor{ID: 10, EscalationPolicyID: &otherPolicyID}, 2},
+		{"level removed", &models.Incident{ID: 20}, &models.Monitor{ID: 10, EscalationPolicyID: &policyID}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repository.MockRepository{}
+			mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+			mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+			mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(20)).Return(tt.incident, nil)
+			mockRepo.On("GetMonitorByID", mock.Anything, mock.Anything, int64(1), int64(10)).Return(tt.monitor, nil)
+			mockRepo.On("ListEscalationPolicyLevelsByPolicyIDs", mock.Anything, mock.Anything, []int64{5}).Return([]models.EscalationPolicyLevel{
+				{ID: 101, PolicyID: 5, Position: 1, DelayMinutes: 5, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeNotification, ID: 1}}},
+				{ID: 102, PolicyID: 5, Position: 2, DelayMinutes: 10, Targets: []models.EscalationTarget{{Type: models.EscalationTargetTypeNotification, ID: 2}}},
+			}, nil)
+			mockRepo.On("CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			body, err := json.Marshal(tasks.IncidentEscalationPayload{TeamID: 1, MonitorID: 10, IncidentID: 20, PolicyID: 5, Level: tt.level})
+			require.NoError(t, err)
+
+			h := &Handler{repo: mockRepo}
+			require.NoError(t, h.HandleIncidentEscalation(context.Background(), asynq.NewTask(tasks.TypeIncidentEscalation, body)))
+			mockRepo.AssertNotCalled(t, "CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestIncidentEscalationTaskID(t *testing.T) {
+	// The same incident and level always map to the same task, so a level re-scheduled after a restart is
+	// rejected by Asynq instead of paging twice.
+	require.Equal(t, tasks.IncidentEscalationTaskID(20, 2), tasks.IncidentEscalationTaskID(20, 2))
+	require.NotEqual(t, tasks.IncidentEscalationTaskID(20, 2), tasks.IncidentEscalationTaskID(20, 3))
+	require.NotEqual(t, tasks.IncidentEscalationTaskID(20, 2), tasks.IncidentEscalationTaskID(21, 2))
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// HandleIncidentManualUpdate notifies the channels linked to the incident's monitors of a status change or
+// update posted by a team member. Routing rules see it as a manual_status_update event, which only channels
+// with a rule naming it receive.
+func (h *Handler) HandleIncidentManualUpdate(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.IncidentManualUpdatePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid incident manual update payload", zap.Error(err))
+		return err
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	incident, err := h.repo.GetIncidentByIDForTeam(ctx, tx, payload.TeamID, payload.IncidentID)
+	if err != nil {
+		return err
+	}
+
+	if incident == nil {
+		return nil
+	}
+
+	monitorIDs, err := h.repo.ListMonitorIDsByIncidentID(ctx, tx, incident.ID)
+	if err != nil {
+		return err
+	}
+
+	monitors := make([]models.Monitor, 0, len(monitorIDs))
+	for _, monitorID := range monitorIDs {
+		monitor, err := h.repo.GetMonitorByID(ctx, tx, payload.TeamID, monitorID)
+		if err != nil {
+			return err
+		}
+		if monitor != nil {
+			monitors = append(monitors, *monitor)
+		}
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	status := models.PingStatusFailed
+	if payload.Status == models.IncidentStatusResolved {
+		status = models.PingStatusSuccessful
+	}
+
+	detail := manualUpdateDetail(payload)
+	now := time.Now().UTC()
+	for _, monitor := range monitors {
+		ping := models.Ping{Time: now, MonitorID: monitor.ID, Status: status}
+		h.enqueueNotificationTasks(monitor, *incident, models.NotificationEventTypeManualStatusUpdate, ping, 0, detail, true)
+	}
+
+	return nil
+}
+
+func manualUpdateDetail(payload tasks.IncidentManualUpdatePayload) string {
+	detail := fmt.Sprintf("Incident status: %s", payload.Status)
+	if message := strings.TrimSpace(payload.Message); message != "" && message != string(payload.Status) {
+		detail = fmt.Sprintf("%s\n%s", detail, message)
+	}
+	return detail
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// HandleIncidentReminder re-notifies linked channels while an incident stays open.
+func (h *Handler) HandleIncidentReminder(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.IncidentReminderPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid incident reminder payload", zap.Error(err))
+		return err
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	incident, err := h.repo.GetIncidentByIDForTeam(ctx, tx, payload.TeamID, payload.IncidentID)
+	if err != nil {
+		return err
+	}
+
+	if incident == nil || incident.Status == models.IncidentStatusResolved || incident.AcknowledgedAt != nil {
+		return nil
+	}
+
+	monitor, err := h.repo.GetMonitorByID(ctx, tx, payload.TeamID, payload.MonitorID)
+	if err != nil {
+		return err
+	}
+
+	// Reminder settings may have changed since the incident was opened.
+	if monitor == nil || monitor.ReminderInterval <= 0 || payload.Sequence > monitor.ReminderMaxCount {
+		return nil
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	// The timeline only claims a reminder went out when a channel was actually asked to deliver it.
+	message := reminderMessage(payload.Sequence, monitor.ReminderMaxCount, incident.StartedAt, payload.Detail)
+	if h.enqueueNotificationTasks(*monitor, *incident, models.NotificationEventTypeDown, payload.Ping, payload.RegionID, message, true) > 0 {
+		h.recordReminderSent(ctx, incident.ID, message)
+	}
+	h.scheduleIncidentReminder(*monitor, incident.ID, payload.Ping, payload.RegionID, payload.Detail, payload.Sequence+1)
+
+	return nil
+}
+
+// scheduleIncidentReminder enqueues the next reminder for an open incident when the monitor has reminders enabled.
+func (h *Handler) scheduleIncidentReminder(monitor models.Monitor, incidentID int64, ping models.Ping, regionID int64, detail string, sequence int) {
+	if h.notifier == nil || monitor.ReminderInterval <= 0 || sequence > monitor.ReminderMaxCount {
+		return
+	}
+
+	task, err := tasks.NewIncidentReminder(tasks.IncidentReminderPayload{
+		TeamID:     monitor.TeamID,
+		MonitorID:  monitor.ID,
+		IncidentID: incidentID,
+		RegionID:   regionID,
+		Sequence:   sequence,
+		Ping:       ping,
+		Detail:     detail,
+	}, time.Duration(monitor.ReminderInterval)*time.Second)
+	if err != nil {
+		zap.L().Error("failed to create incident reminder task",
+			zap.Int64("incident_id", incidentID),
+			zap.Error(err))
+		return
+	}
+
+	if _, err := h.notifier.Enqueue(task); err != nil {
+		if errors.Is(err, asynq.ErrTaskIDConflict) {
+			return
+		}
+		zap.L().Error("failed to enqueue incident reminder task",
+			zap.Int64("incident_id", incidentID),
+			zap.Int("sequence", sequence),
+			zap.Error(err))
+	}
+}
+
+// recordReminderSent adds the notification_sent timeline event for a dispatched reminder. Failures are only
+// logged, since retrying the task would send the reminder again.
+func (h *Handler) recordReminderSent(ctx context.Context, incidentID int64, message string) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("failed to start reminder timeline transaction",
+			zap.Int64("incident_id", incidentID),
+			zap.Error(err))
+		return
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	now := time.Now().UTC()
+	if err := h.repo.CreateEventTimeline(ctx, tx, models.EventTimeline{
+		IncidentID: incidentID,
+		Message:    message,
+		EventType:  models.IncidentEventTypeNotificationSent,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}); err != nil {
+		zap.L().Error("failed to record incident reminder",
+			zap.Int64("incident_id", incidentID),
+			zap.Error(err))
+		return
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("failed to commit reminder timeline transaction",
+			zap.Int64("incident_id", incidentID),
+			zap.Error(err))
+	}
+}
+
+func reminderMessage(sequence, maxCount int, startedAt time.Time, detail string) string {
+	msg := fmt.Sprintf("Reminder %d of %d: incident still open since %s", sequence, maxCount, startedAt.UTC().Format(time.RFC3339))
+	if detail = strings.TrimSpace(detail); detail != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, detail)
+	}
+	return msg
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/repository"
+	"github.com/yorukot/kymarium/worker/tasks"
+)
+
+var reminderStartedAt = time.Date(2026, 3, 4, 5, 0, 0, 0, time.UTC)
+
+func TestHandleIncidentReminder_SkipsTimelineWithoutDispatch(t *testing.T) {
+	// Nothing listens on port 1, so every enqueue fails.
+	unreachable := asynq.NewClient(asynq.RedisClientOpt{Addr: "127.0.0.1:1"})
+	t.Cleanup(func() { _ = unreachable.Close() })
+
+	tests := []struct {
+		name     string
+		notifier *asynq.Client
+	}{
+		{"no task queue", nil},
+		{"enqueue fails", unreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			incident := &models.Incident{ID: 20, Status: models.IncidentStatusDetected, StartedAt: reminderStartedAt}
+			monitor := &models.Monitor{ID: 10, TeamID: 1, ReminderInterval: 600, ReminderMaxCount: 3}
+			mockRepo := &repository.MockRepository{}
+			mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+			mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+			mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(20)).Return(incident, nil)
+			mockRepo.On("GetMonitorByID", mock.Anything, mock.Anything, int64(1), int64(10)).Return(monitor, nil)
+			mockRepo.On("ListNotificationsByMonitorID", mock.Anything, mock.Anything, int64(10)).
+				Return([]models.Notification{{ID: 7, TeamID: 1, Type: models.NotificationTypeDiscord}}, nil)
+			mockRepo.On("CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			body, err := json.Marshal(tasks.IncidentReminderPayload{TeamID: 1, MonitorID: 10, IncidentID: 20, Sequence: 1, Detail: "timeout"})
+			require.NoError(t, err)
+
+			h := &Handler{repo: mockRepo, notifier: tt.notifier}
+			require.NoError(t, h.HandleIncidentReminder(context.Background(), asynq.NewTask(tasks.TypeIncidentReminder, body)))
+			mockRepo.AssertNotCalled(t, "CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandleIncidentReminder_Stops(t *testing.T) {
+	acknowledgedAt := reminderStartedAt.Add(time.Minute)
+	monitor := &models.Monitor{ID: 10, TeamID: 1, ReminderInterval: 600, ReminderMaxCount: 3}
+
+	tests := []struct {
+		name     string
+		incident *models.Incident
+		monitor  *models.Monitor
+		sequence int
+	}{
+		{"acknowledged", &models.Incident{ID: 20, Status: models.IncidentStatusInvestigating, AcknowledgedAt: &acknowledgedAt}, monitor, 1},
+		{"resolved", &models.Incident{ID: 20, Status: models.IncidentStatusResolved}, monitor, 1},
+		{"incident deleted", nil, monitor, 1},
+		{"past the maximum count", &models.Incident{ID: 20}, monitor, 4},
+		{"reminders turned off", &models.Incident{ID: 20}, &models.Monitor{ID: 10, TeamID: 1, ReminderMaxCount: 3}, 1},
+		{"maximum count lowered", &models.Incident{ID: 20}, &models.Monitor{ID: 10, TeamID: 1, ReminderInterval: 600, ReminderMaxCount: 1}, 2},
+		{"monitor deleted", &models.Incident{ID: 20}, nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repository.MockRepository{}
+			mockRepo.On("StartTransaction", mock.Anything).Return(nil, nil)
+			mockRepo.On("DeferRollback", mock.Anything, mock.Anything)
+			mockRepo.On("CommitTransaction", mock.Anything, mock.Anything).Return(nil)
+			mockRepo.On("GetIncidentByIDForTeam", mock.Anything, mock.Anything, int64(1), int64(20)).Return(tt.incident, nil)
+			mockRepo.On("GetMonitorByID", mock.Anything, mock.Anything, int64(1), int64(10)).Return(tt.monitor, nil)
+			mockRepo.On("CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			body, err := json.Marshal(tasks.IncidentReminderPayload{TeamID: 1, MonitorID: 10, IncidentID: 20, Sequence: tt.sequence, Detail: "timeout"})
+			require.NoError(t, err)
+
+			h := &Handler{repo: mockRepo}
+			require.NoError(t, h.HandleIncidentReminder(context.Background(), asynq.NewTask(tasks.TypeIncidentReminder, body)))
+			mockRepo.AssertNotCalled(t, "CreateEventTimeline", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReminderMessage(t *testing.T) {
+	require.Equal(t, "Reminder 2 of 5: incident still open since 2026-03-04T05:00:00Z (timeout)", reminderMessage(2, 5, reminderStartedAt, " timeout "))
+	require.Equal(t, "Reminder 1 of 1: incident still open since 2026-03-04T05:00:00Z", reminderMessage(1, 1, reminderStartedAt, ""))
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// HandleMaintenanceUpdate fans a maintenance timeline entry out to the subscribers of its status page.
+func (h *Handler) HandleMaintenanceUpdate(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.MaintenanceUpdatePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid maintenance update payload", zap.Error(err))
+		return err
+	}
+
+	deliveries, err := h.maintenanceDeliveries(ctx, payload)
+	if err != nil {
+		zap.L().Error("failed to load maintenance subscribers",
+			zap.Int64("maintenance_id", payload.MaintenanceID),
+			zap.Int64("update_id", payload.UpdateID),
+			zap.Error(err))
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		task, err := tasks.NewMaintenanceSubscriber(delivery)
+		if err != nil {
+			return err
+		}
+
+		if _, err := h.notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			zap.L().Error("failed to enqueue maintenance subscriber task",
+				zap.Int64("subscriber_id", delivery.SubscriberID),
+				zap.Int64("update_id", delivery.UpdateID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	zap.L().Info("maintenance update fanned out",
+		zap.Int64("maintenance_id", payload.MaintenanceID),
+		zap.Int64("update_id", payload.UpdateID),
+		zap.Int("subscribers", len(deliveries)))
+
+	return nil
+}
+
+// maintenanceDeliveries returns one delivery per confirmed subscriber of the page that follows the maintenance.
+func (h *Handler) maintenanceDeliveries(ctx context.Context, payload tasks.MaintenanceUpdatePayload) ([]tasks.MaintenanceSubscriberPayload, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	maintenance, err := h.repo.GetMaintenanceByID(ctx, tx, payload.StatusPageID, payload.MaintenanceID)
+	if err != nil || maintenance == nil {
+		return nil, err
+	}
+
+	subscribers, err := h.repo.ListConfirmedStatusPageSubscribers(ctx, tx, payload.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []tasks.MaintenanceSubscriberPayload
+	for _, subscriber := range subscribers {
+		if !statuspagecore.MaintenanceAffects(subscriber, *maintenance) {
+			continue
+		}
+		deliveries = append(deliveries, tasks.MaintenanceSubscriberPayload{
+			TeamID:        payload.TeamID,
+			StatusPageID:  payload.StatusPageID,
+			SubscriberID:  subscriber.ID,
+			MaintenanceID: payload.MaintenanceID,
+			UpdateID:      payload.UpdateID,
+		})
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// HandleMaintenanceSubscriber delivers a maintenance timeline entry to a single subscriber by email or webhook.
+func (h *Handler) HandleMaintenanceSubscriber(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.MaintenanceSubscriberPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid maintenance subscriber payload", zap.Error(err))
+		return err
+	}
+
+	subscriber, update, err := h.loadMaintenanceSubscriberUpdate(ctx, payload)
+	if err != nil {
+		zap.L().Error("failed to load maintenance subscriber update",
+			zap.Int64("subscriber_id", payload.SubscriberID),
+			zap.Int64("update_id", payload.UpdateID),
+			zap.Error(err))
+		return err
+	}
+
+	// The subscriber left, or the maintenance was deleted, since the fan-out.
+	if subscriber == nil || update == nil {
+		return nil
+	}
+
+	switch subscriber.Type {
+	case models.StatusPageSubscriberTypeEmail:
+		email, buildErr := statuspagecore.BuildMaintenanceEmail("", subscriber.Target, *update)
+		if buildErr != nil {
+			return buildErr
+		}
+		err = notificationcore.SendMail(ctx, email)
+	case models.StatusPageSubscriberTypeWebhook:
+		err = statuspagecore.SendMaintenanceWebhook(ctx, subscriberWebhookClient, subscriber.Target, *update)
+	default:
+		err = fmt.Errorf("unsupported subscriber type %q", subscriber.Type)
+	}
+
+	if err != nil {
+		zap.L().Warn("failed to deliver maintenance update",
+			zap.Int64("subscriber_id", subscriber.ID),
+			zap.String("subscriber_type", string(subscriber.Type)),
+			zap.Int64("update_id", payload.UpdateID),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) loadMaintenanceSubscriberUpdate(ctx context.Context, payload tasks.MaintenanceSubscriberPayload) (*models.StatusPageSubscriber, *statuspagecore.MaintenanceUpdate, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	page, err := h.repo.GetStatusPageByID(ctx, tx, payload.TeamID, payload.StatusPageID)
+	if err != nil || page == nil {
+		return nil, nil, err
+	}
+
+	subscriber, err := h.repo.GetStatusPageSubscriberByID(ctx, tx, page.ID, payload.SubscriberID)
+	if err != nil || subscriber == nil || subscriber.ConfirmedAt == nil {
+		return nil, nil, err
+	}
+
+	maintenance, err := h.repo.GetMaintenanceByID(ctx, tx, page.ID, payload.MaintenanceID)
+	if err != nil || maintenance == nil {
+		return nil, nil, err
+	}
+
+	entry, err := h.repo.GetMaintenanceUpdateByID(ctx, tx, maintenance.ID, payload.UpdateID)
+	if err != nil || entry == nil {
+		return nil, nil, err
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	return subscriber, &statuspagecore.MaintenanceUpdate{
+		PageTitle:      page.Title,
+		PageSlug:       page.Slug,
+		MaintenanceID:  maintenance.ID,
+		Title:          maintenance.Title,
+		Status:         entry.Status,
+		StartsAt:       maintenance.StartsAt,
+		EndsAt:         maintenance.EndsAt,
+		Message:        entry.Message,
+		CreatedAt:      entry.CreatedAt,
+		UnsubscribeURL: statuspagecore.SubscriptionURL(config.Env().BackendURL, page.Slug, "unsubscribe", subscriber.Token),
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	monitorcore "github.com/yorukot/kymarium/core/monitor"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
 	"github.com/yorukot/kymarium/models"
 	"github.com/yorukot/kymarium/utils/config"
 	"github.com/yorukot/kymarium/utils/id"
@@ -28,7 +29,7 @@ func (h *Handler) HandleStartServiceTask(ctx context.Context, t *asynq.Task) err
 		return err
 	}
 
-	ping, detail, err := h.pingMonitor(ctx, payload.Monitor, payload.RegionID)
+	ping, detail, certExpiresAt, err := h.pingMonitor(ctx, payload.Monitor, payload.RegionID)
 	if err != nil {
 		zap.L().Warn("monitor ping encountered error",
 			zap.Int64("monitor_id", payload.Monitor.ID),
@@ -39,12 +40,14 @@ func (h *Handler) HandleStartServiceTask(ctx context.Context, t *asynq.Task) err
 	h.pingBuffer.Record(ctx, ping)
 
 	h.processIncident(ctx, payload.Monitor, ping, payload.RegionID, detail)
+	h.checkCertExpiry(ctx, payload.Monitor, ping, payload.RegionID, certExpiresAt)
 
 	// Errors are logged and captured in ping history; returning nil prevents repeated retries.
 	return nil
 }
 
-func (h *Handler) pingMonitor(ctx context.Context, monitor models.Monitor, regionID int64) (models.Ping, string, error) {
+// pingMonitor runs the monitor once and also returns when its TLS certificate expires, if it has one.
+func (h *Handler) pingMonitor(ctx context.Context, monitor models.Monitor, regionID int64) (models.Ping, string, time.Time, error) {
 	result, err := monitorcore.Run(ctx, monitor)
 
 	message := ""
@@ -62,56 +65,80 @@ func (h *Handler) pingMonitor(ctx context.Context, monitor models.Monitor, regio
 		Latency:   0,
 	}
 
+	var certExpiresAt time.Time
 	if result != nil {
 		ping.Status = result.Status
 		ping.Latency = int(clampLatencyMs(result.Duration))
+		certExpiresAt = result.CertExpiresAt
 	}
 
-	return ping, message, err
+	return ping, message, certExpiresAt, err
 }
 
-func (h *Handler) enqueueNotificationTasks(monitor models.Monitor, ping models.Ping, regionID int64, detail string) {
+// enqueueNotificationTasks dispatches the event to every linked channel whose routing rules accept it.
+// followUp marks repeat messages about an already announced incident, such as reminders.
+// It returns how many dispatch tasks were enqueued.
+func (h *Handler) enqueueNotificationTasks(monitor models.Monitor, incident models.Incident, eventType models.NotificationEventType, ping models.Ping, regionID int64, detail string, followUp bool) int {
 	if h.notifier == nil {
-		return
+		return 0
 	}
 
-	// Fetch notification IDs from junction table
+	// Fetch linked notification channels together with their routing rules
 	ctx := context.Background()
 	tx, err := h.repo.StartTransaction(ctx)
 	if err != nil {
 		zap.L().Error("failed to start transaction for notification fetch",
 			zap.Int64("monitor_id", monitor.ID),
 			zap.Error(err))
-		return
+		return 0
 	}
 	defer h.repo.DeferRollback(ctx, tx)
 
-	notificationIDs, err := h.repo.GetNotificationIDsByMonitorID(ctx, tx, monitor.ID)
+	notifications, err := h.repo.ListNotificationsByMonitorID(ctx, tx, monitor.ID)
 	if err != nil {
-		zap.L().Error("failed to fetch notification IDs",
+		zap.L().Error("failed to fetch notifications",
 			zap.Int64("monitor_id", monitor.ID),
 			zap.Error(err))
-		return
+		return 0
 	}
 
 	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
 		zap.L().Error("failed to commit transaction",
 			zap.Int64("monitor_id", monitor.ID),
 			zap.Error(err))
-		return
+		return 0
 	}
 
-	if len(notificationIDs) == 0 {
-		return
+	if len(notifications) == 0 {
+		return 0
+	}
+
+	event := notificationcore.RoutingEvent{
+		Type:     eventType,
+		Severity: incident.Severity,
+		RegionID: regionID,
+		Tags:     monitor.Tags,
 	}
 
-	for _, notificationID := range notificationIDs {
+	enqueued := 0
+	for _, notification := range notifications {
+		if !notificationcore.ShouldDeliver(notification.RoutingRules, event) {
+			zap.L().Debug("notification skipped by routing rules",
+				zap.Int64("monitor_id", monitor.ID),
+				zap.Int64("notification_id", notification.ID),
+				zap.String("event_type", string(eventType)))
+			continue
+		}
 
 		payload := tasks.NotificationPayload{
 			TeamID:         monitor.TeamID,
 			MonitorID:      monitor.ID,
-			NotificationID: notificationID,
+			NotificationID: notification.ID,
+			IncidentID:     incident.ID,
 			RegionID:       regionID,
+			EventType:      eventType,
+			Severity:       incident.Severity,
+			FollowUp:       followUp,
 			Ping:           ping,
 			Detail:         detail,
 		}
@@ -120,7 +147,7 @@ func (h *Handler) enqueueNotificationTasks(monitor models.Monitor, ping models.P
 		if err != nil {
 			zap.L().Error("failed to create notification task",
 				zap.Int64("monitor_id", monitor.ID),
-				zap.Int64("notification_id", notificationID),
+				zap.Int64("notification_id", notification.ID),
 				zap.Error(err))
 			continue
 		}
@@ -128,10 +155,14 @@ func (h *Handler) enqueueNotificationTasks(monitor models.Monitor, ping models.P
 		if _, err := h.notifier.Enqueue(task); err != nil {
 			zap.L().Error("failed to enqueue notification task",
 				zap.Int64("monitor_id", monitor.ID),
-				zap.Int64("notification_id", notificationID),
+				zap.Int64("notification_id", notification.ID),
 				zap.Error(err))
+			continue
 		}
+		enqueued++
 	}
+
+	return enqueued
 }
 
 func clampLatencyMs(duration time.Duration) int64 {
@@ -169,8 +200,9 @@ func (h *Handler) processIncident(ctx context.Context, monitor models.Monitor, p
 		return
 	}
 
-	var notify bool
+	var notifyIncident *models.Incident
 	var notifyDetail string
+	eventType := models.NotificationEventTypeDown
 
 	// Update monitor status based on latest ping before incident logic.
 	targetStatus := monitor.Status
@@ -193,9 +225,10 @@ func (h *Handler) processIncident(ctx context.Context, monitor models.Monitor, p
 	}
 
 	if ping.Status == models.PingStatusSuccessful {
-		notify, notifyDetail, err = h.handleIncidentRecovery(ctx, tx, monitor, ping, regionID, detail, openIncident)
+		eventType = models.NotificationEventTypeRecovered
+		notifyIncident, notifyDetail, err = h.handleIncidentRecovery(ctx, tx, monitor, ping, regionID, detail, openIncident)
 	} else {
-		notify, notifyDetail, err = h.handleIncidentFailure(ctx, tx, monitor, ping, regionID, detail, openIncident)
+		notifyIncident, notifyDetail, err = h.handleIncidentFailure(ctx, tx, monitor, ping, regionID, detail, openIncident)
 	}
 
 	if err != nil {
@@ -216,22 +249,27 @@ func (h *Handler) processIncident(ctx context.Context, monitor models.Monitor, p
 		return
 	}
 
-	if notify {
-		h.enqueueNotificationTasks(monitor, ping, regionID, notifyDetail)
+	if notifyIncident != nil {
+		h.enqueueNotificationTasks(monitor, *notifyIncident, eventType, ping, regionID, notifyDetail, false)
+		if eventType == models.NotificationEventTypeDown {
+			h.scheduleIncidentReminder(monitor, notifyIncident.ID, ping, regionID, notifyDetail, 1)
+			h.startEscalation(monitor, notifyIncident.ID, ping, regionID, notifyDetail)
+		}
 	}
 }
 
-func (h *Handler) handleIncidentFailure(ctx context.Context, tx pgx.Tx, monitor models.Monitor, ping models.Ping, regionID int64, detail string, openIncident *models.Incident) (bool, string, error) {
+// handleIncidentFailure returns the incident to notify about when a new one is opened.
+func (h *Handler) handleIncidentFailure(ctx context.Context, tx pgx.Tx, monitor models.Monitor, ping models.Ping, regionID int64, detail string, openIncident *models.Incident) (*models.Incident, string, error) {
 	// Maintain only one active incident per monitor; use the region-specific window for detection.
 	failureThreshold := int(monitor.FailureThreshold)
 	if failureThreshold <= 0 {
-		return false, "", nil
+		return nil, "", nil
 	}
 
 	window := int(math.Ceil(float64(failureThreshold) * 1.5))
 	recent, err := h.repo.ListRecentPingsByMonitorIDAndRegion(ctx, tx, monitor.ID, regionID, window-1)
 	if err != nil {
-		return false, "", err
+		return nil, "", err
 	}
 
 	samples := append([]models.Ping{ping}, recent...)
@@ -240,14 +278,22 @@ func (h *Handler) handleIncidentFailure(ctx context.Context, tx pgx.Tx, monitor
 	now := time.Now().UTC()
 	message := incidentMessage(strconv.FormatInt(regionID, 10), detail, ping, string(ping.Status))
 
-	// Create a new incident when the failure threshold is met.
+	// Create a new incident when the failure threshold is met, unless the monitor is in a maintenance window.
 	if failureCount >= failureThreshold && len(samples) >= failureThreshold && openIncident == nil {
+		underMaintenance, err := h.repo.IsMonitorUnderMaintenance(ctx, tx, monitor.ID, ping.Time)
+		if err != nil {
+			return nil, "", err
+		}
+		if underMaintenance {
+			return nil, "", nil
+		}
+
 		createdIncident, created, err := h.createIncidentIfAbsent(ctx, tx, monitor.ID, ping.Time, message, now)
 		if err != nil {
-			return false, "", err
+			return nil, "", err
 		}
 		if created {
-			return true, message, nil
+			return createdIncident, message, nil
 		}
 		// If not created, fall through to update handling below.
 		openIncident = createdIncident
@@ -257,7 +303,7 @@ func (h *Handler) handleIncidentFailure(ctx context.Context, tx pgx.Tx, monitor
 	if openIncident != nil {
 		lastEvent, err := h.repo.GetLastEventTimeline(ctx, tx, openIncident.ID)
 		if err != nil {
-			return false, "", err
+			return nil, "", err
 		}
 
 		if lastEvent == nil || strings.TrimSpace(lastEvent.Message) != message {
@@ -268,37 +314,38 @@ func (h *Handler) handleIncidentFailure(ctx context.Context, tx pgx.Tx, monitor
 				CreatedAt:  now,
 				UpdatedAt:  now,
 			}); err != nil {
-				return false, "", err
+				return nil, "", err
 			}
 		}
 	}
 
-	return false, "", nil
+	return nil, "", nil
 }
 
-func (h *Handler) handleIncidentRecovery(ctx context.Context, tx pgx.Tx, monitor models.Monitor, ping models.Ping, regionID int64, detail string, openIncident *models.Incident) (bool, string, error) {
+// handleIncidentRecovery returns the incident to notify about when it is auto-resolved.
+func (h *Handler) handleIncidentRecovery(ctx context.Context, tx pgx.Tx, monitor models.Monitor, ping models.Ping, regionID int64, detail string, openIncident *models.Incident) (*models.Incident, string, error) {
 	// Nothing to do if no incident is open.
 	if openIncident == nil {
-		return false, "", nil
+		return nil, "", nil
 	}
 	if openIncident.AutoResolve == false {
-		return false, "", nil
+		return nil, "", nil
 	}
 
 	recoveryThreshold := int(monitor.RecoveryThreshold)
 	if recoveryThreshold <= 0 {
-		return false, "", nil
+		return nil, "", nil
 	}
 
 	// Pull only enough recent pings (region-specific) to evaluate recovery, include current ping first.
 	recent, err := h.repo.ListRecentPingsByMonitorIDAndRegion(ctx, tx, monitor.ID, regionID, recoveryThreshold-1)
 	if err != nil {
-		return false, "", err
+		return nil, "", err
 	}
 
 	samples := append([]models.Ping{ping}, recent...)
 	if len(samples) < recoveryThreshold {
-		return false, "", nil
+		return nil, "", nil
 	}
 
 	allSuccessful := true
@@ -310,14 +357,14 @@ func (h *Handler) handleIncidentRecovery(ctx context.Context, tx pgx.Tx, monitor
 	}
 
 	if !allSuccessful {
-		return false, "", nil
+		return nil, "", nil
 	}
 
 	now := time.Now().UTC()
 	message := incidentMessage(strconv.FormatInt(regionID, 10), detail, ping, "recovered")
 
 	if err := h.repo.MarkIncidentResolved(ctx, tx, openIncident.ID, ping.Time, now); err != nil {
-		return false, "", err
+		return nil, "", err
 	}
 
 	if err := h.repo.CreateEventTimeline(ctx, tx, models.EventTimeline{
@@ -327,10 +374,10 @@ func (h *Handler) handleIncidentRecovery(ctx context.Context, tx pgx.Tx, monitor
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}); err != nil {
-		return false, "", err
+		return nil, "", err
 	}
 
-	return true, message, nil
+	return openIncident, message, nil
 }
 
 func countFailures(pings []models.Ping, window int) int {
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hibiken/asynq"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// maxDeliveryResponseLength caps the provider response stored with each delivery attempt.
+const maxDeliveryResponseLength = 1024
+
+// recordDelivery persists the outcome of a dispatch attempt. Failures on the final retry are dead-lettered.
+func (h *Handler) recordDelivery(ctx context.Context, t *asynq.Task, payload tasks.NotificationPayload, result notificationcore.Result, duration time.Duration, sendErr error) {
+	delivery, err := newDelivery(ctx, t, payload.NotificationID, result, duration, sendErr)
+	if err != nil {
+		zap.L().Error("failed to generate notification delivery id", zap.Error(err))
+		return
+	}
+
+	monitorID := payload.MonitorID
+	delivery.MonitorID = &monitorID
+
+	if payload.IncidentID != 0 {
+		incidentID := payload.IncidentID
+		delivery.IncidentID = &incidentID
+	}
+
+	h.saveDelivery(ctx, delivery)
+}
+
+// recordDigestDelivery persists the outcome of a digest attempt, which is not tied to a single monitor.
+func (h *Handler) recordDigestDelivery(ctx context.Context, t *asynq.Task, notificationID int64, result notificationcore.Result, duration time.Duration, sendErr error) {
+	delivery, err := newDelivery(ctx, t, notificationID, result, duration, sendErr)
+	if err != nil {
+		zap.L().Error("failed to generate notification delivery id", zap.Error(err))
+		return
+	}
+
+	h.saveDelivery(ctx, delivery)
+}
+
+func newDelivery(ctx context.Context, t *asynq.Task, notificationID int64, result notificationcore.Result, duration time.Duration, sendErr error) (models.NotificationDelivery, error) {
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	if !ok {
+		maxRetry = tasks.NotificationMaxRetry
+	}
+	taskID, _ := asynq.GetTaskID(ctx)
+
+	deliveryID, err := id.GetID()
+	if err != nil {
+		return models.NotificationDelivery{}, err
+	}
+
+	delivery := models.NotificationDelivery{
+		ID:             deliveryID,
+		NotificationID: notificationID,
+		TaskID:         taskID,
+		Attempt:        retried + 1,
+		MaxAttempts:    maxRetry + 1,
+		Status:         models.NotificationDeliveryStatusSucceeded,
+		DurationMs:     int(duration.Milliseconds()),
+		Payload:        t.Payload(),
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	if result.StatusCode != 0 {
+		statusCode := result.StatusCode
+		delivery.HTTPStatus = &statusCode
+	}
+
+	if result.Body != "" {
+		body := truncateString(result.Body, maxDeliveryResponseLength)
+		delivery.Response = &body
+	}
+
+	if sendErr != nil {
+		message := truncateString(sendErr.Error(), maxDeliveryResponseLength)
+		delivery.Error = &message
+		delivery.Status = models.NotificationDeliveryStatusFailed
+		if retried >= maxRetry {
+			delivery.Status = models.NotificationDeliveryStatusDeadLetter
+		}
+	}
+
+	return delivery, nil
+}
+
+func (h *Handler) saveDelivery(ctx context.Context, delivery models.NotificationDelivery) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("failed to start notification delivery transaction", zap.Error(err))
+		return
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	if err := h.repo.CreateNotificationDelivery(ctx, tx, delivery); err != nil {
+		zap.L().Error("failed to record notification delivery",
+			zap.Int64("notification_id", delivery.NotificationID),
+			zap.Error(err))
+		return
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("failed to commit notification delivery", zap.Error(err))
+	}
+}
+
+func truncateString(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return value[:cut]
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hibiken/asynq"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+const (
+	// rateLimitWindow is the window a channel's rate limit is measured over.
+	rateLimitWindow = time.Minute
+	// notificationEventRetention is how long counted events are kept once they are no longer pending.
+	notificationEventRetention = time.Hour
+)
+
+// HandleNotificationDigest sends the events held for a channel as a single message. During quiet hours the
+// digest is postponed to the end of the window unless a held event is severe enough to bypass it.
+func (h *Handler) HandleNotificationDigest(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.NotificationDigestPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid notification digest payload", zap.Error(err))
+		return err
+	}
+
+	notification, events, err := h.loadPendingDigest(ctx, payload)
+	if err != nil {
+		zap.L().Error("failed to load notification digest",
+			zap.Int64("notification_id", payload.NotificationID),
+			zap.Error(err))
+		return err
+	}
+
+	if notification == nil {
+		zap.L().Warn("notification not found for digest",
+			zap.Int64("notification_id", payload.NotificationID))
+		return nil
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	urgent := slices.ContainsFunc(events, func(event models.NotificationEvent) bool {
+		return notificationcore.BypassesQuietHours(event.Severity)
+	})
+	if quietUntil, quiet := quietHoursEnd(*notification, time.Now()); quiet && !urgent {
+		return h.enqueueDigest(*notification, quietUntil, true)
+	}
+
+	title, description, status := notificationcore.FormatDigest(events, payload.QuietHours)
+	startedAt := time.Now()
+	result, err := h.deliverNotification(ctx, *notification, notificationcore.Message{
+		Title:       title,
+		Description: description,
+		Status:      status,
+		TeamID:      notification.TeamID,
+	})
+	h.recordDigestDelivery(ctx, t, notification.ID, result, time.Since(startedAt), err)
+	if err != nil {
+		zap.L().Error("failed to send notification digest",
+			zap.Int64("notification_id", notification.ID),
+			zap.String("notification_type", string(notification.Type)),
+			zap.Int("events", len(events)),
+			zap.Error(err))
+		return err
+	}
+
+	if err := h.completeDigest(ctx, *notification, events); err != nil {
+		zap.L().Error("failed to complete notification digest",
+			zap.Int64("notification_id", notification.ID),
+			zap.Error(err))
+		return err
+	}
+
+	zap.L().Info("notification digest dispatched",
+		zap.Int64("notification_id", notification.ID),
+		zap.String("notification_type", string(notification.Type)),
+		zap.Int("events", len(events)))
+
+	return nil
+}
+
+// holdForDigest counts a dispatch against the channel's rate limit and reports whether the event was held
+// for a digest instead of being sent now. Events are held while the channel has seen at least its rate limit
+// in the last minute, or when quiet hours are active and the event is not severe enough to bypass them.
+// Retries are never held.
+func (h *Handler) holdForDigest(ctx context.Context, notification models.Notification, monitor models.Monitor, payload tasks.NotificationPayload) (bool, error) {
+	if h.notifier == nil {
+		return false, nil
+	}
+
+	if retried, _ := asynq.GetRetryCount(ctx); retried > 0 {
+		return false, nil
+	}
+
+	now := time.Now().UTC()
+	quietUntil, quiet := quietHoursEnd(notification, now)
+	quiet = quiet && !notificationcore.BypassesQuietHours(payload.Severity)
+	if notification.RateLimit <= 0 && !quiet {
+		return false, nil
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	if err := h.repo.LockNotification(ctx, tx, notification.ID); err != nil {
+		return false, err
+	}
+
+	if err := h.repo.DeleteNotificationEventsBefore(ctx, tx, notification.ID, now.Add(-notificationEventRetention)); err != nil {
+		return false, err
+	}
+
+	recent, err := h.repo.CountNotificationEventsSince(ctx, tx, notification.ID, now.Add(-rateLimitWindow))
+	if err != nil {
+		return false, err
+	}
+
+	eventID, err := id.GetID()
+	if err != nil {
+		return false, err
+	}
+
+	limited := notification.RateLimit > 0 && recent >= notification.RateLimit
+	event := models.NotificationEvent{
+		ID:             eventID,
+		NotificationID: notification.ID,
+		MonitorID:      monitor.ID,
+		MonitorName:    monitor.Name,
+		EventType:      digestEventType(payload),
+		Severity:       payload.Severity,
+		Status:         payload.Ping.Status,
+		Held:           quiet || limited,
+		CreatedAt:      now,
+	}
+
+	if err := h.repo.CreateNotificationEvent(ctx, tx, event); err != nil {
+		return false, err
+	}
+
+	// Events held for the same slot share a task ID, so only the first one actually schedules the digest.
+	if quiet {
+		if err := h.enqueueDigest(notification, quietUntil, true); err != nil {
+			return false, err
+		}
+	} else if limited {
+		if err := h.enqueueDigest(notification, nextDigestSlot(notification, now), false); err != nil {
+			return false, err
+		}
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return false, err
+	}
+
+	return event.Held, nil
+}
+
+func (h *Handler) loadPendingDigest(ctx context.Context, payload tasks.NotificationDigestPayload) (*models.Notification, []models.NotificationEvent, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	notification, err := h.repo.GetNotificationByID(ctx, tx, payload.TeamID, payload.NotificationID)
+	if err != nil || notification == nil {
+		return notification, nil, err
+	}
+
+	events, err := h.repo.ListPendingNotificationEvents(ctx, tx, notification.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	return notification, events, nil
+}
+
+// completeDigest marks the sent events as flushed. Events held meanwhile already scheduled their own digest.
+func (h *Handler) completeDigest(ctx context.Context, notification models.Notification, events []models.NotificationEvent) error {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	eventIDs := make([]int64, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	if err := h.repo.MarkNotificationEventsFlushed(ctx, tx, notification.ID, eventIDs, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return h.repo.CommitTransaction(ctx, tx)
+}
+
+func (h *Handler) enqueueDigest(notification models.Notification, sendAt time.Time, quietHours bool) error {
+	task, err := tasks.NewNotificationDigest(tasks.NotificationDigestPayload{
+		TeamID:         notification.TeamID,
+		NotificationID: notification.ID,
+		QuietHours:     quietHours,
+	}, sendAt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := h.notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	return nil
+}
+
+// nextDigestSlot aligns storm digests to the channel's digest interval so they go out at a fixed cadence.
+func nextDigestSlot(notification models.Notification, now time.Time) time.Time {
+	interval := time.Duration(notification.DigestInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Duration(models.DefaultNotificationDigestInterval) * time.Second
+	}
+	return now.Truncate(interval).Add(interval)
+}
+
+// quietHoursEnd reports whether the channel is in quiet hours at the given time and when they end.
+// Invalid quiet hours are logged and ignored so events are never silently held forever.
+func quietHoursEnd(notification models.Notification, at time.Time) (time.Time, bool) {
+	until, quiet, err := notificationcore.QuietUntil(notification.QuietHours, at)
+	if err != nil {
+		zap.L().Warn("invalid notification quiet hours",
+			zap.Int64("notification_id", notification.ID),
+			zap.Error(err))
+		return time.Time{}, false
+	}
+	return until, quiet
+}
+
+// digestEventType falls back to the ping status for payloads enqueued before event types existed.
+func digestEventType(payload tasks.NotificationPayload) models.NotificationEventType {
+	if payload.EventType != "" {
+		return payload.EventType
+	}
+
+	if payload.Ping.Status == models.PingStatusSuccessful {
+		return models.NotificationEventTypeRecovered
+	}
+	return models.NotificationEventTypeDown
+}
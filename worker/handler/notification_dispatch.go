@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/hibiken/asynq"
 	notificationcore "github.com/yorukot/kymarium/core/notification"
@@ -44,17 +45,57 @@ func (h *Handler) HandleNotificationDispatch(ctx context.Context, t *asynq.Task)
 		return nil
 	}
 
+	stored := h.storedMessage(ctx, *notification, payload)
+	if stored != nil && stored.MutedAt != nil && payload.FollowUp {
+		zap.L().Info("notification muted for incident",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Int64("notification_id", payload.NotificationID))
+		return nil
+	}
+
+	held, err := h.holdForDigest(ctx, *notification, *monitor, payload)
+	if err != nil {
+		zap.L().Error("failed to apply notification rate limit",
+			zap.Int64("monitor_id", payload.MonitorID),
+			zap.Int64("notification_id", payload.NotificationID),
+			zap.Error(err))
+		return err
+	}
+
+	if held {
+		zap.L().Info("notification held for digest",
+			zap.Int64("monitor_id", payload.MonitorID),
+			zap.Int64("notification_id", payload.NotificationID))
+		return nil
+	}
+
 	detail := strings.TrimSpace(payload.Detail)
 	region := config.RegionByID(payload.RegionID)
-	title, description := notificationcore.FormatMessage(notificationcore.MessageInput{
+	input := notificationcore.MessageInput{
 		MonitorName: monitor.Name,
 		Status:      payload.Ping.Status,
 		RegionName:  region.Name,
 		LatencyMs:   payload.Ping.Latency,
 		CheckedAt:   payload.Ping.Time,
 		Detail:      detail,
-	})
-	if err := notificationcore.Send(ctx, *notification, title, description, payload.Ping.Status); err != nil {
+		AckURL:      ackURL(payload),
+	}
+	title, description := notificationcore.FormatMessage(input)
+	switch payload.EventType {
+	case models.NotificationEventTypeManualStatusUpdate:
+		title, description = notificationcore.FormatIncidentUpdate(input)
+	case models.NotificationEventTypeCertExpiring:
+		title, description = notificationcore.FormatCertExpiry(input)
+	}
+	msg := dispatchMessage(*monitor, payload, title, description)
+	if stored != nil && payload.EventType == models.NotificationEventTypeRecovered {
+		msg.ReplaceMessageID = stored.MessageID
+	}
+
+	startedAt := time.Now()
+	result, err := h.deliverNotification(ctx, *notification, msg)
+	h.recordDelivery(ctx, t, payload, result, time.Since(startedAt), err)
+	if err != nil {
 		zap.L().Error("failed to send notification",
 			zap.Int64("monitor_id", payload.MonitorID),
 			zap.Int64("notification_id", payload.NotificationID),
@@ -63,6 +104,8 @@ func (h *Handler) HandleNotificationDispatch(ctx context.Context, t *asynq.Task)
 		return err
 	}
 
+	h.rememberMessage(ctx, payload, result)
+
 	zap.L().Info("notification dispatched",
 		zap.Int64("monitor_id", payload.MonitorID),
 		zap.Int64("notification_id", payload.NotificationID),
@@ -97,3 +140,37 @@ func (h *Handler) fetchMonitorAndNotification(ctx context.Context, payload tasks
 
 	return monitor, notification, nil
 }
+
+// dispatchMessage attaches the monitor and incident context of a dispatch to a formatted message.
+func dispatchMessage(monitor models.Monitor, payload tasks.NotificationPayload, title, description string) notificationcore.Message {
+	return notificationcore.Message{
+		Title:       title,
+		Description: description,
+		Status:      payload.Ping.Status,
+		TeamID:      payload.TeamID,
+		MonitorID:   monitor.ID,
+		MonitorName: monitor.Name,
+		IncidentID:  payload.IncidentID,
+		EventType:   payload.EventType,
+		FollowUp:    payload.FollowUp,
+		MonitorURL:  notificationcore.MonitorURL(payload.TeamID, monitor.ID),
+		IncidentURL: notificationcore.IncidentURL(payload.TeamID, payload.IncidentID),
+	}
+}
+
+// ackURL returns a one-click acknowledgement link for down events tied to an incident.
+func ackURL(payload tasks.NotificationPayload) string {
+	if payload.IncidentID == 0 || payload.EventType != models.NotificationEventTypeDown {
+		return ""
+	}
+
+	link, err := notificationcore.IncidentActionURL(payload.TeamID, payload.IncidentID, models.IncidentActionAcknowledge)
+	if err != nil {
+		zap.L().Warn("failed to build incident acknowledgement link",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Error(err))
+		return ""
+	}
+
+	return link
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/id"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// storedMessage returns the provider message posted when the incident opened, for channels whose messages can be edited.
+// Recoveries edit it instead of posting anew and follow-ups check whether the incident was muted.
+func (h *Handler) storedMessage(ctx context.Context, notification models.Notification, payload tasks.NotificationPayload) *models.NotificationMessage {
+	if !editableNotificationType(notification.Type) || payload.IncidentID == 0 {
+		return nil
+	}
+
+	if !payload.FollowUp && payload.EventType != models.NotificationEventTypeRecovered {
+		return nil
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Warn("failed to begin notification message lookup", zap.Error(err))
+		return nil
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	message, err := h.repo.GetNotificationMessage(ctx, tx, notification.ID, payload.IncidentID)
+	if err != nil {
+		zap.L().Warn("failed to load notification message",
+			zap.Int64("notification_id", notification.ID),
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Error(err))
+		return nil
+	}
+
+	return message
+}
+
+func editableNotificationType(notificationType models.NotificationType) bool {
+	return notificationType == models.NotificationTypeSlack || notificationType == models.NotificationTypeTelegram
+}
+
+// rememberMessage stores the provider message of an incident's opening notification for later edits.
+func (h *Handler) rememberMessage(ctx context.Context, payload tasks.NotificationPayload, result notificationcore.Result) {
+	if result.MessageID == "" || payload.IncidentID == 0 || payload.FollowUp || payload.EventType != models.NotificationEventTypeDown {
+		return
+	}
+
+	messageID, err := id.GetID()
+	if err != nil {
+		zap.L().Error("failed to generate notification message id", zap.Error(err))
+		return
+	}
+
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		zap.L().Error("failed to begin notification message transaction", zap.Error(err))
+		return
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	if err := h.repo.UpsertNotificationMessage(ctx, tx, models.NotificationMessage{
+		ID:             messageID,
+		NotificationID: payload.NotificationID,
+		IncidentID:     payload.IncidentID,
+		MessageID:      result.MessageID,
+		CreatedAt:      time.Now().UTC(),
+	}); err != nil {
+		zap.L().Error("failed to store notification message",
+			zap.Int64("notification_id", payload.NotificationID),
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Error(err))
+		return
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		zap.L().Error("failed to commit notification message", zap.Error(err))
+	}
+}
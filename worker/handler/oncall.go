@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	"github.com/yorukot/kymarium/core/oncall"
+	"github.com/yorukot/kymarium/models"
+	"go.uber.org/zap"
+)
+
+// deliverNotification sends through the channel; on-call channels page whoever is currently on call.
+func (h *Handler) deliverNotification(ctx context.Context, notification models.Notification, msg notificationcore.Message) (notificationcore.Result, error) {
+	if notification.Type != models.NotificationTypeOnCall {
+		return notificationcore.DeliverMessage(ctx, http.DefaultClient, notification, msg)
+	}
+
+	var cfg models.OnCallNotificationConfig
+	if err := json.Unmarshal(notification.Config, &cfg); err != nil {
+		return notificationcore.Result{}, fmt.Errorf("decode oncall notification config: %w", err)
+	}
+
+	userID, err := h.currentOnCall(ctx, notification.TeamID, cfg.ScheduleID, time.Now().UTC())
+	if err != nil {
+		return notificationcore.Result{}, err
+	}
+
+	if userID == 0 {
+		return notificationcore.Result{}, fmt.Errorf("nobody is on call for schedule %d", cfg.ScheduleID)
+	}
+
+	return h.pageUser(ctx, notification.TeamID, userID, msg)
+}
+
+// currentOnCall returns the user on call for a schedule, or 0 when the schedule is missing or uncovered.
+func (h *Handler) currentOnCall(ctx context.Context, teamID, scheduleID int64, at time.Time) (int64, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	schedule, err := h.repo.GetOnCallScheduleByID(ctx, tx, teamID, scheduleID)
+	if err != nil || schedule == nil {
+		return 0, err
+	}
+
+	layers, err := h.repo.ListOnCallLayersByScheduleIDs(ctx, tx, []int64{schedule.ID})
+	if err != nil {
+		return 0, err
+	}
+
+	overrides, err := h.repo.ListOnCallOverridesByScheduleID(ctx, tx, schedule.ID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return 0, err
+	}
+
+	shift, err := oncall.Resolve(*schedule, layers, overrides, at)
+	if err != nil || shift == nil {
+		return 0, err
+	}
+
+	return shift.UserID, nil
+}
+
+// pageUser delivers to every contact method of a team member, falling back to their primary account email.
+// It fails only when no contact method accepted the message.
+func (h *Handler) pageUser(ctx context.Context, teamID, userID int64, msg notificationcore.Message) (notificationcore.Result, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return notificationcore.Result{}, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	member, err := h.repo.GetTeamMemberByUserID(ctx, tx, teamID, userID)
+	if err != nil {
+		return notificationcore.Result{}, err
+	}
+
+	// Users who left the team are never paged, even if a rotation still lists them.
+	if member == nil {
+		return notificationcore.Result{}, fmt.Errorf("user %d is not a member of team %d", userID, teamID)
+	}
+
+	methods, err := h.repo.ListUserContactMethodsByUserID(ctx, tx, userID)
+	if err != nil {
+		return notificationcore.Result{}, err
+	}
+
+	if len(methods) == 0 {
+		accounts, err := h.repo.ListAccountsByUserID(ctx, tx, userID)
+		if err != nil {
+			return notificationcore.Result{}, err
+		}
+
+		email := primaryEmail(accounts)
+		if email == "" {
+			return notificationcore.Result{}, fmt.Errorf("user %d has no contact methods", userID)
+		}
+
+		cfg, err := json.Marshal(models.EmailNotificationConfig{EmailAddress: []string{email}})
+		if err != nil {
+			return notificationcore.Result{}, err
+		}
+
+		methods = append(methods, models.UserContactMethod{UserID: userID, Type: models.NotificationTypeEmail, Name: "Primary email", Config: cfg})
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return notificationcore.Result{}, err
+	}
+
+	var (
+		result    notificationcore.Result
+		errs      []error
+		delivered int
+	)
+	for _, method := range methods {
+		res, err := notificationcore.DeliverMessage(ctx, http.DefaultClient, models.Notification{
+			TeamID: teamID,
+			Type:   method.Type,
+			Name:   method.Name,
+			Config: method.Config,
+		}, msg)
+		if err != nil {
+			zap.L().Warn("failed to page user via contact method",
+				zap.Int64("user_id", userID),
+				zap.Int64("contact_method_id", method.ID),
+				zap.String("type", string(method.Type)),
+				zap.Error(err))
+			errs = append(errs, err)
+			continue
+		}
+		result = res
+		delivered++
+	}
+
+	if delivered == 0 {
+		return result, errors.Join(errs...)
+	}
+
+	return result, nil
+}
+
+func primaryEmail(accounts []models.Account) string {
+	for _, account := range accounts {
+		if account.IsPrimary && account.Email != "" {
+			return account.Email
+		}
+	}
+	for _, account := range accounts {
+		if account.Email != "" {
+			return account.Email
+		}
+	}
+	return ""
+}
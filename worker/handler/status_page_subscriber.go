@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	notificationcore "github.com/yorukot/kymarium/core/notification"
+	statuspagecore "github.com/yorukot/kymarium/core/statuspage"
+	"github.com/yorukot/kymarium/models"
+	"github.com/yorukot/kymarium/utils/config"
+	"github.com/yorukot/kymarium/worker/tasks"
+	"go.uber.org/zap"
+)
+
+// subscriberWebhookClient delivers to status page webhook subscribers. Their URLs come from anonymous
+// visitors, so it only connects to public addresses.
+var subscriberWebhookClient = statuspagecore.NewWebhookClient()
+
+// HandleStatusPageUpdate fans a public incident event out to the subscribers of every status page showing the incident.
+func (h *Handler) HandleStatusPageUpdate(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.StatusPageUpdatePayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid status page update payload", zap.Error(err))
+		return err
+	}
+
+	deliveries, err := h.statusPageDeliveries(ctx, payload)
+	if err != nil {
+		zap.L().Error("failed to load status page subscribers",
+			zap.Int64("incident_id", payload.IncidentID),
+			zap.Int64("event_id", payload.EventID),
+			zap.Error(err))
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		task, err := tasks.NewStatusPageSubscriber(delivery)
+		if err != nil {
+			return err
+		}
+
+		if _, err := h.notifier.Enqueue(task); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			zap.L().Error("failed to enqueue status page subscriber task",
+				zap.Int64("subscriber_id", delivery.SubscriberID),
+				zap.Int64("event_id", delivery.EventID),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	zap.L().Info("status page update fanned out",
+		zap.Int64("incident_id", payload.IncidentID),
+		zap.Int64("event_id", payload.EventID),
+		zap.Int("subscribers", len(deliveries)))
+
+	return nil
+}
+
+// statusPageDeliveries returns one delivery per confirmed subscriber that follows a monitor of the incident.
+func (h *Handler) statusPageDeliveries(ctx context.Context, payload tasks.StatusPageUpdatePayload) ([]tasks.StatusPageSubscriberPayload, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	_, event, err := h.publicIncidentEvent(ctx, tx, payload.TeamID, payload.IncidentID, payload.EventID)
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	monitorIDs, err := h.repo.ListMonitorIDsByIncidentID(ctx, tx, payload.IncidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := h.repo.ListStatusPageMonitorsByMonitorIDs(ctx, tx, monitorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the incident's monitors that a page actually shows count for that page's subscribers.
+	pageMonitors := make(map[int64][]int64)
+	var pageIDs []int64
+	for _, entry := range entries {
+		if _, ok := pageMonitors[entry.StatusPageID]; !ok {
+			pageIDs = append(pageIDs, entry.StatusPageID)
+		}
+		pageMonitors[entry.StatusPageID] = append(pageMonitors[entry.StatusPageID], entry.MonitorID)
+	}
+
+	var deliveries []tasks.StatusPageSubscriberPayload
+	for _, pageID := range pageIDs {
+		subscribers, err := h.repo.ListConfirmedStatusPageSubscribers(ctx, tx, pageID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, subscriber := range subscribers {
+			if !statuspagecore.Matches(subscriber, pageMonitors[pageID]) {
+				continue
+			}
+			deliveries = append(deliveries, tasks.StatusPageSubscriberPayload{
+				StatusPageID: pageID,
+				SubscriberID: subscriber.ID,
+				TeamID:       payload.TeamID,
+				IncidentID:   payload.IncidentID,
+				EventID:      payload.EventID,
+			})
+		}
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// HandleStatusPageSubscriber delivers a public incident event to a single subscriber by email or webhook.
+func (h *Handler) HandleStatusPageSubscriber(ctx context.Context, t *asynq.Task) error {
+	var payload tasks.StatusPageSubscriberPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		zap.L().Error("invalid status page subscriber payload", zap.Error(err))
+		return err
+	}
+
+	subscriber, update, err := h.loadSubscriberUpdate(ctx, payload)
+	if err != nil {
+		zap.L().Error("failed to load status page subscriber update",
+			zap.Int64("subscriber_id", payload.SubscriberID),
+			zap.Int64("event_id", payload.EventID),
+			zap.Error(err))
+		return err
+	}
+
+	// The subscriber left, or the incident or event stopped being public, since the fan-out.
+	if subscriber == nil || update == nil {
+		return nil
+	}
+
+	switch subscriber.Type {
+	case models.StatusPageSubscriberTypeEmail:
+		email, buildErr := statuspagecore.BuildUpdateEmail("", subscriber.Target, *update)
+		if buildErr != nil {
+			return buildErr
+		}
+		err = notificationcore.SendMail(ctx, email)
+	case models.StatusPageSubscriberTypeWebhook:
+		err = statuspagecore.SendWebhook(ctx, subscriberWebhookClient, subscriber.Target, *update)
+	default:
+		err = fmt.Errorf("unsupported subscriber type %q", subscriber.Type)
+	}
+
+	if err != nil {
+		zap.L().Warn("failed to deliver status page update",
+			zap.Int64("subscriber_id", subscriber.ID),
+			zap.String("subscriber_type", string(subscriber.Type)),
+			zap.Int64("event_id", payload.EventID),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) loadSubscriberUpdate(ctx context.Context, payload tasks.StatusPageSubscriberPayload) (*models.StatusPageSubscriber, *statuspagecore.Update, error) {
+	tx, err := h.repo.StartTransaction(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer h.repo.DeferRollback(ctx, tx)
+
+	page, err := h.repo.GetStatusPageByID(ctx, tx, payload.TeamID, payload.StatusPageID)
+	if err != nil || page == nil {
+		return nil, nil, err
+	}
+
+	subscriber, err := h.repo.GetStatusPageSubscriberByID(ctx, tx, page.ID, payload.SubscriberID)
+	if err != nil || subscriber == nil || subscriber.ConfirmedAt == nil {
+		return nil, nil, err
+	}
+
+	incident, event, err := h.publicIncidentEvent(ctx, tx, payload.TeamID, payload.IncidentID, payload.EventID)
+	if err != nil || event == nil {
+		return nil, nil, err
+	}
+
+	if err := h.repo.CommitTransaction(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	update := statuspagecore.Update{
+		PageTitle:      page.Title,
+		PageSlug:       page.Slug,
+		IncidentID:     incident.ID,
+		IncidentStatus: incident.Status,
+		EventType:      event.EventType,
+		Message:        event.Message,
+		CreatedAt:      event.CreatedAt,
+		UnsubscribeURL: statuspagecore.SubscriptionURL(config.Env().BackendURL, page.Slug, "unsubscribe", subscriber.Token),
+	}
+	if incident.Title != nil {
+		update.IncidentTitle = *incident.Title
+	}
+
+	return subscriber, &update, nil
+}
+
+// publicIncidentEvent returns the incident and event when both are visible on public status pages, or nils otherwise.
+func (h *Handler) publicIncidentEvent(ctx context.Context, tx pgx.Tx, teamID, incidentID, eventID int64) (*models.Incident, *models.EventTimeline, error) {
+	incident, err := h.repo.GetIncidentByIDForTeam(ctx, tx, teamID, incidentID)
+	if err != nil || incident == nil || !incident.IsPublic {
+		return nil, nil, err
+	}
+
+	events, err := h.repo.ListPublicEventTimelinesByIncidentIDs(ctx, tx, []int64{incident.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range events {
+		if events[i].ID == eventID {
+			return incident, &events[i], nil
+		}
+	}
+
+	return nil, nil, nil
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/yorukot/kymarium/models"
+)
+
+// IncidentReminderPayload represents a repeat notification for an open incident.
+type IncidentReminderPayload struct {
+	TeamID     int64       `json:"team_id,string"`
+	MonitorID  int64       `json:"monitor_id,string"`
+	IncidentID int64       `json:"incident_id,string"`
+	RegionID   int64       `json:"region_id,string"`
+	Sequence   int         `json:"sequence"`
+	Ping       models.Ping `json:"ping"`
+	Detail     string      `json:"detail,omitempty"`
+}
+
+// NewIncidentReminder builds a delayed Asynq task for the next incident reminder.
+// The task ID is derived from the incident and sequence so duplicate schedules are rejected.
+func NewIncidentReminder(payload IncidentReminderPayload, delay time.Duration) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeIncidentReminder, body,
+		asynq.ProcessIn(delay),
+		asynq.TaskID(fmt.Sprintf("incident-reminder:%d:%d", payload.IncidentID, payload.Sequence)),
+	), nil
+}
+
+// IncidentEscalationPayload represents a pending escalation step for an open incident.
+type IncidentEscalationPayload struct {
+	TeamID     int64       `json:"team_id,string"`
+	MonitorID  int64       `json:"monitor_id,string"`
+	IncidentID int64       `json:"incident_id,string"`
+	PolicyID   int64       `json:"policy_id,string"`
+	Level      int         `json:"level"`
+	RegionID   int64       `json:"region_id,string"`
+	Ping       models.Ping `json:"ping"`
+	Detail     string      `json:"detail,omitempty"`
+}
+
+// NewIncidentEscalation builds a delayed Asynq task that notifies an escalation level.
+// The task ID is derived from the incident and level so each level fires at most once.
+func NewIncidentEscalation(payload IncidentEscalationPayload, delay time.Duration) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeIncidentEscalation, body,
+		asynq.ProcessIn(delay),
+		asynq.TaskID(IncidentEscalationTaskID(payload.IncidentID, payload.Level)),
+	), nil
+}
+
+// IncidentEscalationTaskID identifies the escalation task of one level of an incident. Asynq rejects a second
+// task with the same ID, so re-scheduling a level after a worker restart does not notify it twice.
+func IncidentEscalationTaskID(incidentID int64, level int) string {
+	return fmt.Sprintf("incident-escalation:%d:%d", incidentID, level)
+}
+
+// IncidentManualUpdatePayload represents a status change or update posted on an incident by a team member.
+type IncidentManualUpdatePayload struct {
+	TeamID     int64                 `json:"team_id,string"`
+	IncidentID int64                 `json:"incident_id,string"`
+	EventID    int64                 `json:"event_id,string"`
+	Status     models.IncidentStatus `json:"status"`
+	Message    string                `json:"message,omitempty"`
+}
+
+// NewIncidentManualUpdate builds the Asynq task that notifies the incident's channels of a manual update.
+// The task ID is derived from the timeline event so each update is announced once.
+func NewIncidentManualUpdate(payload IncidentManualUpdatePayload) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeIncidentManualUpdate, body,
+		asynq.TaskID(fmt.Sprintf("incident-manual-update:%d", payload.EventID)),
+	), nil
+}
@@ -2,19 +2,28 @@ package tasks
 
 import (
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/yorukot/kymarium/models"
 )
 
+// NotificationMaxRetry is the number of retries before a dispatch is dead-lettered.
+const NotificationMaxRetry = 5
+
 // NotificationPayload represents a notification dispatch request.
 type NotificationPayload struct {
-	TeamID         int64       `json:"team_id,string"`
-	MonitorID      int64       `json:"monitor_id,string"`
-	NotificationID int64       `json:"notification_id,string"`
-	RegionID       int64       `json:"region_id,string"`
-	Ping           models.Ping `json:"ping"`
-	Detail         string      `json:"detail,omitempty"`
+	TeamID         int64                        `json:"team_id,string"`
+	MonitorID      int64                        `json:"monitor_id,string"`
+	NotificationID int64                        `json:"notification_id,string"`
+	IncidentID     int64                        `json:"incident_id,string,omitempty"`
+	RegionID       int64                        `json:"region_id,string"`
+	EventType      models.NotificationEventType `json:"event_type,omitempty"`
+	Severity       models.IncidentSeverity      `json:"severity,omitempty"`
+	FollowUp       bool                         `json:"follow_up,omitempty"`
+	Ping           models.Ping                  `json:"ping"`
+	Detail         string                       `json:"detail,omitempty"`
 }
 
 // NewNotificationDispatch builds an Asynq task to send a notification.
@@ -24,5 +33,27 @@ func NewNotificationDispatch(payload NotificationPayload) (*asynq.Task, error) {
 		return nil, err
 	}
 
-	return asynq.NewTask(TypeNotificationDispatch, body), nil
+	return asynq.NewTask(TypeNotificationDispatch, body, asynq.MaxRetry(NotificationMaxRetry)), nil
+}
+
+// NotificationDigestPayload represents a pending digest for a rate limited notification channel.
+type NotificationDigestPayload struct {
+	TeamID         int64 `json:"team_id,string"`
+	NotificationID int64 `json:"notification_id,string"`
+	QuietHours     bool  `json:"quiet_hours,omitempty"`
+}
+
+// NewNotificationDigest builds a delayed Asynq task that sends the held events of a channel as one message.
+// The task ID is derived from the channel and send time so every event held for the same slot shares one digest.
+func NewNotificationDigest(payload NotificationDigestPayload, processAt time.Time) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeNotificationDigest, body,
+		asynq.ProcessAt(processAt),
+		asynq.MaxRetry(NotificationMaxRetry),
+		asynq.TaskID(fmt.Sprintf("notification-digest:%d:%d", payload.NotificationID, processAt.Unix())),
+	), nil
 }
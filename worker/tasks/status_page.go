@@ -0,0 +1,97 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+// StatusPageSubscriberMaxRetry bounds retries of a single subscriber delivery.
+const StatusPageSubscriberMaxRetry = 5
+
+// StatusPageUpdatePayload announces a public incident event to fan out to status page subscribers.
+type StatusPageUpdatePayload struct {
+	TeamID     int64 `json:"team_id,string"`
+	IncidentID int64 `json:"incident_id,string"`
+	EventID    int64 `json:"event_id,string"`
+}
+
+// NewStatusPageUpdate builds the fan-out task for a public incident event.
+// The task ID is derived from the event so an event is announced at most once.
+func NewStatusPageUpdate(payload StatusPageUpdatePayload) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeStatusPageUpdate, body,
+		asynq.TaskID(fmt.Sprintf("status-page-update:%d", payload.EventID)),
+	), nil
+}
+
+// StatusPageSubscriberPayload delivers one public incident event to one subscriber.
+type StatusPageSubscriberPayload struct {
+	StatusPageID int64 `json:"status_page_id,string"`
+	SubscriberID int64 `json:"subscriber_id,string"`
+	TeamID       int64 `json:"team_id,string"`
+	IncidentID   int64 `json:"incident_id,string"`
+	EventID      int64 `json:"event_id,string"`
+}
+
+// NewStatusPageSubscriber builds the delivery task of an event for a single subscriber, so a failing
+// subscriber retries on its own without re-sending to everyone else.
+func NewStatusPageSubscriber(payload StatusPageSubscriberPayload) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeStatusPageSubscriber, body,
+		asynq.MaxRetry(StatusPageSubscriberMaxRetry),
+		asynq.TaskID(fmt.Sprintf("status-page-subscriber:%d:%d", payload.SubscriberID, payload.EventID)),
+	), nil
+}
+
+// MaintenanceUpdatePayload announces a maintenance timeline entry to fan out to status page subscribers.
+type MaintenanceUpdatePayload struct {
+	TeamID        int64 `json:"team_id,string"`
+	StatusPageID  int64 `json:"status_page_id,string"`
+	MaintenanceID int64 `json:"maintenance_id,string"`
+	UpdateID      int64 `json:"update_id,string"`
+}
+
+// NewMaintenanceUpdate builds the fan-out task for a maintenance timeline entry.
+// The task ID is derived from the entry so it is announced at most once.
+func NewMaintenanceUpdate(payload MaintenanceUpdatePayload) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeMaintenanceUpdate, body,
+		asynq.TaskID(fmt.Sprintf("maintenance-update:%d", payload.UpdateID)),
+	), nil
+}
+
+// MaintenanceSubscriberPayload delivers one maintenance timeline entry to one subscriber.
+type MaintenanceSubscriberPayload struct {
+	TeamID        int64 `json:"team_id,string"`
+	StatusPageID  int64 `json:"status_page_id,string"`
+	SubscriberID  int64 `json:"subscriber_id,string"`
+	MaintenanceID int64 `json:"maintenance_id,string"`
+	UpdateID      int64 `json:"update_id,string"`
+}
+
+// NewMaintenanceSubscriber builds the delivery task of a maintenance entry for a single subscriber.
+func NewMaintenanceSubscriber(payload MaintenanceSubscriberPayload) (*asynq.Task, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return asynq.NewTask(TypeMaintenanceSubscriber, body,
+		asynq.MaxRetry(StatusPageSubscriberMaxRetry),
+		asynq.TaskID(fmt.Sprintf("maintenance-subscriber:%d:%d", payload.SubscriberID, payload.UpdateID)),
+	), nil
+}
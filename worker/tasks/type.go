@@ -2,6 +2,14 @@ package tasks
 
 // Task type constants for Asynq.
 const (
-	TypeMonitorPingPattern   = "monitor:ping:{region}"
-	TypeNotificationDispatch = "notification:dispatch"
+	TypeMonitorPingPattern    = "monitor:ping:{region}"
+	TypeNotificationDispatch  = "notification:dispatch"
+	TypeNotificationDigest    = "notification:digest"
+	TypeIncidentReminder      = "incident:reminder"
+	TypeIncidentEscalation    = "incident:escalate"
+	TypeIncidentManualUpdate  = "incident:manual_update"
+	TypeStatusPageUpdate      = "status_page:update"
+	TypeStatusPageSubscriber  = "status_page:subscriber"
+	TypeMaintenanceUpdate     = "status_page:maintenance_update"
+	TypeMaintenanceSubscriber = "status_page:maintenance_subscriber"
 )
@@ -49,6 +49,14 @@ func Run(db *pgxpool.Pool) {
 	mux := asynq.NewServeMux()
 	mux.HandleFunc(tasks.TypeMonitorPingPattern, h.HandleStartServiceTask)
 	mux.HandleFunc(tasks.TypeNotificationDispatch, h.HandleNotificationDispatch)
+	mux.HandleFunc(tasks.TypeNotificationDigest, h.HandleNotificationDigest)
+	mux.HandleFunc(tasks.TypeIncidentReminder, h.HandleIncidentReminder)
+	mux.HandleFunc(tasks.TypeIncidentEscalation, h.HandleIncidentEscalation)
+	mux.HandleFunc(tasks.TypeIncidentManualUpdate, h.HandleIncidentManualUpdate)
+	mux.HandleFunc(tasks.TypeStatusPageUpdate, h.HandleStatusPageUpdate)
+	mux.HandleFunc(tasks.TypeStatusPageSubscriber, h.HandleStatusPageSubscriber)
+	mux.HandleFunc(tasks.TypeMaintenanceUpdate, h.HandleMaintenanceUpdate)
+	mux.HandleFunc(tasks.TypeMaintenanceSubscriber, h.HandleMaintenanceSubscriber)
 
 	if err := srv.Run(mux); err != nil {
 		panic(err)